@@ -158,7 +158,7 @@ func (b *benchmarker) startThreadsWithClient(ctx context.Context, name string, f
 	requestCountEachClient := int(*b.option.RequestCount / *b.option.ClientCount)
 
 	b.startThreads(name, requestCountEachClient, int(*b.option.RequestCountStart), func(hist *histogram, start, stop, batchSize int) {
-		vc := vs.NewVastoClient(ctx, "benchmarker", *b.option.Master)
+		vc := vs.NewVastoClientInDataCenter(ctx, "benchmarker", *b.option.Master, *b.option.DataCenter)
 		if *b.option.DisableUnixSocket {
 			vc.ClusterListener.SetUnixSocket(false)
 		}
@@ -16,6 +16,7 @@ type GatewayOption struct {
 	TcpAddress *string
 	UnixSocket *string
 	Master     *string
+	DataCenter *string
 	Keyspace   *string
 }
 
@@ -30,7 +31,7 @@ func RunGateway(option *GatewayOption) {
 
 	var gs = &gatewayServer{
 		option:      option,
-		vastoClient: vs.NewVastoClient(context.Background(), "gateway", *option.Master),
+		vastoClient: vs.NewVastoClientInDataCenter(context.Background(), "gateway", *option.Master, *option.DataCenter),
 	}
 
 	if *option.TcpAddress != "" {
@@ -9,18 +9,24 @@ import (
 	"github.com/chrislusf/vasto/topology"
 )
 
+// clientChannel sends the changes of the cluster in one data center to one client
+type clientChannel struct {
+	ch         chan *pb.ClientMessage
+	dataCenter string
+}
+
 type clientChannels struct {
 	sync.Mutex
-	clientChans map[string]chan *pb.ClientMessage
+	clientChans map[string]*clientChannel
 }
 
 func newClientChannels() *clientChannels {
 	return &clientChannels{
-		clientChans: make(map[string]chan *pb.ClientMessage),
+		clientChans: make(map[string]*clientChannel),
 	}
 }
 
-func (cc *clientChannels) addClient(keyspace keyspaceName, server serverAddress) (chan *pb.ClientMessage, error) {
+func (cc *clientChannels) addClient(keyspace keyspaceName, server serverAddress, dataCenter string) (chan *pb.ClientMessage, error) {
 	key := fmt.Sprintf("%s:%s", keyspace, server)
 	cc.Lock()
 	defer cc.Unlock()
@@ -28,7 +34,10 @@ func (cc *clientChannels) addClient(keyspace keyspaceName, server serverAddress)
 		return nil, fmt.Errorf("client key is already in use: %s", key)
 	}
 	ch := make(chan *pb.ClientMessage, 3)
-	cc.clientChans[key] = ch
+	cc.clientChans[key] = &clientChannel{
+		ch:         ch,
+		dataCenter: dataCenter,
+	}
 	return ch, nil
 }
 
@@ -37,13 +46,13 @@ func (cc *clientChannels) removeClient(keyspace keyspaceName, server serverAddre
 	cc.Lock()
 	defer cc.Unlock()
 
-	ch, ok := cc.clientChans[key]
+	c, ok := cc.clientChans[key]
 	if !ok {
 		return fmt.Errorf("client key is not in use: %s", key)
 	}
 
 	delete(cc.clientChans, key)
-	close(ch)
+	close(c.ch)
 	return nil
 }
 
@@ -51,20 +60,21 @@ func (cc *clientChannels) sendClient(keyspace keyspaceName, server serverAddress
 	key := fmt.Sprintf("%s:%s", keyspace, server)
 	cc.Lock()
 	defer cc.Unlock()
-	ch, ok := cc.clientChans[key]
+	c, ok := cc.clientChans[key]
 	if !ok {
 		return fmt.Errorf("client channel not found: %s", key)
 	}
-	ch <- msg
+	c.ch <- msg
 	return nil
 }
 
-func (cc *clientChannels) notifyClients(keyspace keyspaceName, msg *pb.ClientMessage) error {
+// notifyClients sends the message to the clients following the keyspace in the data center
+func (cc *clientChannels) notifyClients(keyspace keyspaceName, dataCenter string, msg *pb.ClientMessage) error {
 	prefix := fmt.Sprintf("%s:", keyspace)
 	cc.Lock()
-	for key, ch := range cc.clientChans {
-		if strings.HasPrefix(key, prefix) {
-			ch <- msg
+	for key, c := range cc.clientChans {
+		if strings.HasPrefix(key, prefix) && c.dataCenter == dataCenter {
+			c.ch <- msg
 		}
 	}
 	cc.Unlock()
@@ -72,8 +82,12 @@ func (cc *clientChannels) notifyClients(keyspace keyspaceName, msg *pb.ClientMes
 }
 
 func (cc *clientChannels) notifyStoreResourceUpdate(keyspace keyspaceName, nodes []*pb.ClusterNode, isDelete bool, isPromotion bool) error {
+	if len(nodes) == 0 {
+		return nil
+	}
 	return cc.notifyClients(
 		keyspace,
+		nodes[0].StoreResource.GetDataCenter(),
 		&pb.ClientMessage{
 			Updates: &pb.ClientMessage_StoreResourceUpdate{
 				Nodes:       nodes,
@@ -95,9 +109,10 @@ func (cc *clientChannels) sendClientCluster(keyspace keyspaceName, server server
 	)
 }
 
-func (cc *clientChannels) notifyClusterResize(keyspace keyspaceName, currentClusterSize, targetClusterSize uint32) error {
+func (cc *clientChannels) notifyClusterResize(keyspace keyspaceName, dataCenter string, currentClusterSize, targetClusterSize uint32) error {
 	return cc.notifyClients(
 		keyspace,
+		dataCenter,
 		&pb.ClientMessage{
 			Resize: &pb.ClientMessage_Resize{
 				CurrentClusterSize: currentClusterSize,
@@ -107,3 +122,16 @@ func (cc *clientChannels) notifyClusterResize(keyspace keyspaceName, currentClus
 		},
 	)
 }
+
+func (cc *clientChannels) notifyReplicationFactorChange(keyspace keyspaceName, dataCenter string, replicationFactor uint32) error {
+	return cc.notifyClients(
+		keyspace,
+		dataCenter,
+		&pb.ClientMessage{
+			ReplicationFactorChange: &pb.ClientMessage_ReplicationFactorChange{
+				ReplicationFactor: replicationFactor,
+				Keyspace:          string(keyspace),
+			},
+		},
+	)
+}
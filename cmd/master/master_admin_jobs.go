@@ -0,0 +1,400 @@
+package master
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	constAdminJobsFile   = "admin_jobs.txt"
+	constMaxAdminJobs    = 1000
+	constAdminJobRestart = "interrupted by master restart"
+	// the history file is rewritten with only the latest jobs after this many appended lines
+	constAdminJobsFileMaxLines = 2 * constMaxAdminJobs
+)
+
+// adminJobs keeps the admin jobs in starting order, and saves them to the master dir if not empty.
+// Each change of a job appends the job as one line to the history file, replacing its earlier lines.
+type adminJobs struct {
+	sync.RWMutex
+	jobs      []*adminJob
+	filePath  string
+	saveLock  sync.Mutex
+	lineCount int
+}
+
+type adminJob struct {
+	sync.Mutex
+	job        *pb.AdminJob
+	jobs       *adminJobs
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	// the job can be cancelled by onCancel instead of cancelling its context
+	onCancel        func() error
+	isUncancellable bool
+	done            chan struct{}
+}
+
+func newAdminJobs(dir string) *adminJobs {
+	jobs := &adminJobs{}
+	if dir == "" {
+		return jobs
+	}
+	jobs.filePath = filepath.Join(dir, constAdminJobsFile)
+
+	if err := jobs.load(); err != nil {
+		glog.Errorf("load admin jobs from %s: %v", jobs.filePath, err)
+	}
+
+	return jobs
+}
+
+func newJobId(kind, keyspace string) string {
+	return fmt.Sprintf("%s-%s-%d", kind, keyspace, time.Now().UnixNano())
+}
+
+// start registers a running job for the request
+func (jobs *adminJobs) start(kind, keyspace, dataCenter string, request proto.Message) *adminJob {
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	job := &adminJob{
+		job: &pb.AdminJob{
+			Id:          newJobId(kind, keyspace),
+			Kind:        kind,
+			Keyspace:    keyspace,
+			DataCenter:  dataCenter,
+			Request:     proto.CompactTextString(request),
+			State:       pb.AdminJob_RUNNING,
+			StartedAtNs: time.Now().UnixNano(),
+		},
+		jobs:       jobs,
+		ctx:        ctx,
+		cancelFunc: cancelFunc,
+		done:       make(chan struct{}),
+	}
+
+	glog.V(1).Infof("job %s starts: %s", job.job.Id, job.job.Request)
+
+	jobs.Lock()
+	jobs.jobs = append(jobs.jobs, job)
+	jobs.trim()
+	jobs.Unlock()
+
+	jobs.save(job)
+
+	return job
+}
+
+// trim drops the oldest finished jobs over constMaxAdminJobs
+func (jobs *adminJobs) trim() {
+	for len(jobs.jobs) > constMaxAdminJobs {
+		dropped := false
+		for i, job := range jobs.jobs {
+			if !job.isRunning() {
+				jobs.jobs = append(jobs.jobs[:i], jobs.jobs[i+1:]...)
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			return
+		}
+	}
+}
+
+func (jobs *adminJobs) getJob(id string) (*adminJob, bool) {
+	jobs.RLock()
+	defer jobs.RUnlock()
+	for _, job := range jobs.jobs {
+		if job.job.Id == id {
+			return job, true
+		}
+	}
+	return nil, false
+}
+
+// list returns the latest jobs first
+func (jobs *adminJobs) list(keyspace, dataCenter string, limit int) (list []*pb.AdminJob) {
+	jobs.RLock()
+	defer jobs.RUnlock()
+	for i := len(jobs.jobs) - 1; i >= 0; i-- {
+		if limit > 0 && len(list) >= limit {
+			break
+		}
+		job := jobs.jobs[i].toAdminJob()
+		if keyspace != "" && job.Keyspace != keyspace {
+			continue
+		}
+		if dataCenter != "" && job.DataCenter != dataCenter {
+			continue
+		}
+		list = append(list, job)
+	}
+	return
+}
+
+func (jobs *adminJobs) load() error {
+
+	file, err := os.Open(jobs.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var history []*pb.AdminJob
+	indexes := make(map[string]int)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for scanner.Scan() {
+		t := &pb.AdminJob{}
+		if err = proto.UnmarshalText(scanner.Text(), t); err != nil {
+			return err
+		}
+		if index, found := indexes[t.Id]; found {
+			history[index] = t
+			continue
+		}
+		indexes[t.Id] = len(history)
+		history = append(history, t)
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now().UnixNano()
+	for _, t := range history {
+		if t.State == pb.AdminJob_RUNNING {
+			t.State, t.Error, t.FinishedAtNs = pb.AdminJob_FAILED, constAdminJobRestart, now
+		}
+		job := &adminJob{
+			job:        t,
+			jobs:       jobs,
+			ctx:        context.Background(),
+			cancelFunc: func() {},
+			done:       make(chan struct{}),
+		}
+		close(job.done)
+		jobs.jobs = append(jobs.jobs, job)
+	}
+	jobs.trim()
+
+	glog.V(0).Infof("loaded %d admin jobs from %s", len(jobs.jobs), jobs.filePath)
+
+	jobs.saveLock.Lock()
+	defer jobs.saveLock.Unlock()
+	return jobs.rewrite()
+}
+
+// save appends the job to the history file, or rewrites the file if it has too many lines
+func (jobs *adminJobs) save(job *adminJob) {
+
+	if jobs.filePath == "" {
+		return
+	}
+
+	jobs.saveLock.Lock()
+	defer jobs.saveLock.Unlock()
+
+	if jobs.lineCount >= constAdminJobsFileMaxLines {
+		if err := jobs.rewrite(); err != nil {
+			glog.Errorf("save admin jobs to %s: %v", jobs.filePath, err)
+		}
+		return
+	}
+
+	file, err := os.OpenFile(jobs.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		glog.Errorf("save admin job %s to %s: %v", job.id(), jobs.filePath, err)
+		return
+	}
+	defer file.Close()
+
+	if _, err = file.WriteString(proto.CompactTextString(job.toAdminJob()) + "\n"); err != nil {
+		glog.Errorf("save admin job %s to %s: %v", job.id(), jobs.filePath, err)
+		return
+	}
+	jobs.lineCount++
+
+}
+
+// rewrite writes one line for each job to a temporary file, and renames it over the history file
+func (jobs *adminJobs) rewrite() error {
+
+	var lines []byte
+	jobs.RLock()
+	for _, job := range jobs.jobs {
+		lines = append(lines, proto.CompactTextString(job.toAdminJob())...)
+		lines = append(lines, '\n')
+	}
+	lineCount := len(jobs.jobs)
+	jobs.RUnlock()
+
+	tmpPath := jobs.filePath + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, lines, 0640); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, jobs.filePath); err != nil {
+		return err
+	}
+	jobs.lineCount = lineCount
+
+	return nil
+}
+
+// run runs fn in the background, and waits for it to finish unless the request is gone first
+func (job *adminJob) run(ctx context.Context, fn func() error) error {
+
+	go func() {
+		job.finish(fn())
+	}()
+
+	return job.wait(ctx)
+}
+
+func (job *adminJob) wait(ctx context.Context) error {
+	select {
+	case <-job.done:
+		t := job.toAdminJob()
+		if t.Error != "" {
+			return errors.New(t.Error)
+		}
+		if t.State == pb.AdminJob_CANCELLED {
+			return fmt.Errorf("job %s is cancelled", t.Id)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("job %s is still running: %v", job.job.Id, ctx.Err())
+	}
+}
+
+func (job *adminJob) id() string {
+	return job.job.Id
+}
+
+func (job *adminJob) isRunning() bool {
+	job.Lock()
+	defer job.Unlock()
+	return job.job.State == pb.AdminJob_RUNNING
+}
+
+func (job *adminJob) setOnCancel(onCancel func() error) {
+	job.Lock()
+	job.onCancel = onCancel
+	job.Unlock()
+}
+
+func (job *adminJob) logStep(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	glog.V(1).Infof("job %s: %s", job.job.Id, message)
+
+	job.Lock()
+	job.job.Steps = append(job.job.Steps, &pb.AdminJobStep{
+		AtNs:    time.Now().UnixNano(),
+		Message: message,
+	})
+	job.Unlock()
+
+	job.jobs.save(job)
+}
+
+// setUncancellable returns false if the job has been cancelled.
+// After this, the job can not be cancelled.
+func (job *adminJob) setUncancellable() bool {
+	job.Lock()
+	defer job.Unlock()
+	if job.job.IsCancelling {
+		return false
+	}
+	job.isUncancellable = true
+	return true
+}
+
+func (job *adminJob) cancel() error {
+	job.Lock()
+	defer job.Unlock()
+	if job.job.State != pb.AdminJob_RUNNING {
+		return fmt.Errorf("job %s is already %v", job.job.Id, job.job.State)
+	}
+	if job.isUncancellable {
+		return fmt.Errorf("job %s can not be cancelled any more", job.job.Id)
+	}
+	if job.onCancel != nil {
+		if err := job.onCancel(); err != nil {
+			return err
+		}
+	} else {
+		job.cancelFunc()
+	}
+	job.job.IsCancelling = true
+	return nil
+}
+
+// finish sets the job as cancelled if it fails after being cancelled
+func (job *adminJob) finish(err error) {
+	state := pb.AdminJob_SUCCEEDED
+	if err != nil {
+		state = pb.AdminJob_FAILED
+		job.Lock()
+		if job.job.IsCancelling {
+			state, err = pb.AdminJob_CANCELLED, nil
+		}
+		job.Unlock()
+	}
+	job.finishWithState(state, err)
+}
+
+func (job *adminJob) finishWithState(state pb.AdminJob_State, err error) {
+	job.Lock()
+	job.job.State = state
+	if err != nil {
+		job.job.Error = err.Error()
+	}
+	job.job.FinishedAtNs = time.Now().UnixNano()
+	job.Unlock()
+
+	job.cancelFunc()
+	close(job.done)
+
+	glog.V(1).Infof("job %s %v: %v", job.job.Id, state, err)
+
+	job.jobs.save(job)
+}
+
+func (job *adminJob) toAdminJob() *pb.AdminJob {
+	job.Lock()
+	defer job.Unlock()
+
+	t := &pb.AdminJob{
+		Id:           job.job.Id,
+		Kind:         job.job.Kind,
+		Keyspace:     job.job.Keyspace,
+		DataCenter:   job.job.DataCenter,
+		Request:      job.job.Request,
+		State:        job.job.State,
+		Error:        job.job.Error,
+		StartedAtNs:  job.job.StartedAtNs,
+		FinishedAtNs: job.job.FinishedAtNs,
+		IsCancelling: job.job.IsCancelling,
+	}
+	for _, step := range job.job.Steps {
+		t.Steps = append(t.Steps, &pb.AdminJobStep{
+			AtNs:    step.AtNs,
+			Message: step.Message,
+		})
+	}
+	return t
+}
@@ -0,0 +1,114 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/magiconair/properties/assert"
+)
+
+func TestAdminJobRun(t *testing.T) {
+
+	jobs := newAdminJobs("")
+
+	job := jobs.start("delete", "ks1", "dc1", &pb.DeleteClusterRequest{Keyspace: "ks1"})
+	err := job.run(context.Background(), func() error {
+		job.logStep("delete shards on %d servers", 3)
+		return nil
+	})
+	assert.Equal(t, err, nil)
+
+	failed := jobs.start("compact", "ks2", "", &pb.CompactClusterRequest{Keyspace: "ks2"})
+	err = failed.run(context.Background(), func() error {
+		return errors.New("no keyspace ks2 found")
+	})
+	assert.Equal(t, err.Error(), "no keyspace ks2 found")
+
+	t1, found := jobs.getJob(job.id())
+	assert.Equal(t, found, true)
+	assert.Equal(t, t1.toAdminJob().State, pb.AdminJob_SUCCEEDED)
+	assert.Equal(t, t1.toAdminJob().Steps[0].Message, "delete shards on 3 servers")
+
+	list := jobs.list("", "", 0)
+	assert.Equal(t, len(list), 2)
+	assert.Equal(t, list[0].Id, failed.id(), "latest first")
+	assert.Equal(t, list[0].State, pb.AdminJob_FAILED)
+
+	assert.Equal(t, len(jobs.list("ks1", "", 0)), 1)
+	assert.Equal(t, len(jobs.list("", "", 1)), 1)
+
+}
+
+func TestAdminJobCancel(t *testing.T) {
+
+	jobs := newAdminJobs("")
+
+	job := jobs.start("create", "ks1", "dc1", &pb.CreateClusterRequest{Keyspace: "ks1"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := job.run(ctx, func() error {
+		<-job.ctx.Done()
+		return job.ctx.Err()
+	})
+	assert.Equal(t, err != nil, true, "request timed out")
+	assert.Equal(t, job.isRunning(), true, "job keeps running")
+
+	assert.Equal(t, job.cancel(), nil)
+	<-job.done
+	assert.Equal(t, job.toAdminJob().State, pb.AdminJob_CANCELLED)
+	assert.Equal(t, job.cancel() != nil, true, "cancel a finished job")
+
+	uncancellable := jobs.start("replace", "ks1", "dc1", &pb.ReplaceNodeRequest{Keyspace: "ks1"})
+	assert.Equal(t, uncancellable.setUncancellable(), true)
+	assert.Equal(t, uncancellable.cancel() != nil, true, "cancel an uncancellable job")
+
+}
+
+func TestAdminJobHistory(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "vasto_master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	jobs := newAdminJobs(dir)
+	done := jobs.start("delete", "ks1", "dc1", &pb.DeleteClusterRequest{Keyspace: "ks1"})
+	done.run(context.Background(), func() error { return nil })
+	running := jobs.start("create", "ks2", "dc1", &pb.CreateClusterRequest{Keyspace: "ks2"})
+	running.logStep("create shards on %d servers", 2)
+
+	// each change appends one line
+	assert.Equal(t, countLines(t, dir), 4)
+
+	// the master restarts
+	loaded := newAdminJobs(dir)
+	assert.Equal(t, countLines(t, dir), 2, "one line for each job after loading")
+
+	list := loaded.list("", "", 0)
+	assert.Equal(t, len(list), 2)
+	assert.Equal(t, list[0].Id, running.id())
+	assert.Equal(t, list[0].State, pb.AdminJob_FAILED)
+	assert.Equal(t, list[0].Error, constAdminJobRestart)
+	assert.Equal(t, list[0].Steps[0].Message, "create shards on 2 servers")
+	assert.Equal(t, list[1].Id, done.id())
+	assert.Equal(t, list[1].State, pb.AdminJob_SUCCEEDED)
+	assert.Equal(t, list[1].Request, done.toAdminJob().Request)
+
+}
+
+func countLines(t *testing.T, dir string) int {
+	txt, err := ioutil.ReadFile(filepath.Join(dir, constAdminJobsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Count(string(txt), "\n")
+}
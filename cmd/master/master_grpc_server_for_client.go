@@ -62,10 +62,17 @@ func (ms *masterServer) RegisterClient(stream pb.VastoMaster_RegisterClientServe
 			} else {
 				clientWatchedKeyspaces[keyspace] = clientName
 
+				// prefer the cluster in the client's data center, unless the keyspace is only in other data centers
+				dataCenter := clientHeartbeat.ClusterFollow.DataCenter
+				ks := ms.topo.keyspaces.getOrCreateKeyspace(string(keyspace))
+				if !clientHeartbeat.ClusterFollow.DisableDataCenterFallback {
+					dataCenter = ks.preferredDataCenter(dataCenter)
+				}
+
 				// for client, just set the expected cluster size to zero, and fix it when actual cluster is registered
-				clusterRing, _ := ms.topo.keyspaces.getOrCreateKeyspace(string(keyspace)).doGetOrCreateCluster(0, 0)
+				clusterRing, _ := ks.doGetOrCreateCluster(dataCenter, 0, 0)
 
-				if ch, err := ms.clientChans.addClient(keyspace, clientAddress); err == nil {
+				if ch, err := ms.clientChans.addClient(keyspace, clientAddress, dataCenter); err == nil {
 					// this is not added yet, start a goroutine that sends to the stream, until client disconnects
 					ms.OnClientConnectEvent(keyspace, clientAddress, clientName)
 					go func() {
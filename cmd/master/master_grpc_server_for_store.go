@@ -24,12 +24,19 @@ func (ms *masterServer) RegisterStore(stream pb.VastoMaster_RegisterStoreServer)
 	storeResource := storeHeartbeat.StoreResource
 	glog.V(1).Infof("[master] + store %v", storeResource.Address)
 
-	dc := ms.topo.dataCenter
+	dc := ms.topo.dataCenters.getOrCreateDataCenter(storeResource.DataCenter)
 
 	if existing, hasData := dc.upsertServer(storeResource); hasData {
 		return fmt.Errorf("duplicate with existing resource %v", existing)
 	}
-	defer ms.topo.dataCenter.deleteServer(storeResource)
+	defer dc.deleteServer(storeResource)
+
+	for _, settings := range storeHeartbeat.KeyspaceSettings {
+		ms.topo.keyspaces.getOrCreateKeyspace(settings.Keyspace).restoreSettings(settings)
+	}
+	for _, indexes := range storeHeartbeat.KeyspaceIndexes {
+		ms.topo.keyspaces.getOrCreateKeyspace(indexes.Keyspace).restoreIndexes(indexes)
+	}
 
 	seenShardsOnThisServer := make(map[string]*pb.ShardInfo)
 	defer ms.unRegisterShards(seenShardsOnThisServer, storeResource)
@@ -40,6 +47,18 @@ func (ms *masterServer) RegisterStore(stream pb.VastoMaster_RegisterStoreServer)
 		if e != nil {
 			break
 		}
+		if beat.ShardInfo == nil {
+			// the periodic heartbeat
+			ms.processReplicationLags(beat.ReplicationLags)
+			dc.setDiskUsages(storeResource.Address, beat.ShardDiskUsages)
+			dc.setBootstrapProgresses(storeResource.Address, beat.BootstrapProgresses)
+			dc.setFollowLags(storeResource.Address, beat.FollowLags)
+			if err := stream.Send(ms.remoteClustersMessage(storeResource.DataCenter)); err != nil {
+				glog.Errorf("[master] - store %v: %v", storeResource.Address, err)
+				return err
+			}
+			continue
+		}
 		if err := ms.processShardInfo(seenShardsOnThisServer, storeResource, beat.ShardInfo); err != nil {
 			glog.Errorf("process shard status %v: %v", beat.ShardInfo, err)
 			glog.Errorf("[master] - store %v: %v", storeResource.Address, e)
@@ -96,7 +115,7 @@ func (ms *masterServer) notifyPromotion(shardInfo *pb.ShardInfo, storeResource *
 func (ms *masterServer) processShardInfo(seenShardsOnThisServer map[string]*pb.ShardInfo,
 	storeResource *pb.StoreResource, shardInfo *pb.ShardInfo) error {
 	keyspace := ms.topo.keyspaces.getOrCreateKeyspace(shardInfo.KeyspaceName)
-	cluster := keyspace.getOrCreateCluster(int(shardInfo.ClusterSize), int(shardInfo.ReplicationFactor))
+	cluster := keyspace.getOrCreateCluster(storeResource.DataCenter, int(shardInfo.ClusterSize), int(shardInfo.ReplicationFactor))
 
 	if shardInfo.IsCandidate {
 		if cluster.GetNextCluster() == nil {
@@ -135,12 +154,20 @@ func (ms *masterServer) processShardInfo(seenShardsOnThisServer map[string]*pb.S
 }
 
 func (ms *masterServer) unRegisterShards(seenShardsOnThisServer map[string]*pb.ShardInfo, storeResource *pb.StoreResource) {
+	shardIds := make(map[keyspaceName]map[uint32]bool)
 	for _, shardInfo := range seenShardsOnThisServer {
 		keyspace := ms.topo.keyspaces.getOrCreateKeyspace(string(shardInfo.KeyspaceName))
-		cluster := keyspace.cluster
+		if shardIds[keyspace.name] == nil {
+			shardIds[keyspace.name] = make(map[uint32]bool)
+		}
+		if shardInfo.ServerId == shardInfo.ShardId {
+			// only the primary shards follow other data centers
+			shardIds[keyspace.name][shardInfo.ShardId] = true
+		}
+		cluster := keyspace.getCluster(storeResource.DataCenter)
 		if cluster != nil {
 			if shardInfo.IsCandidate {
-				if keyspace.cluster.GetNextCluster() == nil {
+				if cluster.GetNextCluster() == nil {
 					continue
 				}
 				cluster = cluster.GetNextCluster()
@@ -149,4 +176,33 @@ func (ms *masterServer) unRegisterShards(seenShardsOnThisServer map[string]*pb.S
 			ms.notifyDeletion(shardInfo, storeResource)
 		}
 	}
+	for name, ids := range shardIds {
+		if keyspace, found := ms.topo.keyspaces.getKeyspace(string(name)); found {
+			keyspace.removeReplicationLags(storeResource.DataCenter, ids)
+		}
+	}
+}
+
+func (ms *masterServer) processReplicationLags(lags []*pb.ReplicationLag) {
+	for _, lag := range lags {
+		if keyspace, found := ms.topo.keyspaces.getKeyspace(lag.Keyspace); found {
+			keyspace.setReplicationLag(lag)
+		}
+	}
+}
+
+// remoteClustersMessage lists the clusters of all keyspaces outside of the data center,
+// for the stores to follow the changes from other data centers.
+func (ms *masterServer) remoteClustersMessage(dc string) *pb.StoreMessage {
+	msg := &pb.StoreMessage{}
+	ms.topo.keyspaces.RLock()
+	for _, keyspace := range ms.topo.keyspaces.keyspaces {
+		for _, cluster := range keyspace.getClusters() {
+			if cluster.DataCenter() != dc {
+				msg.RemoteClusters = append(msg.RemoteClusters, cluster.ToCluster())
+			}
+		}
+	}
+	ms.topo.keyspaces.RUnlock()
+	return msg
 }
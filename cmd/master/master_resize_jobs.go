@@ -0,0 +1,239 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+)
+
+type resizeJobKey struct {
+	keyspace   keyspaceName
+	dataCenter string
+}
+
+// resizeJobs keeps the latest resize job of each cluster
+type resizeJobs struct {
+	sync.RWMutex
+	jobs map[resizeJobKey]*resizeJob
+}
+
+type resizeJob struct {
+	sync.Mutex
+	job *pb.ResizeJob
+	// the admin job with the same id, if any
+	adminJob *adminJob
+	// the existing servers followed by the new servers, indexed by server id
+	servers           []*pb.StoreResource
+	shardCount        int
+	replicationFactor int
+	ctx               context.Context
+	cancelFunc        context.CancelFunc
+	// the new cluster size is being committed, and the job can not be cancelled any more
+	isCommitting bool
+}
+
+func newResizeJobs() *resizeJobs {
+	return &resizeJobs{
+		jobs: make(map[resizeJobKey]*resizeJob),
+	}
+}
+
+// startJob registers a new running job, unless the cluster is already resizing
+func (jobs *resizeJobs) startJob(id, keyspace, dataCenter string, clusterSize, targetClusterSize, shardCount, replicationFactor int) (*resizeJob, error) {
+	key := resizeJobKey{keyspaceName(keyspace), dataCenter}
+
+	jobs.Lock()
+	defer jobs.Unlock()
+
+	if existing, found := jobs.jobs[key]; found && existing.isRunning() {
+		return nil, fmt.Errorf("cluster %s is resizing by job %s", keyspace, existing.job.Id)
+	}
+
+	now := time.Now()
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	job := &resizeJob{
+		job: &pb.ResizeJob{
+			Id:                id,
+			Keyspace:          keyspace,
+			DataCenter:        dataCenter,
+			ClusterSize:       uint32(clusterSize),
+			TargetClusterSize: uint32(targetClusterSize),
+			State:             pb.ResizeJob_RUNNING,
+			StartedAtNs:       now.UnixNano(),
+		},
+		shardCount:        shardCount,
+		replicationFactor: replicationFactor,
+		ctx:               ctx,
+		cancelFunc:        cancelFunc,
+	}
+	jobs.jobs[key] = job
+
+	return job, nil
+}
+
+func (jobs *resizeJobs) getJob(keyspace, dataCenter string) (job *resizeJob, found bool) {
+	jobs.RLock()
+	job, found = jobs.jobs[resizeJobKey{keyspaceName(keyspace), dataCenter}]
+	jobs.RUnlock()
+	return
+}
+
+func (job *resizeJob) isRunning() bool {
+	job.Lock()
+	defer job.Unlock()
+	return job.job.State == pb.ResizeJob_RUNNING
+}
+
+func (job *resizeJob) isCancelling() bool {
+	job.Lock()
+	defer job.Unlock()
+	return job.job.IsCancelling
+}
+
+func (job *resizeJob) setServers(servers []*pb.StoreResource) {
+	job.Lock()
+	job.servers = servers
+	job.Unlock()
+}
+
+func (job *resizeJob) setStep(step string) {
+	job.Lock()
+	job.job.Step = step
+	job.Unlock()
+	if job.adminJob != nil {
+		job.adminJob.logStep("%s", step)
+	}
+}
+
+// startCommit returns false if the job has been cancelled.
+func (job *resizeJob) startCommit(shards []*pb.ResizeShardProgress) bool {
+	job.Lock()
+	defer job.Unlock()
+	if job.job.IsCancelling {
+		return false
+	}
+	job.isCommitting = true
+	job.job.Shards = shards
+	return true
+}
+
+func (job *resizeJob) cancel() error {
+	job.Lock()
+	defer job.Unlock()
+	if job.job.State != pb.ResizeJob_RUNNING {
+		return fmt.Errorf("resize job %s is already %v", job.job.Id, job.job.State)
+	}
+	if job.isCommitting {
+		return fmt.Errorf("resize job %s is committing the new cluster size", job.job.Id)
+	}
+	job.job.IsCancelling = true
+	job.cancelFunc()
+	return nil
+}
+
+func (job *resizeJob) finish(state pb.ResizeJob_State, err error) {
+	job.Lock()
+	job.job.State = state
+	if err != nil {
+		job.job.Error = err.Error()
+	}
+	job.job.FinishedAtNs = time.Now().UnixNano()
+	job.Unlock()
+	job.cancelFunc()
+	if job.adminJob != nil {
+		job.adminJob.finishWithState(adminJobStates[state], err)
+	}
+}
+
+var adminJobStates = map[pb.ResizeJob_State]pb.AdminJob_State{
+	pb.ResizeJob_RUNNING:   pb.AdminJob_RUNNING,
+	pb.ResizeJob_SUCCEEDED: pb.AdminJob_SUCCEEDED,
+	pb.ResizeJob_FAILED:    pb.AdminJob_FAILED,
+	pb.ResizeJob_CANCELLED: pb.AdminJob_CANCELLED,
+}
+
+// toResizeJob returns a copy of the job, with the shard progress if still running
+func (job *resizeJob) toResizeJob(cluster *topology.Cluster, dc *dataCenter) *pb.ResizeJob {
+	job.Lock()
+	defer job.Unlock()
+
+	t := &pb.ResizeJob{
+		Id:                job.job.Id,
+		Keyspace:          job.job.Keyspace,
+		DataCenter:        job.job.DataCenter,
+		ClusterSize:       job.job.ClusterSize,
+		TargetClusterSize: job.job.TargetClusterSize,
+		State:             job.job.State,
+		Step:              job.job.Step,
+		Error:             job.job.Error,
+		StartedAtNs:       job.job.StartedAtNs,
+		FinishedAtNs:      job.job.FinishedAtNs,
+		Shards:            job.job.Shards,
+		IsCancelling:      job.job.IsCancelling,
+	}
+
+	if job.job.State == pb.ResizeJob_RUNNING && !job.isCommitting && cluster != nil && dc != nil {
+		t.Shards = job.shardProgresses(cluster, dc)
+	}
+
+	return t
+}
+
+// shardProgresses lists the shards that the new cluster size adds to each server
+func (job *resizeJob) shardProgresses(cluster *topology.Cluster, dc *dataCenter) (shards []*pb.ResizeShardProgress) {
+
+	clusterSize, targetClusterSize := int(job.job.ClusterSize), int(job.job.TargetClusterSize)
+
+	bootstrapBytes := make(map[[2]uint32]uint64)
+	for _, progress := range dc.getBootstrapProgresses(job.job.Keyspace) {
+		bootstrapBytes[[2]uint32{progress.ServerId, progress.ShardId}] += progress.CopiedBytes
+	}
+	followLags := make(map[[2]uint32]int64)
+	for _, lag := range dc.getFollowLags(job.job.Keyspace) {
+		followLags[[2]uint32{lag.ServerId, lag.ShardId}] = lag.LagMillisecond
+	}
+
+	for serverId, store := range job.servers {
+		if serverId >= targetClusterSize {
+			continue
+		}
+		for _, clusterShard := range topology.LocalVirtualShards(serverId, targetClusterSize, job.shardCount, job.replicationFactor) {
+			if serverId < clusterSize && topology.IsVirtualShardInLocal(clusterShard.ShardId, serverId, clusterSize, job.shardCount, job.replicationFactor) {
+				continue
+			}
+			key := [2]uint32{uint32(serverId), uint32(clusterShard.ShardId)}
+			shards = append(shards, &pb.ResizeShardProgress{
+				ServerId:             uint32(serverId),
+				ShardId:              uint32(clusterShard.ShardId),
+				Address:              store.Address,
+				IsReady:              isCandidateShardReady(cluster, serverId, clusterShard.ShardId),
+				BootstrapBytes:       bootstrapBytes[key],
+				FollowLagMillisecond: followLags[key],
+			})
+		}
+	}
+
+	return
+}
+
+func isCandidateShardReady(cluster *topology.Cluster, serverId, shardId int) bool {
+	if cluster == nil {
+		return false
+	}
+	candidateCluster := cluster.GetNextCluster()
+	if candidateCluster == nil {
+		return false
+	}
+	for _, logicalShardGroup := range candidateCluster.GetAllShards() {
+		for _, node := range logicalShardGroup {
+			if int(node.ShardInfo.ServerId) == serverId && int(node.ShardInfo.ShardId) == shardId {
+				return node.ShardInfo.Status == pb.ShardInfo_READY
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/magiconair/properties/assert"
+)
+
+func TestResizeJobCancel(t *testing.T) {
+
+	jobs := newResizeJobs()
+
+	job, err := jobs.startJob("", "ks1", "dc1", 3, 5, 0, 2)
+	assert.Equal(t, err, nil)
+
+	_, err = jobs.startJob("", "ks1", "dc1", 3, 4, 0, 2)
+	assert.Equal(t, err != nil, true, "one running job for each cluster")
+
+	_, err = jobs.startJob("", "ks1", "dc2", 3, 4, 0, 2)
+	assert.Equal(t, err, nil, "other data center")
+
+	assert.Equal(t, job.cancel(), nil)
+	assert.Equal(t, job.ctx.Err() != nil, true, "cancelled context")
+	assert.Equal(t, job.startCommit(nil), false, "cancelled before commit")
+
+	job.finish(pb.ResizeJob_CANCELLED, nil)
+	assert.Equal(t, job.cancel() != nil, true, "cancel a finished job")
+
+	latest, found := jobs.getJob("ks1", "dc1")
+	assert.Equal(t, found, true)
+	assert.Equal(t, latest.toResizeJob(nil, nil).State, pb.ResizeJob_CANCELLED)
+
+	_, err = jobs.startJob("", "ks1", "dc1", 3, 4, 0, 2)
+	assert.Equal(t, err, nil, "start after the previous job finished")
+
+}
+
+func TestResizeJobCommit(t *testing.T) {
+
+	jobs := newResizeJobs()
+
+	job, _ := jobs.startJob("", "ks1", "dc1", 3, 4, 0, 2)
+
+	assert.Equal(t, job.startCommit(nil), true)
+	assert.Equal(t, job.cancel() != nil, true, "cancel a committing job")
+
+	job.finish(pb.ResizeJob_SUCCEEDED, nil)
+	assert.Equal(t, job.isRunning(), false)
+
+}
+
+func TestResizeJobShardProgresses(t *testing.T) {
+
+	jobs := newResizeJobs()
+
+	var servers []*pb.StoreResource
+	for i := 0; i < 4; i++ {
+		servers = append(servers, &pb.StoreResource{Address: fmt.Sprintf("localhost:%d", 7000+i)})
+	}
+
+	job, _ := jobs.startJob("", "ks1", "dc1", 3, 4, 0, 2)
+	job.setServers(servers)
+
+	dc := &dataCenter{
+		bootstrapProgresses: map[serverAddress][]*pb.BootstrapProgress{
+			"localhost:7003": {{Keyspace: "ks1", ServerId: 3, ShardId: 3, CopiedBytes: 100}},
+		},
+		followLags: make(map[serverAddress][]*pb.FollowLag),
+	}
+
+	shards := job.shardProgresses(nil, dc)
+
+	// growing from 3 to 4 servers with replication factor 2:
+	// server 0 gets shard 3, server 3 gets shard 3 and shard 2
+	var ids []string
+	for _, shard := range shards {
+		ids = append(ids, fmt.Sprintf("%d.%d", shard.ServerId, shard.ShardId))
+	}
+	assert.Equal(t, ids, []string{"0.3", "3.3", "3.2"})
+	assert.Equal(t, shards[1].BootstrapBytes, uint64(100))
+
+}
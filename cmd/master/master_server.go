@@ -13,6 +13,8 @@ import (
 // MasterOption has options to run a master process
 type MasterOption struct {
 	Address *string
+	// the admin job history is kept in this folder, or not kept if empty
+	Dir *string
 }
 
 type masterServer struct {
@@ -22,16 +24,25 @@ type masterServer struct {
 	topo                 *masterTopology
 	keyspaceMutexMap     map[string]*mutexWithCounter
 	keyspaceMutexMapLock sync.Mutex
+	resizeJobs           *resizeJobs
+	adminJobs            *adminJobs
 }
 
 // RunMaster starts a master process
 func RunMaster(option *MasterOption) {
+	var dir string
+	if option.Dir != nil {
+		dir = *option.Dir
+	}
+
 	var ms = &masterServer{
 		option:           option,
 		clientChans:      newClientChannels(),
 		clientsStat:      newClientsStat(),
 		topo:             newMasterTopology(),
 		keyspaceMutexMap: make(map[string]*mutexWithCounter),
+		resizeJobs:       newResizeJobs(),
+		adminJobs:        newAdminJobs(dir),
 	}
 
 	listener, err := net.Listen("tcp", *option.Address)
@@ -0,0 +1,353 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+)
+
+const (
+	bytesPerGb = 1024 * 1024 * 1024
+)
+
+// Balance moves the cluster nodes from the stores using more disk to the stores using less disk.
+// Each move replaces one node by the replicate prepare/commit/cleanup protocol, one at a time.
+func (ms *masterServer) Balance(ctx context.Context, req *pb.BalanceRequest) (resp *pb.BalanceResponse, err error) {
+
+	resp = &pb.BalanceResponse{}
+
+	dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter)
+	if !found {
+		resp.Error = fmt.Sprintf("no datacenter %s found", req.DataCenter)
+		return
+	}
+
+	resp.Moves, err = ms.planBalance(dc, req)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	if req.DryRun {
+		return
+	}
+
+	doneMoves, err := ms.runMoves(ctx, req.DataCenter, resp.Moves, time.Duration(req.MoveIntervalSecond)*time.Second)
+	resp.DoneMoves = uint32(doneMoves)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
+
+// runMoves replaces the nodes one by one, pausing between the moves, and stops at the first error
+func (ms *masterServer) runMoves(ctx context.Context, dataCenter string, moves []*pb.ShardMove, interval time.Duration) (doneMoves int, err error) {
+
+	for i, move := range moves {
+		if i > 0 && interval > 0 {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return doneMoves, fmt.Errorf("cancelled after %d moves: %v", doneMoves, ctx.Err())
+			}
+		}
+		glog.V(1).Infof("move %s node %d from %s to %s, %d bytes",
+			move.Keyspace, move.ServerId, move.FromAddress, move.ToAddress, move.SizeBytes)
+		replaceResp, replaceErr := ms.ReplaceNode(ctx, &pb.ReplaceNodeRequest{
+			Keyspace:   move.Keyspace,
+			NodeId:     move.ServerId,
+			NewAddress: move.ToAddress,
+			DataCenter: dataCenter,
+		})
+		if replaceErr == nil && replaceResp.Error != "" {
+			replaceErr = fmt.Errorf("%s", replaceResp.Error)
+		}
+		if replaceErr != nil {
+			return doneMoves, fmt.Errorf("move %s node %d from %s to %s: %v",
+				move.Keyspace, move.ServerId, move.FromAddress, move.ToAddress, replaceErr)
+		}
+		doneMoves++
+	}
+
+	return
+}
+
+// balanceStore is a store in the balance plan, with the disk usage after the planned moves
+type balanceStore struct {
+	resource  *pb.StoreResource
+	usedBytes uint64
+	isTarget  bool
+}
+
+func (s *balanceStore) capacity() uint64 {
+	if s.resource.DiskSizeGb == 0 {
+		return bytesPerGb
+	}
+	return uint64(s.resource.DiskSizeGb) * bytesPerGb
+}
+
+func (s *balanceStore) load(extraBytes uint64) float64 {
+	return float64(s.usedBytes+extraBytes) / float64(s.capacity())
+}
+
+// balanceNode is one node of a keyspace cluster, which is moved with all its shards
+type balanceNode struct {
+	keyspace  string
+	serverId  int
+	sizeBytes uint64
+	// the servers of the cluster after the planned moves
+	servers           []*pb.StoreResource
+	replicationFactor int
+}
+
+func (ms *masterServer) planBalance(dc *dataCenter, req *pb.BalanceRequest) ([]*pb.ShardMove, error) {
+
+	var servers []*pb.StoreResource
+	dc.RLock()
+	for address, server := range dc.servers {
+		if dc.draining[address] {
+			// the draining stores are left to DrainStore
+			continue
+		}
+		if req.StoreGroup == "" || meetRequirement(server.Tags, []string{req.StoreGroup}) {
+			servers = append(servers, server)
+		}
+	}
+	dc.RUnlock()
+
+	return planMoves(servers, ms.balanceNodes(dc), dc.getDiskUsages(), int(req.StoreCount), int(req.MaxMoves))
+}
+
+// balanceNodes lists the nodes of all clusters in the data center, except the clusters being changed
+func (ms *masterServer) balanceNodes(dc *dataCenter) (nodes []*balanceNode) {
+	ms.topo.keyspaces.RLock()
+	for _, keyspace := range ms.topo.keyspaces.keyspaces {
+		cluster := keyspace.getCluster(string(dc.name))
+		if cluster == nil || cluster.ExpectedSize() == 0 {
+			continue
+		}
+		if cluster.GetNextCluster() != nil && cluster.GetNextCluster().CurrentSize() > 0 {
+			// the cluster is changing
+			continue
+		}
+		clusterServers := clusterServers(cluster)
+		for serverId := range clusterServers {
+			nodes = append(nodes, &balanceNode{
+				keyspace:          string(keyspace.name),
+				serverId:          serverId,
+				servers:           clusterServers,
+				replicationFactor: cluster.ReplicationFactor(),
+			})
+		}
+	}
+	ms.topo.keyspaces.RUnlock()
+	return
+}
+
+// balancePlan simulates the moves of the nodes among the stores
+type balancePlan struct {
+	stores    map[serverAddress]*balanceStore
+	storeList []*balanceStore // sorted by the disk load
+	nodes     []*balanceNode  // sorted by the size desc
+	moves     []*pb.ShardMove
+	maxMoves  int
+}
+
+// newBalancePlan plans on copies of the nodes, leaving the nodes unchanged
+func newBalancePlan(servers []*pb.StoreResource, nodes []*balanceNode, usages map[serverAddress][]*pb.ShardDiskUsage, maxMoves int) *balancePlan {
+
+	plan := &balancePlan{
+		stores:   make(map[serverAddress]*balanceStore),
+		maxMoves: maxMoves,
+	}
+	for _, node := range nodes {
+		t := *node
+		t.servers = make([]*pb.StoreResource, len(node.servers))
+		copy(t.servers, node.servers)
+		plan.nodes = append(plan.nodes, &t)
+	}
+
+	for _, server := range servers {
+		store := &balanceStore{resource: server}
+		for _, usage := range usages[serverAddress(server.Address)] {
+			store.usedBytes += usage.SizeBytes
+		}
+		plan.stores[serverAddress(server.Address)] = store
+		plan.storeList = append(plan.storeList, store)
+	}
+	sort.Slice(plan.storeList, func(i, j int) bool {
+		if plan.storeList[i].load(0) == plan.storeList[j].load(0) {
+			return plan.storeList[i].resource.Address < plan.storeList[j].resource.Address
+		}
+		return plan.storeList[i].load(0) < plan.storeList[j].load(0)
+	})
+
+	for _, node := range plan.nodes {
+		node.sizeBytes = 0
+		if server := node.servers[node.serverId]; server != nil {
+			for _, usage := range usages[serverAddress(server.Address)] {
+				if usage.Keyspace == node.keyspace && int(usage.ServerId) == node.serverId {
+					node.sizeBytes += usage.SizeBytes
+				}
+			}
+		}
+	}
+	// move the big nodes first
+	sort.SliceStable(plan.nodes, func(i, j int) bool {
+		return plan.nodes[i].sizeBytes > plan.nodes[j].sizeBytes
+	})
+
+	return plan
+}
+
+func (plan *balancePlan) hasMoreMoves() bool {
+	return plan.maxMoves == 0 || len(plan.moves) < plan.maxMoves
+}
+
+func (plan *balancePlan) move(node *balanceNode, from, to *balanceStore) {
+	plan.moves = append(plan.moves, &pb.ShardMove{
+		Keyspace:    node.keyspace,
+		ServerId:    uint32(node.serverId),
+		FromAddress: from.resource.Address,
+		ToAddress:   to.resource.Address,
+		SizeBytes:   node.sizeBytes,
+	})
+	from.usedBytes -= node.sizeBytes
+	to.usedBytes += node.sizeBytes
+	servers := make([]*pb.StoreResource, len(node.servers))
+	copy(servers, node.servers)
+	servers[node.serverId] = to.resource
+	// the other nodes of the same cluster share the planned servers
+	for _, n := range plan.nodes {
+		if n.keyspace == node.keyspace {
+			n.servers = servers
+		}
+	}
+}
+
+// moveOffNonTargets moves all nodes off the stores not in the targets
+func (plan *balancePlan) moveOffNonTargets() error {
+	for _, node := range plan.nodes {
+		if !plan.hasMoreMoves() {
+			return nil
+		}
+		from := nodeStore(node, plan.stores)
+		if from == nil || from.isTarget {
+			continue
+		}
+		to := lightestTargetStore(node, plan.storeList, from)
+		if to == nil {
+			return fmt.Errorf("no store to move %s node %d off %s", node.keyspace, node.serverId, from.resource.Address)
+		}
+		plan.move(node, from, to)
+	}
+	return nil
+}
+
+// moveFromHighestLoad moves the nodes from the target store with the highest disk load,
+// as long as it lowers the highest disk load
+func (plan *balancePlan) moveFromHighestLoad() {
+	for round := 0; plan.hasMoreMoves() && round < len(plan.nodes)*len(plan.storeList); round++ {
+		var from *balanceStore
+		for _, store := range plan.storeList {
+			if store.isTarget && (from == nil || store.load(0) > from.load(0)) {
+				from = store
+			}
+		}
+		if from == nil {
+			return
+		}
+		moved := false
+		for _, node := range plan.nodes {
+			if node.sizeBytes == 0 || nodeStore(node, plan.stores) != from {
+				continue
+			}
+			to := lightestTargetStore(node, plan.storeList, from)
+			if to == nil || to.load(node.sizeBytes) >= from.load(0) {
+				continue
+			}
+			plan.move(node, from, to)
+			moved = true
+			break
+		}
+		if !moved {
+			return
+		}
+	}
+}
+
+// planMoves plans the moves of the nodes among the servers.
+// 1. the storeCount servers with the lowest disk load are the targets
+// 2. move the nodes off the servers not in the targets
+// 3. move the nodes from the server with the highest disk load to the servers with lower disk load
+func planMoves(servers []*pb.StoreResource, nodes []*balanceNode, usages map[serverAddress][]*pb.ShardDiskUsage, storeCount int, maxMoves int) ([]*pb.ShardMove, error) {
+
+	if storeCount > len(servers) {
+		return nil, fmt.Errorf("store count %d is more than the %d existing stores", storeCount, len(servers))
+	}
+
+	plan := newBalancePlan(servers, nodes, usages, maxMoves)
+
+	// 1. the storeCount servers with the lowest disk load are the targets
+	if storeCount == 0 {
+		storeCount = len(plan.storeList)
+	}
+	for i := 0; i < storeCount; i++ {
+		plan.storeList[i].isTarget = true
+	}
+
+	// 2. move the nodes off the servers not in the targets
+	if err := plan.moveOffNonTargets(); err != nil {
+		return nil, err
+	}
+
+	// 3. move the nodes from the server with the highest disk load
+	plan.moveFromHighestLoad()
+
+	return plan.moves, nil
+}
+
+func nodeStore(node *balanceNode, stores map[serverAddress]*balanceStore) *balanceStore {
+	server := node.servers[node.serverId]
+	if server == nil {
+		return nil
+	}
+	return stores[serverAddress(server.Address)]
+}
+
+// lightestTargetStore finds the target store with the lowest disk load after the move,
+// which does not have the keyspace, has enough free disk, and does not break the placement across racks.
+func lightestTargetStore(node *balanceNode, storeList []*balanceStore, from *balanceStore) (to *balanceStore) {
+	for _, store := range storeList {
+		if !store.isTarget || store == from {
+			continue
+		}
+		if store.usedBytes+node.sizeBytes > store.capacity() {
+			continue
+		}
+		if serversContains(node.servers, store.resource.Address) {
+			continue
+		}
+		if checkReplacementPlacement(node.servers, node.replicationFactor, node.serverId, store.resource) != nil {
+			continue
+		}
+		if to == nil || store.load(node.sizeBytes) < to.load(node.sizeBytes) {
+			to = store
+		}
+	}
+	return
+}
+
+func serversContains(servers []*pb.StoreResource, address string) bool {
+	for _, server := range servers {
+		if server != nil && server.Address == address {
+			return true
+		}
+	}
+	return false
+}
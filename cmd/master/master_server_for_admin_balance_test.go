@@ -0,0 +1,100 @@
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/magiconair/properties/assert"
+)
+
+func newBalanceServers(n int) (servers []*pb.StoreResource) {
+	for i := 0; i < n; i++ {
+		servers = append(servers, &pb.StoreResource{
+			Address:    fmt.Sprintf("localhost:%d", 7000+i),
+			DiskSizeGb: 10,
+		})
+	}
+	return
+}
+
+func newBalanceNodes(keyspace string, servers []*pb.StoreResource, replicationFactor int) (nodes []*balanceNode) {
+	for serverId := range servers {
+		nodes = append(nodes, &balanceNode{
+			keyspace:          keyspace,
+			serverId:          serverId,
+			servers:           servers,
+			replicationFactor: replicationFactor,
+		})
+	}
+	return
+}
+
+func TestPlanMovesToEmptyStores(t *testing.T) {
+
+	servers := newBalanceServers(4)
+
+	// ks1 on server 0 and 1, ks2 on server 0 and 2, server 3 is empty
+	var nodes []*balanceNode
+	nodes = append(nodes, newBalanceNodes("ks1", []*pb.StoreResource{servers[0], servers[1]}, 1)...)
+	nodes = append(nodes, newBalanceNodes("ks2", []*pb.StoreResource{servers[0], servers[2]}, 1)...)
+
+	usages := map[serverAddress][]*pb.ShardDiskUsage{
+		"localhost:7000": {
+			{Keyspace: "ks1", ServerId: 0, ShardId: 0, SizeBytes: 4 * bytesPerGb},
+			{Keyspace: "ks2", ServerId: 0, ShardId: 0, SizeBytes: 3 * bytesPerGb},
+		},
+		"localhost:7001": {{Keyspace: "ks1", ServerId: 1, ShardId: 1, SizeBytes: 2 * bytesPerGb}},
+		"localhost:7002": {{Keyspace: "ks2", ServerId: 1, ShardId: 1, SizeBytes: 2 * bytesPerGb}},
+	}
+
+	moves, err := planMoves(servers, nodes, usages, 0, 0)
+	assert.Equal(t, err, nil, "plan moves")
+	assert.Equal(t, len(moves), 1, "moves")
+	assert.Equal(t, moves[0].Keyspace, "ks1", "move the biggest node")
+	assert.Equal(t, moves[0].FromAddress, "localhost:7000", "from the fullest store")
+	assert.Equal(t, moves[0].ToAddress, "localhost:7003", "to the empty store")
+
+}
+
+func TestPlanMovesOffStores(t *testing.T) {
+
+	servers := newBalanceServers(3)
+	nodes := newBalanceNodes("ks1", []*pb.StoreResource{servers[0], servers[1]}, 2)
+
+	usages := map[serverAddress][]*pb.ShardDiskUsage{
+		"localhost:7000": {{Keyspace: "ks1", ServerId: 0, ShardId: 0, SizeBytes: 1 * bytesPerGb}},
+		"localhost:7001": {{Keyspace: "ks1", ServerId: 1, ShardId: 1, SizeBytes: 5 * bytesPerGb}},
+	}
+
+	// only keep 2 stores, the store with ks1 node 1 is the fullest
+	moves, err := planMoves(servers, nodes, usages, 2, 0)
+	assert.Equal(t, err, nil, "plan moves")
+	assert.Equal(t, len(moves), 1, "moves")
+	assert.Equal(t, moves[0].ServerId, uint32(1), "move node 1")
+	assert.Equal(t, moves[0].ToAddress, "localhost:7002", "to the empty store")
+
+	_, err = planMoves(servers, nodes, usages, 4, 0)
+	assert.Equal(t, err != nil, true, "more stores than existing")
+
+}
+
+func TestPlanDrainMoves(t *testing.T) {
+
+	servers := newBalanceServers(4)
+	nodes := newBalanceNodes("ks1", []*pb.StoreResource{servers[0], servers[1], servers[2]}, 2)
+
+	usages := map[serverAddress][]*pb.ShardDiskUsage{
+		"localhost:7001": {{Keyspace: "ks1", ServerId: 1, ShardId: 1, SizeBytes: 1 * bytesPerGb}},
+	}
+
+	moves, err := planDrainMoves(servers[1], []*pb.StoreResource{servers[0], servers[2], servers[3]}, nodes, usages)
+	assert.Equal(t, err, nil, "plan drain")
+	assert.Equal(t, len(moves), 1, "moves")
+	assert.Equal(t, moves[0].ServerId, uint32(1), "move node 1")
+	assert.Equal(t, moves[0].ToAddress, "localhost:7003", "to the store without the keyspace")
+
+	_, err = planDrainMoves(servers[1], []*pb.StoreResource{servers[0], servers[2]}, nodes, usages)
+	assert.Equal(t, err != nil, true, "no other store for the keyspace")
+
+}
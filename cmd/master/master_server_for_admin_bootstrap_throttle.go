@@ -0,0 +1,63 @@
+package master
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"google.golang.org/grpc"
+)
+
+// SetBootstrapThrottle changes the limit of sending bootstrap copies on one store, or on all stores in the data center.
+func (ms *masterServer) SetBootstrapThrottle(ctx context.Context, req *pb.SetBootstrapThrottleRequest) (resp *pb.SetBootstrapThrottleResponse, err error) {
+
+	resp = &pb.SetBootstrapThrottleResponse{}
+
+	dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter)
+	if !found {
+		resp.Error = fmt.Sprintf("no datacenter %s found", req.DataCenter)
+		return
+	}
+
+	var servers []*pb.StoreResource
+	if req.Address != "" {
+		server, found := dc.getServer(req.Address)
+		if !found {
+			resp.Error = fmt.Sprintf("no store %s found in datacenter %s", req.Address, req.DataCenter)
+			return
+		}
+		servers = append(servers, server)
+	} else {
+		dc.RLock()
+		for _, server := range dc.servers {
+			servers = append(servers, server)
+		}
+		dc.RUnlock()
+	}
+
+	err = eachStore(servers, func(serverId int, store *pb.StoreResource) error {
+		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+
+			client := pb.NewVastoStoreClient(grpcConnection)
+			request := &pb.SetBootstrapThrottleRequest{
+				BytesPerSecond: req.BytesPerSecond,
+			}
+
+			glog.V(1).Infof("set bootstrap throttle on %v: %v", store.AdminAddress, request)
+			resp, err := client.SetBootstrapThrottle(ctx, request)
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("set bootstrap throttle on %s: %s", store.AdminAddress, resp.Error)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp, nil
+}
@@ -8,34 +8,42 @@ import (
 
 func (ms *masterServer) CompactCluster(ctx context.Context, req *pb.CompactClusterRequest) (resp *pb.CompactClusterResponse, err error) {
 
+	resp = &pb.CompactClusterResponse{}
+
+	job := ms.adminJobs.start("compact", req.Keyspace, "", req)
+	resp.JobId = job.id()
+
+	if jobErr := job.run(ctx, func() error {
+		return ms.compactCluster(job, req)
+	}); jobErr != nil {
+		resp.Error = jobErr.Error()
+	}
+
+	return resp, nil
+}
+
+func (ms *masterServer) compactCluster(job *adminJob, req *pb.CompactClusterRequest) error {
+
 	ms.lock(req.Keyspace)
 	defer ms.unlock(req.Keyspace)
 
-	resp = &pb.CompactClusterResponse{}
-
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
-		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
-		return
+		return fmt.Errorf("no keyspace %v found", req.Keyspace)
 	}
 
-	if keyspace.cluster == nil {
-		resp.Error = fmt.Sprintf("no cluster %v created", req.Keyspace)
-		return
+	if len(keyspace.getClusters()) == 0 {
+		return fmt.Errorf("no cluster %v created", req.Keyspace)
 	}
 
-	var servers []*pb.StoreResource
-	for i := 0; i < keyspace.cluster.ExpectedSize(); i++ {
-		server, found := keyspace.cluster.GetNode(i, 0)
-		if !found {
-			continue
-		}
-		servers = append(servers, server.GetStoreResource())
-	}
+	servers := keyspace.getPrimaryServers()
 
-	if err = compactShards(ctx, req, servers); err != nil {
-		resp.Error = err.Error()
+	// the stores compact to the end once asked
+	if !job.setUncancellable() {
+		return fmt.Errorf("compacting cluster %s is cancelled", req.Keyspace)
 	}
 
-	return resp, nil
+	job.logStep("compact shards on %d servers", len(servers))
+
+	return compactShards(context.Background(), req, servers)
 }
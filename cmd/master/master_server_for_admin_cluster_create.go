@@ -4,66 +4,140 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
 	"math"
+	"time"
 )
 
 func (ms *masterServer) CreateCluster(ctx context.Context, req *pb.CreateClusterRequest) (resp *pb.CreateClusterResponse, err error) {
 
+	resp = &pb.CreateClusterResponse{}
+
+	// a dry run changes nothing, and is not recorded as a job
+	if req.DryRun {
+		if dryRunErr := ms.createCluster(nil, req, resp); dryRunErr != nil {
+			resp.Error = dryRunErr.Error()
+		}
+		return resp, nil
+	}
+
+	job := ms.adminJobs.start("create", req.Keyspace, req.DataCenter, req)
+	resp.JobId = job.id()
+
+	// the result is only read after the job finishes
+	result := &pb.CreateClusterResponse{}
+	if jobErr := job.run(ctx, func() error {
+		return ms.createCluster(job, req, result)
+	}); jobErr != nil {
+		resp.Error = jobErr.Error()
+		return resp, nil
+	}
+	resp.Cluster, resp.Plan = result.Cluster, result.Plan
+
+	return resp, nil
+}
+
+// createCluster only plans the cluster for a dry run, which has no job.
+func (ms *masterServer) createCluster(job *adminJob, req *pb.CreateClusterRequest, resp *pb.CreateClusterResponse) (err error) {
+
 	ms.lock(req.Keyspace)
 	defer ms.unlock(req.Keyspace)
 
-	resp = &pb.CreateClusterResponse{}
-
-	dc := ms.topo.dataCenter
-	if dc == nil {
-		resp.Error = fmt.Sprintf("no datacenter found")
-		return
+	dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter)
+	if !found {
+		return fmt.Errorf("no datacenter %s found", req.DataCenter)
 	}
 
+	var indexes *pb.KeyspaceIndexes
 	keyspace, foundKeyspace := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if foundKeyspace {
-		if keyspace.cluster != nil && keyspace.cluster.ExpectedSize() > 0 {
-			resp.Error = fmt.Sprintf("keyspace %s in already exists", req.Keyspace)
-			return
+		if cluster := keyspace.getCluster(req.DataCenter); cluster != nil && cluster.ExpectedSize() > 0 {
+			return fmt.Errorf("keyspace %s in already exists in datacenter %s", req.Keyspace, req.DataCenter)
+		}
+		if req.Settings == nil {
+			// the cluster in another data center follows the existing keyspace settings
+			req.Settings = keyspace.settings
+		}
+		// and the existing index definitions
+		indexes = keyspace.indexes
+	}
+
+	if req.ShardCount != 0 && req.ShardCount < req.ClusterSize {
+		return fmt.Errorf("shard count %d should not be less than cluster size %d", req.ShardCount, req.ClusterSize)
+	}
+
+	if req.Settings != nil {
+		if err = pb.ValidateKeyspaceSettings(req.Settings); err != nil {
+			return err
+		}
+		req.Settings.Keyspace = req.Keyspace
+		if req.Settings.UpdatedAtNs == 0 {
+			req.Settings.UpdatedAtNs = uint64(time.Now().UnixNano())
 		}
 	}
 
-	servers, err := dc.allocateServers(int(req.ClusterSize), float64(req.TotalDiskSizeGb*req.ReplicationFactor),
+	servers, err := dc.allocateServers(nil, int(req.ClusterSize), int(req.ReplicationFactor), float64(req.TotalDiskSizeGb*req.ReplicationFactor),
 		func(resource *pb.StoreResource) bool {
 			return meetRequirement(resource.Tags, req.Tags)
 		})
 	if err != nil {
-		resp.Error = err.Error()
-		return
+		return err
 	}
 
+	if req.DryRun {
+		resp.Plan = planTopologyChange(req.Keyspace, nil, servers, allServerIds(len(servers)),
+			int(req.ShardCount), int(req.ReplicationFactor), nil)
+		return nil
+	}
+
+	// the primary copy of each shard
+	shardCount := topology.LogicalShardCount(len(servers), int(req.ShardCount))
 	var nodes []*pb.ClusterNode
-	for i, server := range servers {
+	for i := 0; i < shardCount; i++ {
+		server := servers[i%len(servers)]
 		nodes = append(nodes, &pb.ClusterNode{
 			StoreResource: &pb.StoreResource{
 				Network:      server.Network,
 				Address:      server.Address,
 				AdminAddress: server.AdminAddress,
+				DataCenter:   server.DataCenter,
+				Zone:         server.Zone,
+				Rack:         server.Rack,
 			},
 			ShardInfo: &pb.ShardInfo{
-				ServerId: uint32(i),
-				ShardId:  uint32(i),
+				KeyspaceName:      req.Keyspace,
+				ServerId:          uint32(i % len(servers)),
+				ShardId:           uint32(i),
+				ClusterSize:       req.ClusterSize,
+				ReplicationFactor: req.ReplicationFactor,
+				ShardCount:        req.ShardCount,
 			},
 		})
 	}
 
-	eachShardSizeGb := uint32(math.Ceil(float64(req.TotalDiskSizeGb) / float64(req.ClusterSize)))
+	eachShardSizeGb := uint32(math.Ceil(float64(req.TotalDiskSizeGb) / float64(shardCount)))
 
-	if err = createShards(ctx, req.Keyspace, req.ClusterSize, req.ReplicationFactor, eachShardSizeGb, servers); err != nil {
-		resp.Error = err.Error()
+	// the created shards are not rolled back, so the job can not stop partway
+	if !job.setUncancellable() {
+		return fmt.Errorf("creating cluster %s is cancelled", req.Keyspace)
 	}
 
-	resp.Cluster = &pb.Cluster{
-		Keyspace:            req.Keyspace,
-		Nodes:               nodes,
-		ExpectedClusterSize: req.ClusterSize,
-		CurrentClusterSize:  uint32(len(nodes)),
+	job.logStep("create shards on %d servers", len(servers))
+
+	if err = createShards(context.Background(), req.Keyspace, req.ClusterSize, req.ShardCount, req.ReplicationFactor, eachShardSizeGb, req.Settings, indexes, servers); err != nil {
+		return err
+	}
+	if req.Settings != nil {
+		ms.topo.keyspaces.getOrCreateKeyspace(req.Keyspace).settings = req.Settings
 	}
 
-	return resp, nil
+	cluster := topology.NewCluster(req.Keyspace, int(req.ClusterSize), int(req.ReplicationFactor))
+	cluster.SetDataCenter(req.DataCenter)
+	cluster.SetShardCount(int(req.ShardCount))
+	for _, node := range nodes {
+		cluster.SetShard(node.StoreResource, node.ShardInfo)
+	}
+	resp.Cluster = cluster.ToCluster()
+
+	return nil
 }
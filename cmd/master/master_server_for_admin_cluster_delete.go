@@ -8,21 +8,33 @@ import (
 
 func (ms *masterServer) DeleteCluster(ctx context.Context, req *pb.DeleteClusterRequest) (resp *pb.DeleteClusterResponse, err error) {
 
+	resp = &pb.DeleteClusterResponse{}
+
+	job := ms.adminJobs.start("delete", req.Keyspace, req.DataCenter, req)
+	resp.JobId = job.id()
+
+	if jobErr := job.run(ctx, func() error {
+		return ms.deleteCluster(job, req)
+	}); jobErr != nil {
+		resp.Error = jobErr.Error()
+	}
+
+	return resp, nil
+}
+
+func (ms *masterServer) deleteCluster(job *adminJob, req *pb.DeleteClusterRequest) error {
+
 	ms.lock(req.Keyspace)
 	defer ms.unlock(req.Keyspace)
 
-	resp = &pb.DeleteClusterResponse{}
-
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
-		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
-		return
+		return fmt.Errorf("no keyspace %v found", req.Keyspace)
 	}
 
-	cluster := keyspace.cluster
+	cluster := keyspace.getCluster(req.DataCenter)
 	if cluster == nil {
-		resp.Error = fmt.Sprintf("no cluster for %v found", req.Keyspace)
-		return
+		return fmt.Errorf("no cluster for %v found in datacenter %s", req.Keyspace, req.DataCenter)
 	}
 
 	var servers []*pb.StoreResource
@@ -34,9 +46,12 @@ func (ms *masterServer) DeleteCluster(ctx context.Context, req *pb.DeleteCluster
 		servers = append(servers, server.GetStoreResource())
 	}
 
-	if err = deleteShards(ctx, req, servers); err != nil {
-		resp.Error = err.Error()
+	// the deleted shards can not be restored, so the job can not stop partway
+	if !job.setUncancellable() {
+		return fmt.Errorf("deleting cluster %s is cancelled", req.Keyspace)
 	}
 
-	return resp, nil
+	job.logStep("delete shards on %d servers", len(servers))
+
+	return deleteShards(context.Background(), req, servers)
 }
@@ -0,0 +1,47 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// DefineIndex adds, replaces, or drops a secondary index on all stores of the keyspace.
+func (ms *masterServer) DefineIndex(ctx context.Context, req *pb.DefineIndexRequest) (resp *pb.DefineIndexResponse, err error) {
+
+	ms.lock(req.Keyspace)
+	defer ms.unlock(req.Keyspace)
+
+	resp = &pb.DefineIndexResponse{}
+
+	if req.Index == nil || req.Index.Name == "" {
+		resp.Error = "missing index name"
+		return
+	}
+
+	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
+	if !found {
+		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
+		return
+	}
+
+	if len(keyspace.getClusters()) == 0 {
+		resp.Error = fmt.Sprintf("no cluster %v created", req.Keyspace)
+		return
+	}
+
+	servers := keyspace.getPrimaryServers()
+
+	req.UpdatedAtNs = uint64(time.Now().UnixNano())
+	if err = defineIndexOnShards(ctx, req, servers); err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	// the new shards of later cluster changes follow the index definitions
+	keyspace.indexes = keyspace.indexes.WithIndex(req.Keyspace, req.Index, req.IsDrop, req.UpdatedAtNs)
+
+	return resp, nil
+}
@@ -14,77 +14,143 @@ import (
 
 func (ms *masterServer) ReplaceNode(ctx context.Context, req *pb.ReplaceNodeRequest) (resp *pb.ReplaceNodeResponse, err error) {
 
+	resp = &pb.ReplaceNodeResponse{}
+
+	// a dry run changes nothing, and is not recorded as a job
+	if req.DryRun {
+		if dryRunErr := ms.replaceNode(nil, req, resp); dryRunErr != nil {
+			resp.Error = dryRunErr.Error()
+		}
+		return resp, nil
+	}
+
+	job := ms.adminJobs.start("replace", req.Keyspace, req.DataCenter, req)
+	resp.JobId = job.id()
+
+	// the result is only read after the job finishes
+	result := &pb.ReplaceNodeResponse{}
+	if jobErr := job.run(ctx, func() error {
+		return ms.replaceNode(job, req, result)
+	}); jobErr != nil {
+		resp.Error = jobErr.Error()
+		return resp, nil
+	}
+	resp.Plan = result.Plan
+
+	return resp, nil
+
+}
+
+// replaceNode only plans the replacement for a dry run, which has no job.
+func (ms *masterServer) replaceNode(job *adminJob, req *pb.ReplaceNodeRequest, resp *pb.ReplaceNodeResponse) (err error) {
+
 	ms.lock(req.Keyspace)
 	defer ms.unlock(req.Keyspace)
 
-	resp = &pb.ReplaceNodeResponse{}
-
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
-		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
-		return
+		return fmt.Errorf("no keyspace %v found", req.Keyspace)
 	}
 
-	cluster := keyspace.cluster
+	cluster := keyspace.getCluster(req.DataCenter)
 	if cluster == nil {
-		resp.Error = fmt.Sprintf("no cluster %v found", req.Keyspace)
-		return
+		return fmt.Errorf("no cluster %v found in datacenter %s", req.Keyspace, req.DataCenter)
 	}
 
 	if cluster.GetNextCluster() != nil && cluster.GetNextCluster().CurrentSize() > 0 {
-		resp.Error = fmt.Sprintf("cluster %s is changing %d => %d in progress ...",
+		return fmt.Errorf("cluster %s is changing %d => %d in progress ...",
 			req.Keyspace, cluster.ExpectedSize(), cluster.GetNextCluster().ExpectedSize())
-		return
 	}
 
 	oldServerNode, found := cluster.GetNode(int(req.NodeId), 0)
 	if !found {
-		resp.Error = fmt.Sprintf("no server %v found", req.NodeId)
-		return
+		return fmt.Errorf("no server %v found", req.NodeId)
 	}
 	oldServer := oldServerNode.StoreResource
 
 	adminAddress, err := addressToAdminAddress(req.NewAddress)
 	if err != nil {
-		resp.Error = err.Error()
-		return
+		return err
 	}
 
 	newStore := &pb.StoreResource{
 		Address:      req.GetNewAddress(),
 		AdminAddress: adminAddress,
+		DataCenter:   req.DataCenter,
+	}
+	if dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter); found {
+		if dc.isDraining(req.GetNewAddress()) {
+			return fmt.Errorf("server %s is draining", req.GetNewAddress())
+		}
+		if server, found := dc.getServer(req.GetNewAddress()); found {
+			newStore.Zone, newStore.Rack, newStore.Tags = server.Zone, server.Rack, server.Tags
+		}
+	}
+
+	if err = checkReplacementPlacement(clusterServers(cluster), cluster.ReplicationFactor(), int(req.NodeId), newStore); err != nil {
+		return err
 	}
 
-	if err = replicateNodePrepare(ctx, req, cluster, newStore, oldServer); err != nil {
+	if req.DryRun {
+		fromServers := clusterServers(cluster)
+		toServers := append([]*pb.StoreResource{}, fromServers...)
+		toServers[req.NodeId] = newStore
+		var sizes shardSizes
+		if dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter); found {
+			sizes = dc.getShardSizes(req.Keyspace)
+		}
+		resp.Plan = planTopologyChange(req.Keyspace, fromServers, toServers, []int{int(req.NodeId)},
+			cluster.ShardCount(), cluster.ReplicationFactor(), sizes)
+		return nil
+	}
+
+	ctx := job.ctx
+
+	job.logStep("prepare server %d on %s", req.NodeId, newStore.Address)
+	if err = replicateNodePrepare(ctx, req, cluster, keyspace.indexes, newStore, oldServer); err != nil {
 		glog.Errorf("replicateNodePrepare %v: %v", req, err)
-		resp.Error = err.Error()
-		return
+		return err
 	}
 
+	// the old server is replaced after committing
+	if !job.setUncancellable() {
+		return fmt.Errorf("replacing server %d is cancelled", req.NodeId)
+	}
+	ctx = context.Background()
+
+	job.logStep("commit server %d on %s", req.NodeId, newStore.Address)
 	if err = replicateNodeCommit(ctx, req, cluster, newStore, oldServer); err != nil {
 		glog.Errorf("replicateNodeCommit %v: %v", req, err)
-		resp.Error = err.Error()
-		return
+		return err
 	}
 
 	if err = ms.adjustAndBroadcastShardStatus(ctx, req, cluster, newStore, oldServer); err != nil {
 		glog.Errorf("adjustAndBroadcastShardStatus %v: %v", req, err)
-		resp.Error = err.Error()
-		return
+		return err
 	}
 
+	job.logStep("cleanup server %d on %s", req.NodeId, oldServer.Address)
 	if err = replicateNodeCleanup(ctx, req, cluster, newStore, oldServer); err != nil {
 		glog.Errorf("replicateNodeCleanup %v: %v", req, err)
-		resp.Error = err.Error()
-		return
+		return err
 	}
 
-	return resp, nil
+	if keyspace.settings != nil {
+		if err = updateKeyspaceSettingsOnShards(ctx, &pb.UpdateKeyspaceRequest{
+			Keyspace: req.Keyspace,
+			Settings: keyspace.settings,
+		}, []*pb.StoreResource{newStore}); err != nil {
+			glog.Errorf("updateKeyspaceSettingsOnShards %v: %v", req, err)
+			return err
+		}
+	}
+
+	return nil
 
 }
 
 // 1. create the new shard and follow the old shard and its peers
-func replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
+func replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, cluster *topology.Cluster, indexes *pb.KeyspaceIndexes, newStore *pb.StoreResource, oldServer *pb.StoreResource) error {
 
 	glog.V(1).Infof("replicateNodePrepare %v", req)
 
@@ -96,6 +162,8 @@ func replicateNodePrepare(ctx context.Context, req *pb.ReplaceNodeRequest, clust
 			ServerId:          req.NodeId,
 			ClusterSize:       uint32(cluster.ExpectedSize()),
 			ReplicationFactor: uint32(cluster.ReplicationFactor()),
+			ShardCount:        uint32(cluster.ShardCount()),
+			Indexes:           indexes,
 		}
 
 		glog.V(1).Infof("prepare replicate keyspace %s from %s to %v: %v", req.Keyspace, oldServer.GetAddress(), newStore.Address, request)
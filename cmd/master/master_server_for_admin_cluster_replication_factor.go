@@ -0,0 +1,163 @@
+package master
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"google.golang.org/grpc"
+)
+
+// ChangeReplicationFactor changes the replication factor of the cluster without downtime.
+// 1. each server creates and bootstraps the extra replica shards, if increasing
+// 2. each server switches to the new replication factor, and deletes the surplus replica shards, if decreasing
+func (ms *masterServer) ChangeReplicationFactor(ctx context.Context, req *pb.ChangeReplicationFactorRequest) (resp *pb.ChangeReplicationFactorResponse, err error) {
+
+	ms.lock(req.Keyspace)
+	defer ms.unlock(req.Keyspace)
+
+	resp = &pb.ChangeReplicationFactorResponse{}
+
+	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
+	if !found {
+		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
+		return
+	}
+
+	cluster := keyspace.getCluster(req.DataCenter)
+	if cluster == nil {
+		resp.Error = fmt.Sprintf("no cluster for %v found in datacenter %s", req.Keyspace, req.DataCenter)
+		return
+	}
+
+	if cluster.GetNextCluster() != nil && cluster.GetNextCluster().CurrentSize() > 0 {
+		resp.Error = fmt.Sprintf("cluster %s is changing %d => %d in progress ...",
+			req.Keyspace, cluster.CurrentSize(), cluster.GetNextCluster().ExpectedSize())
+		return
+	}
+
+	replicationFactor := cluster.ReplicationFactor()
+	gained, dropped, err := planReplicationFactorChange(cluster.ExpectedSize(), cluster.ShardCount(), replicationFactor, int(req.ReplicationFactor))
+	if err != nil {
+		resp.Error = fmt.Sprintf("cluster %s: %v", req.Keyspace, err)
+		return resp, nil
+	}
+	glog.V(1).Infof("change %s replication factor %d => %d, adding replica shards %v, deleting replica shards %v",
+		req.Keyspace, replicationFactor, req.ReplicationFactor, gained, dropped)
+
+	servers := clusterServers(cluster)
+	for serverId, server := range servers {
+		if server == nil {
+			resp.Error = fmt.Sprintf("cluster %s is missing server %d", req.Keyspace, serverId)
+			return
+		}
+	}
+
+	for _, warning := range placementWarnings(servers, int(req.ReplicationFactor)) {
+		glog.Warningf("change %s replication factor to %d: %s", req.Keyspace, req.ReplicationFactor, warning)
+	}
+
+	// 1. create and bootstrap the extra replica shards
+	if err = changeReplicationFactorPrepare(ctx, req.Keyspace, uint32(cluster.ExpectedSize()), uint32(replicationFactor), req.ReplicationFactor, servers); err != nil {
+		glog.Errorf("changeReplicationFactorPrepare %v: %v", req, err)
+		resp.Error = err.Error()
+		// delete the extra replica shards already prepared on the other servers
+		if rollbackErr := changeReplicationFactorCommit(ctx, req.Keyspace, uint32(replicationFactor), servers); rollbackErr != nil {
+			glog.Errorf("rollback changeReplicationFactorPrepare %v: %v", req, rollbackErr)
+		}
+		return resp, nil
+	}
+
+	// 2. switch to the new replication factor, and delete the surplus replica shards
+	if err = changeReplicationFactorCommit(ctx, req.Keyspace, req.ReplicationFactor, servers); err != nil {
+		glog.Errorf("changeReplicationFactorCommit %v: %v", req, err)
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	cluster.SetReplicationFactor(int(req.ReplicationFactor))
+
+	ms.clientChans.notifyReplicationFactorChange(keyspaceName(req.Keyspace), req.DataCenter, req.ReplicationFactor)
+
+	return resp, nil
+}
+
+// planReplicationFactorChange lists the replica shards the servers gain when the replication factor goes up,
+// or drop when it goes down.
+func planReplicationFactorChange(clusterSize, shardCount, replicationFactor, targetReplicationFactor int) (gained, dropped []topology.ClusterShard, err error) {
+
+	if targetReplicationFactor <= 0 || targetReplicationFactor > clusterSize {
+		return nil, nil, fmt.Errorf("replication factor %d should be between 1 and the cluster size %d", targetReplicationFactor, clusterSize)
+	}
+	if targetReplicationFactor == replicationFactor {
+		return nil, nil, fmt.Errorf("already has replication factor %d", replicationFactor)
+	}
+
+	for serverId := 0; serverId < clusterSize; serverId++ {
+		for _, shard := range topology.LocalVirtualShards(serverId, clusterSize, shardCount, targetReplicationFactor) {
+			if !topology.IsVirtualShardInLocal(shard.ShardId, serverId, clusterSize, shardCount, replicationFactor) {
+				gained = append(gained, shard)
+			}
+		}
+		for _, shard := range topology.LocalVirtualShards(serverId, clusterSize, shardCount, replicationFactor) {
+			if !topology.IsVirtualShardInLocal(shard.ShardId, serverId, clusterSize, shardCount, targetReplicationFactor) {
+				dropped = append(dropped, shard)
+			}
+		}
+	}
+
+	return gained, dropped, nil
+}
+
+func changeReplicationFactorPrepare(ctx context.Context, keyspace string, clusterSize, replicationFactor, targetReplicationFactor uint32, stores []*pb.StoreResource) error {
+
+	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
+		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+
+			client := pb.NewVastoStoreClient(grpcConnection)
+			request := &pb.ChangeReplicationFactorPrepareRequest{
+				Keyspace:                keyspace,
+				ServerId:                uint32(serverId),
+				ClusterSize:             clusterSize,
+				ReplicationFactor:       replicationFactor,
+				TargetReplicationFactor: targetReplicationFactor,
+			}
+
+			glog.V(1).Infof("change replication factor prepare on %v: %v", store.AdminAddress, request)
+			resp, err := client.ChangeReplicationFactorPrepare(ctx, request)
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("change replication factor prepare server %d on %s: %s", serverId, store.AdminAddress, resp.Error)
+			}
+			return nil
+		})
+	})
+}
+
+func changeReplicationFactorCommit(ctx context.Context, keyspace string, targetReplicationFactor uint32, stores []*pb.StoreResource) error {
+
+	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
+		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+
+			client := pb.NewVastoStoreClient(grpcConnection)
+			request := &pb.ChangeReplicationFactorCommitRequest{
+				Keyspace:                keyspace,
+				TargetReplicationFactor: targetReplicationFactor,
+			}
+
+			glog.V(1).Infof("change replication factor commit on %v: %v", store.AdminAddress, request)
+			resp, err := client.ChangeReplicationFactorCommit(ctx, request)
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("change replication factor commit server %d on %s: %s", serverId, store.AdminAddress, resp.Error)
+			}
+			return nil
+		})
+	})
+}
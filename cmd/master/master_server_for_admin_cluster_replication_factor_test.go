@@ -0,0 +1,54 @@
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/topology"
+	"github.com/magiconair/properties/assert"
+)
+
+func clusterShardIds(shards []topology.ClusterShard) (ids []string) {
+	for _, shard := range shards {
+		ids = append(ids, fmt.Sprintf("%d.%d", shard.ServerId, shard.ShardId))
+	}
+	return
+}
+
+func TestPlanReplicationFactorChange(t *testing.T) {
+
+	gained, dropped, err := planReplicationFactorChange(3, 0, 1, 2)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, clusterShardIds(gained), []string{"0.2", "1.0", "2.1"}, "each server copies the previous shard")
+	assert.Equal(t, len(dropped), 0)
+
+	gained, dropped, err = planReplicationFactorChange(3, 0, 1, 3)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, clusterShardIds(gained), []string{"0.2", "0.1", "1.0", "1.2", "2.1", "2.0"}, "every server has every shard")
+	assert.Equal(t, len(dropped), 0)
+
+	gained, dropped, err = planReplicationFactorChange(3, 0, 3, 2)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(gained), 0)
+	assert.Equal(t, clusterShardIds(dropped), []string{"0.1", "1.2", "2.0"}, "each server drops its farthest copy")
+
+	// with virtual shards, each server copies the shards of the previous server
+	gained, _, err = planReplicationFactorChange(3, 6, 1, 2)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, clusterShardIds(gained)[:2], []string{"0.2", "0.5"})
+	assert.Equal(t, len(gained), 6)
+
+}
+
+func TestPlanReplicationFactorChangeRejects(t *testing.T) {
+
+	_, _, err := planReplicationFactorChange(3, 0, 1, 4)
+	assert.Equal(t, err != nil, true, "replication factor larger than the cluster size")
+
+	_, _, err = planReplicationFactorChange(3, 0, 1, 0)
+	assert.Equal(t, err != nil, true, "replication factor 0")
+
+	_, _, err = planReplicationFactorChange(3, 0, 2, 2)
+	assert.Equal(t, err != nil, true, "unchanged replication factor")
+
+}
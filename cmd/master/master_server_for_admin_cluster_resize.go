@@ -2,6 +2,7 @@ package master
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
@@ -10,26 +11,53 @@ import (
 	"time"
 )
 
+// ResizeCluster starts a resize job and returns its id, or only returns the plan for a dry run.
+// The job keeps the keyspace locked until it finishes.
 func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest) (resp *pb.ResizeResponse, err error) {
 
-	ms.lock(req.Keyspace)
-	defer ms.unlock(req.Keyspace)
-
 	resp = &pb.ResizeResponse{}
 
+	// a dry run changes nothing, and is not recorded as a job
+	var adminJob *adminJob
+	if !req.DryRun {
+		adminJob = ms.adminJobs.start("resize", req.Keyspace, req.DataCenter, req)
+		resp.JobId = adminJob.id()
+	}
+
+	ms.lock(req.Keyspace)
+	isStarted := false
+	defer func() {
+		if isStarted {
+			return
+		}
+		ms.unlock(req.Keyspace)
+		if adminJob == nil {
+			return
+		}
+		if resp.Error != "" {
+			adminJob.finish(errors.New(resp.Error))
+		} else {
+			adminJob.finish(nil)
+		}
+	}()
+
 	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
 	if !found {
 		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
 		return
 	}
 
-	cluster := keyspace.cluster
+	cluster := keyspace.getCluster(req.DataCenter)
 	if cluster == nil {
-		resp.Error = fmt.Sprintf("no cluster for %v found", req.Keyspace)
+		resp.Error = fmt.Sprintf("no cluster for %v found in datacenter %s", req.Keyspace, req.DataCenter)
 		return
 	}
 
-	dc := ms.topo.dataCenter
+	dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter)
+	if !found {
+		resp.Error = fmt.Sprintf("no datacenter %s found", req.DataCenter)
+		return
+	}
 
 	if cluster.GetNextCluster() != nil && cluster.GetNextCluster().CurrentSize() > 0 {
 		resp.Error = fmt.Sprintf("cluster %s is resizing %d => %d in progress ...",
@@ -42,6 +70,11 @@ func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest
 		return
 	}
 
+	if cluster.ShardCount() > 0 && int(req.GetTargetClusterSize()) > cluster.ShardCount() {
+		resp.Error = fmt.Sprintf("cluster %s has only %d shards for %d servers", req.Keyspace, cluster.ShardCount(), req.TargetClusterSize)
+		return
+	}
+
 	var existingServers, newServers []*pb.StoreResource
 	for i := 0; i < cluster.ExpectedSize(); i++ {
 		if node, found := cluster.GetNode(i, 0); found {
@@ -55,7 +88,7 @@ func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest
 		var allocateErr error
 		// TODO proper quota alocation
 		eachShardSizeGb := uint32(1)
-		newServers, allocateErr = allocateServers(cluster, dc, int(req.TargetClusterSize)-cluster.ExpectedSize(), float64(eachShardSizeGb))
+		newServers, allocateErr = allocateServers(cluster, dc, existingServers, int(req.TargetClusterSize)-cluster.ExpectedSize(), float64(eachShardSizeGb))
 		if allocateErr != nil {
 			glog.Errorf("allocateServers %v: %v", req, err)
 			resp.Error = fmt.Sprintf("fail to allocate %d servers: %v", int(req.TargetClusterSize)-cluster.ExpectedSize(), allocateErr)
@@ -66,41 +99,175 @@ func (ms *masterServer) ResizeCluster(ctx context.Context, req *pb.ResizeRequest
 		// shrink the cluster
 	}
 
-	// 2. create missing shards on existing servers, create new shards on new servers
+	if req.DryRun {
+		fromServers := clusterServers(cluster)
+		toServers := append(append([]*pb.StoreResource{}, fromServers...), newServers...)[:req.TargetClusterSize]
+		resp.Plan = planTopologyChange(req.Keyspace, fromServers, toServers, allServerIds(len(toServers)),
+			cluster.ShardCount(), cluster.ReplicationFactor(), dc.getShardSizes(req.Keyspace))
+		return
+	}
+
+	job, startErr := ms.resizeJobs.startJob(adminJob.id(), req.Keyspace, req.DataCenter, cluster.ExpectedSize(), int(req.TargetClusterSize), cluster.ShardCount(), cluster.ReplicationFactor())
+	if startErr != nil {
+		resp.Error = startErr.Error()
+		return
+	}
 	servers := append(existingServers, newServers...)
-	if err = resizeCreateShards(ctx, req.Keyspace, uint32(cluster.ExpectedSize()), req.TargetClusterSize, uint32(cluster.ReplicationFactor()), servers); err != nil {
+	job.setServers(servers)
+	job.adminJob = adminJob
+	adminJob.setOnCancel(job.cancel)
+
+	isStarted = true
+
+	go func() {
+		defer ms.unlock(req.Keyspace)
+		ms.runResizeJob(job, req, keyspace, cluster, dc, servers, existingServers, newServers)
+	}()
+
+	return
+}
+
+func (ms *masterServer) runResizeJob(job *resizeJob, req *pb.ResizeRequest, keyspace *keyspace, cluster *topology.Cluster, dc *dataCenter,
+	servers, existingServers, newServers []*pb.StoreResource) {
+
+	glog.V(1).Infof("resize job %s starts: %v", job.job.Id, req)
+
+	// 2. create missing shards on existing servers, create new shards on new servers
+	job.setStep("create shards")
+	if err := resizeCreateShards(job.ctx, req.Keyspace, uint32(cluster.ExpectedSize()), req.TargetClusterSize, uint32(cluster.ShardCount()), uint32(cluster.ReplicationFactor()), keyspace.indexes, servers); err != nil {
 		glog.Errorf("resizeCreateShards %v: %v", req, err)
-		resp.Error = err.Error()
+		ms.rollbackResize(job, req, cluster, servers, err)
 		return
 	}
 
+	// the job can not be cancelled after this point
+	if !job.startCommit(job.shardProgresses(cluster, dc)) {
+		ms.rollbackResize(job, req, cluster, servers, nil)
+		return
+	}
+	ctx := context.Background()
+
 	// 3. tell all servers to commit the new shards, adjust local cluster size, status, etc, not informing the master of shard info changes
-	if err = resizeCommit(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
-		resp.Error = err.Error()
+	job.setStep("commit")
+	if err := resizeCommit(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
+		glog.Errorf("resizeCommit %v: %v", req, err)
+		job.finish(pb.ResizeJob_FAILED, err)
 		return
 	}
 
-	if err = ms.adjustAndBroadcastUpcomingShardStatuses(ctx, req, cluster, servers, existingServers); err != nil {
+	if err := ms.adjustAndBroadcastUpcomingShardStatuses(ctx, req, cluster, servers, existingServers); err != nil {
 		glog.Errorf("adjustAndBroadcastUpcomingShardStatuses %v: %v", req, err)
-		resp.Error = err.Error()
+		job.finish(pb.ResizeJob_FAILED, err)
 		return
 	}
 
 	// 3. cleanup old shards
-	if err = resizeCleanup(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
+	job.setStep("cleanup")
+	if err := resizeCleanup(ctx, req.Keyspace, req.TargetClusterSize, servers); err != nil {
 		glog.Errorf("resizeCleanup %v: %v", req, err)
-		resp.Error = err.Error()
+		job.finish(pb.ResizeJob_FAILED, err)
 		return
 	}
 
 	cluster.SetExpectedSize(int(req.TargetClusterSize))
 
+	// 4. the new servers follow the keyspace settings
+	if keyspace.settings != nil && len(newServers) > 0 {
+		job.setStep("update settings")
+		if err := updateKeyspaceSettingsOnShards(ctx, &pb.UpdateKeyspaceRequest{
+			Keyspace: req.Keyspace,
+			Settings: keyspace.settings,
+		}, newServers); err != nil {
+			glog.Errorf("updateKeyspaceSettingsOnShards %v: %v", req, err)
+			job.finish(pb.ResizeJob_FAILED, err)
+			return
+		}
+	}
+
+	glog.V(1).Infof("resize job %s succeeded", job.job.Id)
+	job.finish(pb.ResizeJob_SUCCEEDED, nil)
+}
+
+// rollbackResize deletes the new shards and keeps the current cluster size,
+// when the resize is cancelled or fails before committing.
+func (ms *masterServer) rollbackResize(job *resizeJob, req *pb.ResizeRequest, cluster *topology.Cluster, servers []*pb.StoreResource, cause error) {
+
+	glog.V(1).Infof("resize job %s rolls back: %v", job.job.Id, cause)
+
+	job.setStep("rollback")
+
+	state := pb.ResizeJob_FAILED
+	if job.isCancelling() {
+		state, cause = pb.ResizeJob_CANCELLED, nil
+	}
+
+	if err := resizeCleanup(context.Background(), req.Keyspace, uint32(cluster.ExpectedSize()), servers); err != nil {
+		glog.Errorf("resizeCleanup %v: %v", req, err)
+		if cause == nil {
+			cause = fmt.Errorf("rollback: %v", err)
+		} else {
+			cause = fmt.Errorf("%v, rollback: %v", cause, err)
+		}
+	}
+
+	// clients drop the candidate shards
+	if candidateCluster := cluster.GetNextCluster(); candidateCluster != nil {
+		for _, logicalShardGroup := range candidateCluster.GetAllShards() {
+			for _, node := range logicalShardGroup {
+				ms.notifyDeletion(node.ShardInfo, node.StoreResource)
+				glog.V(1).Infof("drop candidate shard %v on %s", node.ShardInfo.IdentifierOnThisServer(), node.StoreResource.GetAddress())
+			}
+		}
+	}
+	cluster.RemoveNextCluster()
+
+	job.finish(state, cause)
+}
+
+func (ms *masterServer) GetResizeStatus(ctx context.Context, req *pb.ResizeStatusRequest) (resp *pb.ResizeStatusResponse, err error) {
+
+	resp = &pb.ResizeStatusResponse{}
+
+	job, found := ms.resizeJobs.getJob(req.Keyspace, req.DataCenter)
+	if !found {
+		resp.Error = fmt.Sprintf("no resize job found for %s in datacenter %s", req.Keyspace, req.DataCenter)
+		return
+	}
+
+	var cluster *topology.Cluster
+	if keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace); found {
+		cluster = keyspace.getCluster(req.DataCenter)
+	}
+	dc, _ := ms.topo.dataCenters.getDataCenter(req.DataCenter)
+
+	resp.Job = job.toResizeJob(cluster, dc)
+
+	return
+}
+
+func (ms *masterServer) CancelResize(ctx context.Context, req *pb.CancelResizeRequest) (resp *pb.CancelResizeResponse, err error) {
+
+	resp = &pb.CancelResizeResponse{}
+
+	job, found := ms.resizeJobs.getJob(req.Keyspace, req.DataCenter)
+	if !found {
+		resp.Error = fmt.Sprintf("no resize job found for %s in datacenter %s", req.Keyspace, req.DataCenter)
+		return
+	}
+
+	if cancelErr := job.cancel(); cancelErr != nil {
+		resp.Error = cancelErr.Error()
+		return
+	}
+
+	glog.V(1).Infof("resize job %s is cancelling", job.job.Id)
+
 	return
 }
 
 // TODO add tags for filtering
-func allocateServers(cluster *topology.Cluster, dc *dataCenter, serverCount int, eachShardSizeGb float64) ([]*pb.StoreResource, error) {
-	servers, err := dc.allocateServers(serverCount, eachShardSizeGb,
+func allocateServers(cluster *topology.Cluster, dc *dataCenter, existingServers []*pb.StoreResource, serverCount int, eachShardSizeGb float64) ([]*pb.StoreResource, error) {
+	servers, err := dc.allocateServers(existingServers, serverCount, cluster.ReplicationFactor(), eachShardSizeGb,
 		func(resource *pb.StoreResource) bool {
 
 			for i := 0; i < cluster.ExpectedSize(); i++ {
@@ -117,7 +284,7 @@ func allocateServers(cluster *topology.Cluster, dc *dataCenter, serverCount int,
 	return servers, err
 }
 
-func resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targetClusterSize, replicationFactor uint32, stores []*pb.StoreResource) error {
+func resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targetClusterSize, shardCount, replicationFactor uint32, indexes *pb.KeyspaceIndexes, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
@@ -130,6 +297,8 @@ func resizeCreateShards(ctx context.Context, keyspace string, clusterSize, targe
 				ClusterSize:       clusterSize,
 				ReplicationFactor: replicationFactor,
 				TargetClusterSize: targetClusterSize,
+				ShardCount:        shardCount,
+				Indexes:           indexes,
 			}
 
 			glog.V(1).Infof("resize create shard on %v: %v", store.AdminAddress, request)
@@ -200,7 +369,7 @@ func (ms *masterServer) adjustAndBroadcastUpcomingShardStatuses(ctx context.Cont
 	var toBeRemoved []*pb.ClusterNode
 	for _, logicalShardGroup := range cluster.GetAllShards() {
 		for _, node := range logicalShardGroup {
-			if topology.IsShardInLocal(int(node.ShardInfo.ShardId), int(node.ShardInfo.ServerId), newClusterSize, replicationFactor) {
+			if topology.IsVirtualShardInLocal(int(node.ShardInfo.ShardId), int(node.ShardInfo.ServerId), newClusterSize, cluster.ShardCount(), replicationFactor) {
 				if int(node.ShardInfo.ClusterSize) != newClusterSize {
 					node.ShardInfo.ClusterSize = uint32(newClusterSize)
 					ms.notifyUpdate(node.ShardInfo, node.GetStoreResource())
@@ -214,7 +383,7 @@ func (ms *masterServer) adjustAndBroadcastUpcomingShardStatuses(ctx context.Cont
 	}
 
 	// notify the new cluster size, clients can write to the new set of servers now
-	ms.clientChans.notifyClusterResize(keyspaceName(req.Keyspace), uint32(oldClusterSize), req.TargetClusterSize)
+	ms.clientChans.notifyClusterResize(keyspaceName(req.Keyspace), req.DataCenter, uint32(oldClusterSize), req.TargetClusterSize)
 
 	// wait a bit for the slow-to-change clients
 	time.Sleep(5 * time.Second)
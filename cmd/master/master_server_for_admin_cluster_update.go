@@ -0,0 +1,48 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// UpdateKeyspace changes the keyspace settings on all stores of the keyspace, in all data centers.
+func (ms *masterServer) UpdateKeyspace(ctx context.Context, req *pb.UpdateKeyspaceRequest) (resp *pb.UpdateKeyspaceResponse, err error) {
+
+	ms.lock(req.Keyspace)
+	defer ms.unlock(req.Keyspace)
+
+	resp = &pb.UpdateKeyspaceResponse{}
+
+	if err = pb.ValidateKeyspaceSettings(req.Settings); err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	keyspace, found := ms.topo.keyspaces.getKeyspace(req.Keyspace)
+	if !found {
+		resp.Error = fmt.Sprintf("no keyspace %v found", req.Keyspace)
+		return
+	}
+
+	if len(keyspace.getClusters()) == 0 {
+		resp.Error = fmt.Sprintf("no cluster %v created", req.Keyspace)
+		return
+	}
+
+	req.Settings.Keyspace = req.Keyspace
+	req.Settings.UpdatedAtNs = uint64(time.Now().UnixNano())
+
+	servers := keyspace.getPrimaryServers()
+
+	if err = updateKeyspaceSettingsOnShards(ctx, req, servers); err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	keyspace.settings = req.Settings
+
+	return resp, nil
+}
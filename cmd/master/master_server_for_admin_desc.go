@@ -14,11 +14,16 @@ func (ms *masterServer) Describe(ctx context.Context, req *pb.DescribeRequest) (
 	if req.GetDescCluster() != nil {
 		keyspace, found := ms.topo.keyspaces.getKeyspace(req.DescCluster.Keyspace)
 		if found {
-			cluster := keyspace.cluster
+			cluster := keyspace.getCluster(keyspace.preferredDataCenter(req.DescCluster.DataCenter))
 			if cluster != nil {
 				resp.DescCluster = &pb.DescribeResponse_DescCluster{
-					Cluster:     cluster.ToCluster(),
-					ClientCount: uint32(ms.clientsStat.getKeyspaceClientCount(keyspace.name)),
+					Cluster:           cluster.ToCluster(),
+					ClientCount:       uint32(ms.clientsStat.getKeyspaceClientCount(keyspace.name)),
+					ReplicationLags:   keyspace.getReplicationLags(),
+					PlacementWarnings: placementWarnings(clusterServers(cluster), cluster.ReplicationFactor()),
+				}
+				if dc, found := ms.topo.dataCenters.getDataCenter(cluster.DataCenter()); found {
+					resp.DescCluster.BootstrapProgresses = dc.getBootstrapProgresses(string(keyspace.name))
 				}
 				if cluster.GetNextCluster() != nil {
 					resp.DescCluster.NextCluster = cluster.GetNextCluster().ToCluster()
@@ -27,18 +32,24 @@ func (ms *masterServer) Describe(ctx context.Context, req *pb.DescribeRequest) (
 		}
 	}
 	if req.GetDescDataCenters() != nil {
-		resp.DescDataCenter = &pb.DescribeResponse_DescDataCenter{}
-
-		dataCenter := ms.topo.dataCenter
-		var servers []*pb.StoreResource
-		dataCenter.RLock()
-		for _, server := range dataCenter.servers {
-			t := server
-			servers = append(servers, t)
+		resp.DescDataCenter = &pb.DescribeResponse_DescDataCenter{
+			DataCenter: &pb.DescribeResponse_DescDataCenter_DataCenter{},
 		}
-		dataCenter.RUnlock()
-		resp.DescDataCenter.DataCenter = &pb.DescribeResponse_DescDataCenter_DataCenter{
-			StoreResources: servers,
+
+		for _, dataCenter := range ms.topo.dataCenters.getDataCenters() {
+			var servers []*pb.StoreResource
+			dataCenter.RLock()
+			for _, server := range dataCenter.servers {
+				t := server
+				servers = append(servers, t)
+			}
+			dataCenter.RUnlock()
+			resp.DescDataCenter.DataCenters = append(resp.DescDataCenter.DataCenters, &pb.DescribeResponse_DescDataCenter_DataCenter{
+				DataCenter:        string(dataCenter.name),
+				StoreResources:    servers,
+				DrainingAddresses: dataCenter.getDrainingAddresses(),
+			})
+			resp.DescDataCenter.DataCenter.StoreResources = append(resp.DescDataCenter.DataCenter.StoreResources, servers...)
 		}
 	}
 
@@ -47,7 +58,9 @@ func (ms *masterServer) Describe(ctx context.Context, req *pb.DescribeRequest) (
 		ms.topo.keyspaces.RLock()
 		for keyspaceName, keyspace := range ms.topo.keyspaces.keyspaces {
 			var clusters []*pb.Cluster
-			clusters = append(clusters, keyspace.cluster.ToCluster())
+			for _, cluster := range keyspace.getClusters() {
+				clusters = append(clusters, cluster.ToCluster())
+			}
 			resp.DescKeyspaces.Keyspaces = append(resp.DescKeyspaces.Keyspaces,
 				&pb.DescribeResponse_DescKeyspaces_Keyspace{
 					Keyspace:    string(keyspaceName),
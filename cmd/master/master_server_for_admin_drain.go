@@ -0,0 +1,103 @@
+package master
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// DrainStore marks the store as draining, so no new shards are allocated to it,
+// and moves the nodes of all clusters on the store to other stores.
+// A dry run only plans the moves.
+func (ms *masterServer) DrainStore(ctx context.Context, req *pb.DrainStoreRequest) (resp *pb.DrainStoreResponse, err error) {
+
+	resp = &pb.DrainStoreResponse{}
+
+	dc, found := ms.topo.dataCenters.getDataCenter(req.DataCenter)
+	if !found {
+		resp.Error = fmt.Sprintf("no datacenter %s found", req.DataCenter)
+		return
+	}
+
+	if req.Cancel {
+		dc.setDraining(req.Address, false)
+		resp.IsEmpty = !ms.storeHasNodes(dc, req.Address)
+		return
+	}
+
+	if !req.DryRun {
+		dc.setDraining(req.Address, true)
+	}
+
+	resp.Moves, err = ms.planDrain(dc, req.Address)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	if !req.DryRun {
+		doneMoves, moveErr := ms.runMoves(ctx, req.DataCenter, resp.Moves, 0)
+		resp.DoneMoves = uint32(doneMoves)
+		if moveErr != nil {
+			resp.Error = moveErr.Error()
+		}
+	}
+
+	resp.IsEmpty = !ms.storeHasNodes(dc, req.Address)
+
+	return resp, nil
+}
+
+func (ms *masterServer) planDrain(dc *dataCenter, address string) ([]*pb.ShardMove, error) {
+
+	var servers []*pb.StoreResource
+	drainingServer := &pb.StoreResource{Address: address}
+	dc.RLock()
+	for a, server := range dc.servers {
+		if string(a) == address {
+			drainingServer = server
+			continue
+		}
+		if !dc.draining[a] {
+			servers = append(servers, server)
+		}
+	}
+	dc.RUnlock()
+
+	return planDrainMoves(drainingServer, servers, ms.balanceNodes(dc), dc.getDiskUsages())
+}
+
+// planDrainMoves plans to move all nodes off the draining server to the other servers
+func planDrainMoves(drainingServer *pb.StoreResource, servers []*pb.StoreResource, nodes []*balanceNode, usages map[serverAddress][]*pb.ShardDiskUsage) ([]*pb.ShardMove, error) {
+
+	plan := newBalancePlan(append(servers, drainingServer), nodes, usages, 0)
+	for _, store := range plan.storeList {
+		store.isTarget = store.resource != drainingServer
+	}
+
+	if err := plan.moveOffNonTargets(); err != nil {
+		return nil, err
+	}
+
+	return plan.moves, nil
+}
+
+// storeHasNodes checks whether any cluster, or any cluster being changed, still has the store
+func (ms *masterServer) storeHasNodes(dc *dataCenter, address string) bool {
+	ms.topo.keyspaces.RLock()
+	defer ms.topo.keyspaces.RUnlock()
+	for _, keyspace := range ms.topo.keyspaces.keyspaces {
+		cluster := keyspace.getCluster(string(dc.name))
+		if cluster == nil {
+			continue
+		}
+		if serversContains(clusterServers(cluster), address) {
+			return true
+		}
+		if nextCluster := cluster.GetNextCluster(); nextCluster != nil && serversContains(clusterServers(nextCluster), address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package master
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func (ms *masterServer) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (resp *pb.ListJobsResponse, err error) {
+
+	resp = &pb.ListJobsResponse{
+		Jobs: ms.adminJobs.list(req.Keyspace, req.DataCenter, int(req.Limit)),
+	}
+
+	return resp, nil
+}
+
+func (ms *masterServer) GetJob(ctx context.Context, req *pb.GetJobRequest) (resp *pb.GetJobResponse, err error) {
+
+	resp = &pb.GetJobResponse{}
+
+	job, found := ms.adminJobs.getJob(req.Id)
+	if !found {
+		resp.Error = fmt.Sprintf("no job %s found", req.Id)
+		return
+	}
+
+	resp.Job = job.toAdminJob()
+
+	return resp, nil
+}
+
+func (ms *masterServer) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (resp *pb.CancelJobResponse, err error) {
+
+	resp = &pb.CancelJobResponse{}
+
+	job, found := ms.adminJobs.getJob(req.Id)
+	if !found {
+		resp.Error = fmt.Sprintf("no job %s found", req.Id)
+		return
+	}
+
+	if cancelErr := job.cancel(); cancelErr != nil {
+		resp.Error = cancelErr.Error()
+		return
+	}
+
+	job.logStep("cancelling")
+
+	return resp, nil
+}
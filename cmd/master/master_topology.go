@@ -3,6 +3,7 @@ package master
 import (
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/topology"
+	"sort"
 	"sync"
 )
 
@@ -21,15 +22,39 @@ shard:
 
 type keyspaceName string
 type serverAddress string
+type dataCenterName string
 
 type dataCenter struct {
-	servers map[serverAddress]*pb.StoreResource
+	name       dataCenterName
+	servers    map[serverAddress]*pb.StoreResource
+	diskUsages map[serverAddress][]*pb.ShardDiskUsage
+	// the shards being bootstrapped on each server
+	bootstrapProgresses map[serverAddress][]*pb.BootstrapProgress
+	followLags          map[serverAddress][]*pb.FollowLag
+	// the draining servers are not allocated, and kept across reconnections
+	draining map[serverAddress]bool
 	sync.RWMutex
 }
 
+type dataCenters struct {
+	sync.RWMutex
+	dataCenters map[dataCenterName]*dataCenter
+}
+
 type keyspace struct {
-	name    keyspaceName
-	cluster *topology.Cluster
+	name            keyspaceName
+	clusters        map[dataCenterName]*topology.Cluster
+	clustersLock    sync.RWMutex
+	settings        *pb.KeyspaceSettings
+	indexes         *pb.KeyspaceIndexes
+	replicationLags map[replicationLagKey]*pb.ReplicationLag
+	lagsLock        sync.Mutex
+}
+
+type replicationLagKey struct {
+	shardId          uint32
+	sourceDataCenter dataCenterName
+	targetDataCenter dataCenterName
 }
 
 type keyspaces struct {
@@ -38,8 +63,8 @@ type keyspaces struct {
 }
 
 type masterTopology struct {
-	keyspaces  *keyspaces
-	dataCenter *dataCenter
+	keyspaces   *keyspaces
+	dataCenters *dataCenters
 }
 
 func newMasterTopology() *masterTopology {
@@ -47,8 +72,8 @@ func newMasterTopology() *masterTopology {
 		keyspaces: &keyspaces{
 			keyspaces: make(map[keyspaceName]*keyspace),
 		},
-		dataCenter: &dataCenter{
-			servers: make(map[serverAddress]*pb.StoreResource),
+		dataCenters: &dataCenters{
+			dataCenters: make(map[dataCenterName]*dataCenter),
 		},
 	}
 }
@@ -58,7 +83,9 @@ func (ks *keyspaces) getOrCreateKeyspace(ksName string) *keyspace {
 	k, hasData := ks.keyspaces[keyspaceName(ksName)]
 	if !hasData {
 		k = &keyspace{
-			name: keyspaceName(ksName),
+			name:            keyspaceName(ksName),
+			clusters:        make(map[dataCenterName]*topology.Cluster),
+			replicationLags: make(map[replicationLagKey]*pb.ReplicationLag),
 		}
 		ks.keyspaces[k.name] = k
 	}
@@ -79,26 +106,170 @@ func (ks *keyspaces) removeKeyspace(ksName string) {
 	ks.Unlock()
 }
 
-func (k *keyspace) doGetOrCreateCluster(clusterSize int, replicationFactor int) (cluster *topology.Cluster, isNew bool) {
+func (k *keyspace) doGetOrCreateCluster(dc string, clusterSize int, replicationFactor int) (cluster *topology.Cluster, isNew bool) {
+
+	k.clustersLock.Lock()
+	defer k.clustersLock.Unlock()
 
-	if k.cluster == nil {
-		k.cluster = topology.NewCluster(string(k.name), clusterSize, replicationFactor)
+	cluster, found := k.clusters[dataCenterName(dc)]
+	if !found {
+		cluster = topology.NewCluster(string(k.name), clusterSize, replicationFactor)
+		cluster.SetDataCenter(dc)
+		k.clusters[dataCenterName(dc)] = cluster
 		isNew = true
 	}
 
-	cluster = k.cluster
-
 	return
 }
 
-func (k *keyspace) getOrCreateCluster(clusterSize int, replicationFactor int) *topology.Cluster {
-	cluster, _ := k.doGetOrCreateCluster(clusterSize, replicationFactor)
+func (k *keyspace) getOrCreateCluster(dc string, clusterSize int, replicationFactor int) *topology.Cluster {
+	cluster, _ := k.doGetOrCreateCluster(dc, clusterSize, replicationFactor)
 	cluster.SetExpectedSize(clusterSize)
 	cluster.SetReplicationFactor(replicationFactor)
 
 	return cluster
 }
 
+// getCluster returns the cluster of the keyspace in the data center, or nil if not found
+func (k *keyspace) getCluster(dc string) *topology.Cluster {
+	k.clustersLock.RLock()
+	defer k.clustersLock.RUnlock()
+	return k.clusters[dataCenterName(dc)]
+}
+
+// getClusters returns the created clusters of the keyspace in all data centers, ordered by the data center
+func (k *keyspace) getClusters() (clusters []*topology.Cluster) {
+	k.clustersLock.RLock()
+	for _, cluster := range k.clusters {
+		if cluster.ExpectedSize() > 0 {
+			clusters = append(clusters, cluster)
+		}
+	}
+	k.clustersLock.RUnlock()
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].DataCenter() < clusters[j].DataCenter()
+	})
+	return
+}
+
+// preferredDataCenter returns the data center if the keyspace has a cluster there,
+// or else the first other data center with a cluster of the keyspace.
+func (k *keyspace) preferredDataCenter(dc string) string {
+	if cluster := k.getCluster(dc); cluster != nil && cluster.ExpectedSize() > 0 {
+		return dc
+	}
+	for _, cluster := range k.getClusters() {
+		return cluster.DataCenter()
+	}
+	return dc
+}
+
+// getPrimaryServers returns the servers of the primary shards of the keyspace in all data centers
+func (k *keyspace) getPrimaryServers() (servers []*pb.StoreResource) {
+	for _, cluster := range k.getClusters() {
+		for i := 0; i < cluster.ExpectedSize(); i++ {
+			server, found := cluster.GetNode(i, 0)
+			if !found {
+				continue
+			}
+			servers = append(servers, server.GetStoreResource())
+		}
+	}
+	return
+}
+
+// restoreSettings keeps the latest settings reported by the stores
+func (k *keyspace) restoreSettings(settings *pb.KeyspaceSettings) {
+	if k.settings == nil || k.settings.UpdatedAtNs < settings.UpdatedAtNs {
+		k.settings = settings
+	}
+}
+
+// restoreIndexes keeps the latest index definitions reported by the stores
+func (k *keyspace) restoreIndexes(indexes *pb.KeyspaceIndexes) {
+	if k.indexes == nil || k.indexes.UpdatedAtNs < indexes.UpdatedAtNs {
+		k.indexes = indexes
+	}
+}
+
+func (k *keyspace) setReplicationLag(lag *pb.ReplicationLag) {
+	k.lagsLock.Lock()
+	k.replicationLags[replicationLagKey{
+		shardId:          lag.ShardId,
+		sourceDataCenter: dataCenterName(lag.SourceDataCenter),
+		targetDataCenter: dataCenterName(lag.TargetDataCenter),
+	}] = lag
+	k.lagsLock.Unlock()
+}
+
+// getReplicationLags returns the lags of the shards following other data centers
+func (k *keyspace) getReplicationLags() (lags []*pb.ReplicationLag) {
+	k.lagsLock.Lock()
+	for _, lag := range k.replicationLags {
+		lags = append(lags, lag)
+	}
+	k.lagsLock.Unlock()
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].TargetDataCenter != lags[j].TargetDataCenter {
+			return lags[i].TargetDataCenter < lags[j].TargetDataCenter
+		}
+		if lags[i].SourceDataCenter != lags[j].SourceDataCenter {
+			return lags[i].SourceDataCenter < lags[j].SourceDataCenter
+		}
+		return lags[i].ShardId < lags[j].ShardId
+	})
+	return
+}
+
+// removeReplicationLags forgets the lags reported by the store, when it disconnects
+func (k *keyspace) removeReplicationLags(dc string, shardIds map[uint32]bool) {
+	k.lagsLock.Lock()
+	for key := range k.replicationLags {
+		if key.targetDataCenter == dataCenterName(dc) && shardIds[key.shardId] {
+			delete(k.replicationLags, key)
+		}
+	}
+	k.lagsLock.Unlock()
+}
+
+func (dcs *dataCenters) getOrCreateDataCenter(dc string) *dataCenter {
+	dcs.Lock()
+	defer dcs.Unlock()
+	d, found := dcs.dataCenters[dataCenterName(dc)]
+	if !found {
+		d = &dataCenter{
+			name:                dataCenterName(dc),
+			servers:             make(map[serverAddress]*pb.StoreResource),
+			diskUsages:          make(map[serverAddress][]*pb.ShardDiskUsage),
+			draining:            make(map[serverAddress]bool),
+			bootstrapProgresses: make(map[serverAddress][]*pb.BootstrapProgress),
+			followLags:          make(map[serverAddress][]*pb.FollowLag),
+		}
+		dcs.dataCenters[d.name] = d
+	}
+	return d
+}
+
+func (dcs *dataCenters) getDataCenter(dc string) (d *dataCenter, found bool) {
+	dcs.RLock()
+	d, found = dcs.dataCenters[dataCenterName(dc)]
+	dcs.RUnlock()
+	return
+}
+
+// getDataCenters returns all data centers, ordered by the name
+func (dcs *dataCenters) getDataCenters() (list []*dataCenter) {
+	dcs.RLock()
+	for _, d := range dcs.dataCenters {
+		list = append(list, d)
+	}
+	dcs.RUnlock()
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].name < list[j].name
+	})
+	return
+}
+
 func (dc *dataCenter) upsertServer(storeResource *pb.StoreResource) (existing *pb.StoreResource, hasData bool) {
 	dc.Lock()
 	existing, hasData = dc.servers[serverAddress(storeResource.Address)]
@@ -114,7 +285,112 @@ func (dc *dataCenter) deleteServer(storeResource *pb.StoreResource) (existing *p
 	existing, hasData = dc.servers[serverAddress(storeResource.Address)]
 	if hasData {
 		delete(dc.servers, serverAddress(storeResource.Address))
+		delete(dc.diskUsages, serverAddress(storeResource.Address))
+		delete(dc.bootstrapProgresses, serverAddress(storeResource.Address))
+		delete(dc.followLags, serverAddress(storeResource.Address))
+	}
+	dc.Unlock()
+	return
+}
+
+func (dc *dataCenter) getServer(address string) (server *pb.StoreResource, found bool) {
+	dc.RLock()
+	server, found = dc.servers[serverAddress(address)]
+	dc.RUnlock()
+	return
+}
+
+func (dc *dataCenter) setDiskUsages(address string, usages []*pb.ShardDiskUsage) {
+	dc.Lock()
+	dc.diskUsages[serverAddress(address)] = usages
+	dc.Unlock()
+}
+
+func (dc *dataCenter) getDiskUsages() (usages map[serverAddress][]*pb.ShardDiskUsage) {
+	usages = make(map[serverAddress][]*pb.ShardDiskUsage)
+	dc.RLock()
+	for address, u := range dc.diskUsages {
+		usages[address] = u
+	}
+	dc.RUnlock()
+	return
+}
+
+func (dc *dataCenter) setBootstrapProgresses(address string, progresses []*pb.BootstrapProgress) {
+	dc.Lock()
+	if len(progresses) == 0 {
+		delete(dc.bootstrapProgresses, serverAddress(address))
+	} else {
+		dc.bootstrapProgresses[serverAddress(address)] = progresses
 	}
 	dc.Unlock()
+}
+
+// getBootstrapProgresses returns the shards of the keyspace being bootstrapped, ordered by server id and shard id
+func (dc *dataCenter) getBootstrapProgresses(keyspace string) (progresses []*pb.BootstrapProgress) {
+	dc.RLock()
+	for _, list := range dc.bootstrapProgresses {
+		for _, progress := range list {
+			if progress.Keyspace == keyspace {
+				progresses = append(progresses, progress)
+			}
+		}
+	}
+	dc.RUnlock()
+	sort.Slice(progresses, func(i, j int) bool {
+		if progresses[i].ServerId != progresses[j].ServerId {
+			return progresses[i].ServerId < progresses[j].ServerId
+		}
+		return progresses[i].ShardId < progresses[j].ShardId
+	})
+	return
+}
+
+func (dc *dataCenter) setFollowLags(address string, lags []*pb.FollowLag) {
+	dc.Lock()
+	if len(lags) == 0 {
+		delete(dc.followLags, serverAddress(address))
+	} else {
+		dc.followLags[serverAddress(address)] = lags
+	}
+	dc.Unlock()
+}
+
+func (dc *dataCenter) getFollowLags(keyspace string) (lags []*pb.FollowLag) {
+	dc.RLock()
+	for _, list := range dc.followLags {
+		for _, lag := range list {
+			if lag.Keyspace == keyspace {
+				lags = append(lags, lag)
+			}
+		}
+	}
+	dc.RUnlock()
+	return
+}
+
+func (dc *dataCenter) setDraining(address string, isDraining bool) {
+	dc.Lock()
+	if isDraining {
+		dc.draining[serverAddress(address)] = true
+	} else {
+		delete(dc.draining, serverAddress(address))
+	}
+	dc.Unlock()
+}
+
+func (dc *dataCenter) isDraining(address string) bool {
+	dc.RLock()
+	defer dc.RUnlock()
+	return dc.draining[serverAddress(address)]
+}
+
+func (dc *dataCenter) getDrainingAddresses() (addresses []string) {
+	dc.RLock()
+	for address := range dc.draining {
+		addresses = append(addresses, string(address))
+	}
+	dc.RUnlock()
+	sort.Strings(addresses)
 	return
 }
@@ -12,18 +12,22 @@ import (
 )
 
 // allocateServers
-// 1. select servers that has all the requiredTags and enough disk
+// 1. select servers that has all the requiredTags and enough disk, and are not draining
 // 2. sort by free capacity desc
-// 3. pick the top n
+// 3. pick n servers, spreading the copies of each shard across zones or racks
+// the placed servers are already on the ring, and the n servers are for the following positions
 // the actual capacity is deducted until the stores create the database and report to the master
-func (dc *dataCenter) allocateServers(n int, totalGb float64, filterFunc func(*pb.StoreResource) bool) (stores []*pb.StoreResource, err error) {
+func (dc *dataCenter) allocateServers(placed []*pb.StoreResource, n, replicationFactor int, totalGb float64, filterFunc func(*pb.StoreResource) bool) (stores []*pb.StoreResource, err error) {
 	var servers []*pb.StoreResource
 
 	eachRequiredGb := uint32(math.Ceil(totalGb / float64(n)))
 
-	// 1. select servers that has all the requiredTags and enough disk
+	// 1. select servers that has all the requiredTags and enough disk, and are not draining
 	dc.RLock()
-	for _, server := range dc.servers {
+	for address, server := range dc.servers {
+		if dc.draining[address] {
+			continue
+		}
 		if filterFunc(server) && (server.DiskSizeGb-server.AllocatedSizeGb) > eachRequiredGb {
 			servers = append(servers, server)
 		}
@@ -39,8 +43,15 @@ func (dc *dataCenter) allocateServers(n int, totalGb float64, filterFunc func(*p
 		return (servers[i].DiskSizeGb - servers[i].AllocatedSizeGb) >= (servers[j].DiskSizeGb - servers[j].AllocatedSizeGb)
 	})
 
-	// 3. pick the top n
-	return servers[:n], nil
+	// 3. pick n servers, spreading the copies of each shard across zones or racks
+	stores, err = placeServers(placed, servers, len(placed)+n, replicationFactor)
+	if err != nil {
+		return nil, err
+	}
+	for _, warning := range placementWarnings(append(placed[:len(placed):len(placed)], stores...), replicationFactor) {
+		glog.Warningf("allocate %d servers: %s", n, warning)
+	}
+	return stores, nil
 }
 
 func meetRequirement(existingTags, requiredTags []string) bool {
@@ -59,7 +70,7 @@ func meetRequirement(existingTags, requiredTags []string) bool {
 	return true
 }
 
-func createShards(ctx context.Context, keyspace string, clusterSize, replicationFactor, eachShardSizeGb uint32, stores []*pb.StoreResource) error {
+func createShards(ctx context.Context, keyspace string, clusterSize, shardCount, replicationFactor, eachShardSizeGb uint32, settings *pb.KeyspaceSettings, indexes *pb.KeyspaceIndexes, stores []*pb.StoreResource) error {
 
 	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
 		// glog.V(2).Infof"connecting to server %d at %s", serverId, store.GetAdminAddress())
@@ -72,6 +83,9 @@ func createShards(ctx context.Context, keyspace string, clusterSize, replication
 				ClusterSize:       clusterSize,
 				ReplicationFactor: replicationFactor,
 				ShardDiskSizeGb:   eachShardSizeGb,
+				Settings:          settings,
+				ShardCount:        shardCount,
+				Indexes:           indexes,
 			}
 
 			glog.V(1).Infof("create shard on %v: %v", store.AdminAddress, request)
@@ -137,6 +151,48 @@ func compactShards(ctx context.Context, req *pb.CompactClusterRequest, stores []
 
 }
 
+func defineIndexOnShards(ctx context.Context, req *pb.DefineIndexRequest, stores []*pb.StoreResource) error {
+
+	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
+		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+
+			client := pb.NewVastoStoreClient(grpcConnection)
+
+			glog.V(1).Infof("define index on %v: %v", store.AdminAddress, req)
+			resp, err := client.DefineKeyspaceIndex(ctx, req)
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("define index %s on keyspace %s on %s: %s", req.Index.Name, req.Keyspace, store.AdminAddress, resp.Error)
+			}
+			return nil
+		})
+	})
+
+}
+
+func updateKeyspaceSettingsOnShards(ctx context.Context, req *pb.UpdateKeyspaceRequest, stores []*pb.StoreResource) error {
+
+	return eachStore(stores, func(serverId int, store *pb.StoreResource) error {
+		return withConnection(store, func(grpcConnection *grpc.ClientConn) error {
+
+			client := pb.NewVastoStoreClient(grpcConnection)
+
+			glog.V(1).Infof("update keyspace settings on %v: %v", store.AdminAddress, req)
+			resp, err := client.UpdateKeyspaceSettings(ctx, req)
+			if err != nil {
+				return err
+			}
+			if resp.Error != "" {
+				return fmt.Errorf("update keyspace %s settings on %s: %s", req.Keyspace, store.AdminAddress, resp.Error)
+			}
+			return nil
+		})
+	})
+
+}
+
 func withConnection(store *pb.StoreResource, fn func(*grpc.ClientConn) error) error {
 
 	grpcConnection, err := grpc.Dial(store.GetAdminAddress(), grpc.WithInsecure())
@@ -8,7 +8,10 @@ import (
 )
 
 func (k *keyspace) debug(prefix string) {
-	k.cluster.Debug(prefix + "    ")
+	for _, cluster := range k.getClusters() {
+		fmt.Printf("%s  data center: %v\n", prefix, cluster.DataCenter())
+		cluster.Debug(prefix + "    ")
+	}
 	return
 }
 
@@ -36,7 +39,10 @@ func (topo *masterTopology) Debug() {
 	}
 	topo.keyspaces.RUnlock()
 
-	topo.dataCenter.debug(" ")
+	for _, dc := range topo.dataCenters.getDataCenters() {
+		fmt.Printf("data center: %v\n", dc.name)
+		dc.debug(" ")
+	}
 }
 
 func withConnect(node *pb.StoreResource, fn func(*grpc.ClientConn) error) error {
@@ -0,0 +1,241 @@
+package master
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+)
+
+/*
+The copies of shard i are on the servers i, i+1, ..., i+replicationFactor-1 of the ring.
+So the servers of any replicationFactor consecutive positions should be in distinct failure domains.
+
+A store declares its zone and rack by --zone and --rack, or by the tags zone=<name> and rack=<name>.
+A store without labels is its own failure domain.
+*/
+
+const (
+	maxPlacementSearchSteps = 100000
+)
+
+type failureDomainLevel int
+
+const (
+	zoneLevel failureDomainLevel = iota
+	rackLevel
+)
+
+func storeLabel(store *pb.StoreResource, name, value string) string {
+	if value != "" {
+		return value
+	}
+	prefix := name + "="
+	for _, tag := range store.Tags {
+		if strings.HasPrefix(tag, prefix) {
+			return tag[len(prefix):]
+		}
+	}
+	return ""
+}
+
+func storeZone(store *pb.StoreResource) string {
+	return storeLabel(store, "zone", store.Zone)
+}
+
+func storeRack(store *pb.StoreResource) string {
+	return storeLabel(store, "rack", store.Rack)
+}
+
+// failureDomain returns the failure domain of the store on the level
+func failureDomain(store *pb.StoreResource, level failureDomainLevel) string {
+	zone, rack := storeZone(store), storeRack(store)
+	if zone == "" && rack == "" {
+		return "server " + store.Address
+	}
+	if zone == "" {
+		// the racks without zones are not spread by zones
+		return "rack " + rack
+	}
+	if level == zoneLevel || rack == "" {
+		return "zone " + zone
+	}
+	return fmt.Sprintf("zone %s rack %s", zone, rack)
+}
+
+// ringDistance is the distance between two positions on a ring of size n
+func ringDistance(a, b, n int) int {
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	if n-d < d {
+		return n - d
+	}
+	return d
+}
+
+// placeServers picks len(positions)-len(placed) servers from the candidates, which are ordered by preference,
+// for the positions after the placed servers on the ring of the size,
+// so that the servers within replicationFactor consecutive positions are in distinct failure domains.
+// It tries to spread by zones first, then by racks.
+// If there are not enough racks, it places the servers with the fewest copies in the same rack,
+// and placementWarnings() reports the shards having copies in the same rack.
+// The conflicts among the placed servers are not checked.
+func placeServers(placed, candidates []*pb.StoreResource, size, replicationFactor int) ([]*pb.StoreResource, error) {
+
+	if size-len(placed) > len(candidates) {
+		return nil, fmt.Errorf("only has %d servers meet the requirement", len(candidates))
+	}
+
+	if replicationFactor > size {
+		replicationFactor = size
+	}
+
+	for _, level := range []failureDomainLevel{zoneLevel, rackLevel} {
+		if servers, found := searchPlacement(placed, candidates, size, replicationFactor, level); found {
+			return servers, nil
+		}
+	}
+
+	return bestEffortPlacement(placed, candidates, size, replicationFactor), nil
+}
+
+// bestEffortPlacement picks the candidate with the fewest conflicts for each position in turn
+func bestEffortPlacement(placed, candidates []*pb.StoreResource, size, replicationFactor int) []*pb.StoreResource {
+
+	positions := make([]*pb.StoreResource, size)
+	copy(positions, placed)
+	used := make([]bool, len(candidates))
+
+	for p := len(placed); p < size; p++ {
+		best, bestConflicts := -1, 0
+		for i, candidate := range candidates {
+			if used[i] {
+				continue
+			}
+			conflicts := placementConflicts(positions, p, candidate, replicationFactor, rackLevel)
+			if best < 0 || conflicts < bestConflicts {
+				best, bestConflicts = i, conflicts
+			}
+		}
+		used[best], positions[p] = true, candidates[best]
+	}
+
+	return positions[len(placed):]
+}
+
+func searchPlacement(placed, candidates []*pb.StoreResource, size, replicationFactor int, level failureDomainLevel) ([]*pb.StoreResource, bool) {
+
+	positions := make([]*pb.StoreResource, size)
+	copy(positions, placed)
+	used := make([]bool, len(candidates))
+	steps := 0
+
+	var search func(p int) bool
+	search = func(p int) bool {
+		if p == size {
+			return true
+		}
+		for i, candidate := range candidates {
+			if used[i] {
+				continue
+			}
+			if steps++; steps > maxPlacementSearchSteps {
+				return false
+			}
+			if hasPlacementConflict(positions, p, candidate, replicationFactor, level) {
+				continue
+			}
+			used[i], positions[p] = true, candidate
+			if search(p + 1) {
+				return true
+			}
+			used[i], positions[p] = false, nil
+		}
+		return false
+	}
+
+	if !search(len(placed)) {
+		return nil, false
+	}
+	return positions[len(placed):], true
+}
+
+func hasPlacementConflict(positions []*pb.StoreResource, p int, candidate *pb.StoreResource, replicationFactor int, level failureDomainLevel) bool {
+	return placementConflicts(positions, p, candidate, replicationFactor, level) > 0
+}
+
+// placementConflicts counts the servers within replicationFactor positions in the same failure domain as the candidate
+func placementConflicts(positions []*pb.StoreResource, p int, candidate *pb.StoreResource, replicationFactor int, level failureDomainLevel) (conflicts int) {
+	domain := failureDomain(candidate, level)
+	for q, server := range positions {
+		if server == nil || q == p || ringDistance(p, q, len(positions)) >= replicationFactor {
+			continue
+		}
+		if failureDomain(server, level) == domain {
+			conflicts++
+		}
+	}
+	return
+}
+
+// placementWarnings lists the shards having more than one copy in the same rack
+func placementWarnings(servers []*pb.StoreResource, replicationFactor int) (warnings []string) {
+	size := len(servers)
+	if replicationFactor > size {
+		replicationFactor = size
+	}
+	for shardId := 0; shardId < size; shardId++ {
+		seen := make(map[string]int)
+		for i := 0; i < replicationFactor; i++ {
+			server := servers[(shardId+i)%size]
+			if server == nil {
+				continue
+			}
+			seen[failureDomain(server, rackLevel)]++
+		}
+		for i := 0; i < replicationFactor; i++ {
+			server := servers[(shardId+i)%size]
+			if server == nil {
+				continue
+			}
+			domain := failureDomain(server, rackLevel)
+			if seen[domain] > 1 {
+				warnings = append(warnings, fmt.Sprintf("shard %d has %d copies in %s", shardId, seen[domain], domain))
+				delete(seen, domain)
+			}
+		}
+	}
+	return
+}
+
+// clusterServers lists the servers of the cluster by server id, nil if the server is missing
+func clusterServers(cluster *topology.Cluster) (servers []*pb.StoreResource) {
+	for i := 0; i < cluster.ExpectedSize(); i++ {
+		if node, found := cluster.GetNode(i, 0); found {
+			servers = append(servers, node.StoreResource)
+		} else {
+			servers = append(servers, nil)
+		}
+	}
+	return
+}
+
+// checkReplacementPlacement rejects the new server if it puts more copies of any shard in the same rack
+func checkReplacementPlacement(servers []*pb.StoreResource, replicationFactor, serverId int, newStore *pb.StoreResource) error {
+	if serverId >= len(servers) {
+		return nil
+	}
+	before := placementWarnings(servers, replicationFactor)
+	replaced := make([]*pb.StoreResource, len(servers))
+	copy(replaced, servers)
+	replaced[serverId] = newStore
+	after := placementWarnings(replaced, replicationFactor)
+	if len(after) > len(before) {
+		return fmt.Errorf("server %s is in the same failure domain as other copies: %s",
+			newStore.Address, strings.Join(after, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/magiconair/properties/assert"
+)
+
+func newStoreInRack(i int, zone, rack string) *pb.StoreResource {
+	return &pb.StoreResource{
+		Address: fmt.Sprintf("localhost:%d", 7000+i),
+		Zone:    zone,
+		Tags:    []string{"ssd", "rack=" + rack},
+	}
+}
+
+func TestPlaceServersAcrossRacks(t *testing.T) {
+
+	// sorted by free capacity, the first servers are all in rack r1
+	candidates := []*pb.StoreResource{
+		newStoreInRack(0, "", "r1"),
+		newStoreInRack(1, "", "r1"),
+		newStoreInRack(2, "", "r1"),
+		newStoreInRack(3, "", "r2"),
+		newStoreInRack(4, "", "r2"),
+		newStoreInRack(5, "", "r3"),
+	}
+
+	servers, err := placeServers(nil, candidates, 3, 3)
+	assert.Equal(t, err, nil, "place 3 servers")
+	assert.Equal(t, len(placementWarnings(servers, 3)), 0, "no copies in the same rack")
+
+	servers, err = placeServers(nil, candidates, 6, 2)
+	assert.Equal(t, err, nil, "place 6 servers")
+	assert.Equal(t, len(placementWarnings(servers, 2)), 0, "no neighbors in the same rack")
+
+	// only 2 racks for 3 copies, each shard has 2 copies in one rack
+	servers, err = placeServers(nil, candidates[:5], 3, 3)
+	assert.Equal(t, err, nil, "place 3 servers on 2 racks")
+	assert.Equal(t, len(servers), 3, "place 3 servers on 2 racks")
+	assert.Equal(t, len(placementWarnings(servers, 3)), 3, "copies in the same rack")
+
+	// 2 racks can not alternate on a ring of 5, and only one shard has 2 copies in one rack
+	servers, err = placeServers(nil, candidates[:5], 5, 2)
+	assert.Equal(t, err, nil, "place 5 servers on 2 racks")
+	assert.Equal(t, len(placementWarnings(servers, 2)), 1, "fewest copies in the same rack")
+
+}
+
+func TestPlaceServersPrefersZones(t *testing.T) {
+
+	candidates := []*pb.StoreResource{
+		newStoreInRack(0, "z1", "r1"),
+		newStoreInRack(1, "z1", "r2"),
+		newStoreInRack(2, "z2", "r3"),
+		newStoreInRack(3, "z2", "r4"),
+	}
+
+	servers, err := placeServers(nil, candidates, 2, 2)
+	assert.Equal(t, err, nil, "place 2 servers")
+	assert.Equal(t, storeZone(servers[0]) != storeZone(servers[1]), true, "copies in distinct zones")
+
+	// the existing servers are kept, and the new servers avoid their racks
+	servers, err = placeServers(candidates[:1], candidates[1:], 3, 3)
+	assert.Equal(t, err, nil, "grow to 3 servers")
+	assert.Equal(t, len(servers), 2, "new servers")
+	assert.Equal(t, len(placementWarnings(append(candidates[:1:1], servers...), 3)), 0, "no copies in the same rack")
+
+}
+
+func TestPlacementWarnings(t *testing.T) {
+
+	servers := []*pb.StoreResource{
+		newStoreInRack(0, "", "r1"),
+		newStoreInRack(1, "", "r1"),
+		newStoreInRack(2, "", "r2"),
+		{Address: "localhost:7003"},
+	}
+
+	warnings := placementWarnings(servers, 2)
+	assert.Equal(t, warnings, []string{"shard 0 has 2 copies in rack r1"}, "warnings")
+
+}
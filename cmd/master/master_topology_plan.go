@@ -0,0 +1,117 @@
+package master
+
+import (
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+)
+
+// shardSizes are the sizes of the shards of one keyspace, as reported by the stores
+type shardSizes map[topology.ClusterShard]uint64
+
+func (dc *dataCenter) getShardSizes(keyspace string) shardSizes {
+	sizes := make(shardSizes)
+	for _, usages := range dc.getDiskUsages() {
+		for _, usage := range usages {
+			if usage.Keyspace != keyspace {
+				continue
+			}
+			sizes[topology.ClusterShard{ShardId: int(usage.ShardId), ServerId: int(usage.ServerId)}] = usage.SizeBytes
+		}
+	}
+	return sizes
+}
+
+// planTopologyChange plans the shards on the servers of serverIds, when the cluster changes from fromServers to toServers.
+// Both fromServers and toServers are indexed by server id. Nothing is bootstrapped if fromServers is empty.
+func planTopologyChange(keyspace string, fromServers, toServers []*pb.StoreResource, serverIds []int, shardCount, replicationFactor int, sizes shardSizes) *pb.TopologyChangePlan {
+
+	fromClusterSize, toClusterSize := len(fromServers), len(toServers)
+
+	plan := &pb.TopologyChangePlan{
+		Keyspace:          keyspace,
+		ClusterSize:       uint32(fromClusterSize),
+		TargetClusterSize: uint32(toClusterSize),
+	}
+
+	for _, serverId := range serverIds {
+
+		server := toServers[serverId]
+		if serverId >= fromClusterSize || fromServers[serverId].GetAddress() != server.GetAddress() {
+			plan.NewServers = append(plan.NewServers, &pb.PlannedServer{
+				ServerId: uint32(serverId),
+				Address:  server.GetAddress(),
+				Zone:     server.GetZone(),
+				Rack:     server.GetRack(),
+			})
+		}
+
+		for _, clusterShard := range topology.LocalVirtualShards(serverId, toClusterSize, shardCount, replicationFactor) {
+
+			shardPlan := &pb.ShardBootstrapPlan{
+				ServerId: uint32(serverId),
+				ShardId:  uint32(clusterShard.ShardId),
+				Address:  server.GetAddress(),
+			}
+
+			if fromClusterSize > 0 {
+				bootstrapPlan := topology.BootstrapPlanWithVirtualShards(&topology.BootstrapRequest{
+					ServerId:          serverId,
+					ShardId:           clusterShard.ShardId,
+					FromClusterSize:   fromClusterSize,
+					ToClusterSize:     toClusterSize,
+					ReplicationFactor: replicationFactor,
+				}, shardCount)
+				if len(bootstrapPlan.BootstrapSource) == 0 && len(bootstrapPlan.TransitionalFollowSource) == 0 {
+					continue
+				}
+				shardPlan.BootstrapSources = toShardLocations(bootstrapPlan.BootstrapSource, fromServers)
+				shardPlan.PickBestBootstrapSource = bootstrapPlan.PickBestBootstrapSource
+				shardPlan.TransitionalFollows = toShardLocations(bootstrapPlan.TransitionalFollowSource, fromServers)
+				shardPlan.EstimatedBytes = estimateBootstrapBytes(bootstrapPlan, sizes)
+			}
+
+			plan.Shards = append(plan.Shards, shardPlan)
+			plan.EstimatedBytes += shardPlan.EstimatedBytes
+		}
+	}
+
+	return plan
+}
+
+func allServerIds(clusterSize int) (serverIds []int) {
+	for serverId := 0; serverId < clusterSize; serverId++ {
+		serverIds = append(serverIds, serverId)
+	}
+	return
+}
+
+func toShardLocations(clusterShards []topology.ClusterShard, servers []*pb.StoreResource) (locations []*pb.ShardLocation) {
+	for _, clusterShard := range clusterShards {
+		location := &pb.ShardLocation{
+			ServerId: uint32(clusterShard.ServerId),
+			ShardId:  uint32(clusterShard.ShardId),
+		}
+		if clusterShard.ServerId < len(servers) {
+			location.Address = servers[clusterShard.ServerId].GetAddress()
+		}
+		locations = append(locations, location)
+	}
+	return
+}
+
+// estimateBootstrapBytes counts the largest copy if copying from one of the sources,
+// otherwise the part of each source that belongs to the new cluster size.
+func estimateBootstrapBytes(plan *topology.BootstrapPlan, sizes shardSizes) (bytes uint64) {
+	if plan.PickBestBootstrapSource {
+		for _, source := range plan.BootstrapSource {
+			if sizes[source] > bytes {
+				bytes = sizes[source]
+			}
+		}
+		return
+	}
+	for _, source := range plan.BootstrapSource {
+		bytes += sizes[source] / uint64(plan.ToClusterSize)
+	}
+	return
+}
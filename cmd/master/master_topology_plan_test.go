@@ -0,0 +1,87 @@
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"github.com/magiconair/properties/assert"
+)
+
+func planShardIds(plan *pb.TopologyChangePlan) (ids []string) {
+	for _, shard := range plan.Shards {
+		ids = append(ids, fmt.Sprintf("%d.%d", shard.ServerId, shard.ShardId))
+	}
+	return
+}
+
+func TestPlanGrowingCluster(t *testing.T) {
+
+	servers := newBalanceServers(4)
+
+	sizes := shardSizes{
+		{ServerId: 0, ShardId: 0}: 400,
+		{ServerId: 1, ShardId: 1}: 800,
+		{ServerId: 2, ShardId: 2}: 1200,
+		{ServerId: 0, ShardId: 2}: 1000,
+	}
+
+	plan := planTopologyChange("ks1", servers[:3], servers, []int{0, 1, 2, 3}, 0, 2, sizes)
+
+	assert.Equal(t, len(plan.NewServers), 1)
+	assert.Equal(t, plan.NewServers[0].Address, "localhost:7003")
+
+	assert.Equal(t, planShardIds(plan), []string{"0.3", "3.3", "3.2"})
+
+	// the new shard 3 takes a quarter of every shard
+	assert.Equal(t, plan.Shards[0].EstimatedBytes, uint64(600))
+	assert.Equal(t, len(plan.Shards[0].TransitionalFollows), 3)
+	// the existing shard 2 is copied from its largest copy
+	assert.Equal(t, plan.Shards[2].PickBestBootstrapSource, true)
+	assert.Equal(t, plan.Shards[2].EstimatedBytes, uint64(1200))
+
+	assert.Equal(t, plan.EstimatedBytes, uint64(2400))
+
+}
+
+func TestPlanReplaceNode(t *testing.T) {
+
+	servers := newBalanceServers(4)
+	fromServers := servers[:3]
+	toServers := []*pb.StoreResource{servers[0], servers[3], servers[2]}
+
+	sizes := shardSizes{
+		{ServerId: 0, ShardId: 1}: 300,
+		{ServerId: 2, ShardId: 2}: 500,
+	}
+
+	plan := planTopologyChange("ks1", fromServers, toServers, []int{1}, 6, 2, sizes)
+
+	assert.Equal(t, len(plan.NewServers), 1)
+	assert.Equal(t, plan.NewServers[0].ServerId, uint32(1))
+
+	var expected []string
+	for _, shard := range topology.LocalVirtualShards(1, 3, 6, 2) {
+		expected = append(expected, fmt.Sprintf("1.%d", shard.ShardId))
+	}
+	assert.Equal(t, planShardIds(plan), expected)
+
+	// each shard follows the replaced server
+	for _, shard := range plan.Shards {
+		assert.Equal(t, shard.TransitionalFollows[0].Address, "localhost:7001")
+	}
+
+}
+
+func TestPlanNewCluster(t *testing.T) {
+
+	servers := newBalanceServers(2)
+
+	plan := planTopologyChange("ks1", nil, servers, []int{0, 1}, 0, 1, nil)
+
+	assert.Equal(t, len(plan.NewServers), 2)
+	assert.Equal(t, planShardIds(plan), []string{"0.0", "1.1"})
+	assert.Equal(t, plan.EstimatedBytes, uint64(0))
+
+}
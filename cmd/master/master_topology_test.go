@@ -0,0 +1,52 @@
+package master
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/magiconair/properties/assert"
+)
+
+func TestPreferredDataCenter(t *testing.T) {
+
+	topo := newMasterTopology()
+	k := topo.keyspaces.getOrCreateKeyspace("ks")
+
+	assert.Equal(t, k.preferredDataCenter("dc1"), "dc1", "no cluster is created yet")
+
+	// a client only registered a cluster of size 0 in dc1
+	k.doGetOrCreateCluster("dc1", 0, 0)
+	k.getOrCreateCluster("dc3", 2, 1)
+	k.getOrCreateCluster("dc2", 2, 1)
+	assert.Equal(t, k.preferredDataCenter("dc1"), "dc2", "fall back to the first data center with the keyspace")
+
+	k.getOrCreateCluster("dc1", 1, 1)
+	assert.Equal(t, k.preferredDataCenter("dc1"), "dc1", "prefer the local data center")
+	assert.Equal(t, k.preferredDataCenter("dc3"), "dc3", "prefer the local data center")
+
+}
+
+func TestReplicationLags(t *testing.T) {
+
+	topo := newMasterTopology()
+	k := topo.keyspaces.getOrCreateKeyspace("ks")
+
+	k.setReplicationLag(&pb.ReplicationLag{Keyspace: "ks", ShardId: 1, SourceDataCenter: "dc1", TargetDataCenter: "dc2", LagMillisecond: 5})
+	k.setReplicationLag(&pb.ReplicationLag{Keyspace: "ks", ShardId: 0, SourceDataCenter: "dc1", TargetDataCenter: "dc2", LagMillisecond: 7})
+	k.setReplicationLag(&pb.ReplicationLag{Keyspace: "ks", ShardId: 0, SourceDataCenter: "dc2", TargetDataCenter: "dc1", LagMillisecond: 3})
+	// a later report replaces the lag
+	k.setReplicationLag(&pb.ReplicationLag{Keyspace: "ks", ShardId: 1, SourceDataCenter: "dc1", TargetDataCenter: "dc2", LagMillisecond: 9})
+
+	lags := k.getReplicationLags()
+	assert.Equal(t, len(lags), 3, "one lag per shard and data center pair")
+	assert.Equal(t, lags[0].TargetDataCenter, "dc1", "ordered by the target data center")
+	assert.Equal(t, lags[1].ShardId, uint32(0), "ordered by the shard")
+	assert.Equal(t, lags[2].LagMillisecond, int64(9), "the latest lag")
+
+	// the store of shard 0 in dc2 disconnects
+	k.removeReplicationLags("dc2", map[uint32]bool{0: true})
+	lags = k.getReplicationLags()
+	assert.Equal(t, len(lags), 2, "forget the lags of the disconnected store")
+	assert.Equal(t, lags[1].ShardId, uint32(1), "keep the lags of other shards")
+
+}
@@ -21,12 +21,17 @@ func (c *commandCreateKeyspace) Name() string {
 }
 
 func (c *commandCreateKeyspace) Help() string {
-	return "<cluster_name> <server count> <replication factor>"
+	return "<cluster_name> <server count> <replication factor> [shard count] [plan]"
 }
 
 func (c *commandCreateKeyspace) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
-	if len(args) != 3 {
+	isPlan := len(args) > 0 && args[len(args)-1] == "plan"
+	if isPlan {
+		args = args[:len(args)-1]
+	}
+
+	if len(args) != 3 && len(args) != 4 {
 		return errInvalidArguments
 	}
 
@@ -55,7 +60,29 @@ func (c *commandCreateKeyspace) Do(vastoClient *vs.VastoClient, args []string, c
 		return errInvalidArguments
 	}
 
-	cluster, err := vastoClient.CreateCluster(keyspace, int(clusterSize), int(replicationFactor))
+	var shardCount uint64
+	if len(args) == 4 {
+		shardCount, err = strconv.ParseUint(args[3], 10, 64)
+		if err != nil {
+			println("can not parse shard count", args[3])
+			return errInvalidArguments
+		}
+		if shardCount < clusterSize {
+			println("shard count", shardCount, "should not be less than cluster size", clusterSize)
+			return errInvalidArguments
+		}
+	}
+
+	if isPlan {
+		plan, err := vastoClient.PlanCreateCluster(keyspace, int(clusterSize), int(shardCount), int(replicationFactor))
+		if err != nil {
+			return fmt.Errorf("plan cluster request: %v", err)
+		}
+		printTopologyChangePlan(writer, plan)
+		return nil
+	}
+
+	cluster, err := vastoClient.CreateClusterWithShardCount(keyspace, int(clusterSize), int(shardCount), int(replicationFactor), nil)
 
 	if err != nil {
 		return fmt.Errorf("create cluster request: %v", err)
@@ -70,6 +97,9 @@ func printCluster(out io.Writer, cluster *pb.Cluster) {
 	if cluster != nil {
 		fmt.Fprintf(out, "Cluster Expected Size: %d\n", cluster.ExpectedClusterSize)
 		fmt.Fprintf(out, "Cluster Current  Size: %d\n", cluster.CurrentClusterSize)
+		if cluster.ShardCount > 0 {
+			fmt.Fprintf(out, "Cluster Shard   Count: %d\n", cluster.ShardCount)
+		}
 
 		for _, node := range cluster.Nodes {
 			fmt.Fprintf(out, "        * shard %v server %v %v\n",
@@ -43,10 +43,15 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 				return err
 			}
 
-			fmt.Fprintf(out, "available servers:\n")
-			for _, server := range descResponse.DescDataCenter.DataCenter.StoreResources {
-				fmt.Fprintf(out, "    server %v total:%d GB, allocated:%d GB, Tags:%s\n",
-					server.Address, server.DiskSizeGb, server.AllocatedSizeGb, server.Tags)
+			for _, dataCenter := range descResponse.DescDataCenter.DataCenters {
+				fmt.Fprintf(out, "available servers in data center %q:\n", dataCenter.DataCenter)
+				for _, address := range dataCenter.DrainingAddresses {
+					fmt.Fprintf(out, "    draining server %v\n", address)
+				}
+				for _, server := range dataCenter.StoreResources {
+					fmt.Fprintf(out, "    server %v total:%d GB, allocated:%d GB, zone:%s rack:%s Tags:%s\n",
+						server.Address, server.DiskSizeGb, server.AllocatedSizeGb, server.Zone, server.Rack, server.Tags)
+				}
 			}
 
 		}
@@ -67,7 +72,7 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 			for _, keyspace := range keyspaces {
 				fmt.Fprintf(out, "keyspace %v client:%d\n", keyspace.Keyspace, keyspace.ClientCount)
 				for _, cluster := range keyspace.Clusters {
-					fmt.Fprintf(out, "    cluster expected size %d\n", cluster.ExpectedClusterSize)
+					fmt.Fprintf(out, "    cluster data center %q expected size %d\n", cluster.DataCenter, cluster.ExpectedClusterSize)
 					for _, node := range cluster.Nodes {
 						fmt.Fprintf(out, "        * node %v shard %v %v\n",
 							node.ShardInfo.ServerId, node.ShardInfo.ShardId, node.StoreResource.Address)
@@ -82,7 +87,8 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 			context.Background(),
 			&pb.DescribeRequest{
 				DescCluster: &pb.DescribeRequest_DescCluster{
-					Keyspace: param,
+					Keyspace:   param,
+					DataCenter: commandEnv.dataCenter,
 				},
 			},
 		)
@@ -95,6 +101,7 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 			return fmt.Errorf("no cluster keyspace(%v) found", param)
 		}
 
+		fmt.Fprintf(out, "Cluster Data Center  : %q\n", descResponse.DescCluster.GetCluster().DataCenter)
 		fmt.Fprintf(out, "Cluster Client Count : %d\n", descResponse.DescCluster.ClientCount)
 		printCluster(out, descResponse.DescCluster.GetCluster())
 		if descResponse.DescCluster.GetNextCluster() != nil {
@@ -110,6 +117,17 @@ func (c *commandDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 				}
 			}
 		}
+		for _, warning := range descResponse.DescCluster.PlacementWarnings {
+			fmt.Fprintf(out, "warning: %s\n", warning)
+		}
+		for _, progress := range descResponse.DescCluster.BootstrapProgresses {
+			fmt.Fprintf(out, "bootstrap shard %d.%d from %s copied %d entries %d bytes, %d retries\n",
+				progress.ServerId, progress.ShardId, progress.Source, progress.CopiedEntries, progress.CopiedBytes, progress.Retries)
+		}
+		for _, lag := range descResponse.DescCluster.ReplicationLags {
+			fmt.Fprintf(out, "replication %q => %q shard %d lag %d ms\n",
+				lag.SourceDataCenter, lag.TargetDataCenter, lag.ShardId, lag.LagMillisecond)
+		}
 
 	}
 
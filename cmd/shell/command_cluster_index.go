@@ -0,0 +1,86 @@
+package shell
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+)
+
+func init() {
+	commands = append(commands, &commandDefineIndex{})
+	commands = append(commands, &commandDropIndex{})
+}
+
+type commandDefineIndex struct {
+}
+
+func (c *commandDefineIndex) Name() string {
+	return "cluster.index.define"
+}
+
+func (c *commandDefineIndex) Help() string {
+	return "<cluster_name> <index_name> value <offset> <length> | json <path> | key <prefix length>"
+}
+
+func (c *commandDefineIndex) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if len(args) < 4 {
+		return errInvalidArguments
+	}
+
+	keyspace := args[0]
+	index := &pb.IndexDefinition{
+		Name: args[1],
+	}
+
+	switch args[2] {
+	case "value":
+		if len(args) != 5 {
+			return errInvalidArguments
+		}
+		offset, err := strconv.ParseUint(args[3], 10, 32)
+		if err != nil {
+			return errInvalidArguments
+		}
+		length, err := strconv.ParseUint(args[4], 10, 32)
+		if err != nil {
+			return errInvalidArguments
+		}
+		index.Source = pb.IndexDefinition_VALUE_BYTES
+		index.Offset, index.Length = uint32(offset), uint32(length)
+	case "json":
+		index.Source = pb.IndexDefinition_JSON_PATH
+		index.JsonPath = args[3]
+	case "key":
+		length, err := strconv.ParseUint(args[3], 10, 32)
+		if err != nil {
+			return errInvalidArguments
+		}
+		index.Source = pb.IndexDefinition_KEY_PREFIX
+		index.Length = uint32(length)
+	default:
+		return errInvalidArguments
+	}
+
+	return vastoClient.DefineIndex(keyspace, index)
+}
+
+type commandDropIndex struct {
+}
+
+func (c *commandDropIndex) Name() string {
+	return "cluster.index.drop"
+}
+
+func (c *commandDropIndex) Help() string {
+	return "<cluster_name> <index_name>"
+}
+
+func (c *commandDropIndex) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if len(args) != 2 {
+		return errInvalidArguments
+	}
+
+	return vastoClient.DropIndex(args[0], args[1])
+}
@@ -19,12 +19,12 @@ func (c *commandClusterReplaceNode) Name() string {
 }
 
 func (c *commandClusterReplaceNode) Help() string {
-	return "<cluster_name> <node_id> <new_server_ip:new_server_port>"
+	return "<cluster_name> <node_id> <new_server_ip:new_server_port> [plan]"
 }
 
 func (c *commandClusterReplaceNode) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
-	if len(args) != 3 {
+	if len(args) != 3 && len(args) != 4 {
 		return errInvalidArguments
 	}
 	keyspace := args[0]
@@ -34,6 +34,18 @@ func (c *commandClusterReplaceNode) Do(vastoClient *vs.VastoClient, args []strin
 	}
 	newAddress := args[2]
 
+	if len(args) == 4 {
+		if args[3] != "plan" {
+			return errInvalidArguments
+		}
+		plan, err := vastoClient.PlanReplaceNode(keyspace, uint32(nodeId), newAddress)
+		if err != nil {
+			return err
+		}
+		printTopologyChangePlan(writer, plan)
+		return nil
+	}
+
 	return vastoClient.ReplaceNode(keyspace, uint32(nodeId), newAddress)
 
 }
@@ -0,0 +1,37 @@
+package shell
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandChangeReplicationFactor{})
+}
+
+type commandChangeReplicationFactor struct {
+}
+
+func (c *commandChangeReplicationFactor) Name() string {
+	return "cluster.replication"
+}
+
+func (c *commandChangeReplicationFactor) Help() string {
+	return "<cluster_name> <new_replication_factor>"
+}
+
+func (c *commandChangeReplicationFactor) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) != 2 {
+		return errInvalidArguments
+	}
+	keyspace := args[0]
+	replicationFactor, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil || replicationFactor == 0 {
+		return errInvalidArguments
+	}
+
+	return vastoClient.ChangeReplicationFactor(keyspace, int(replicationFactor))
+}
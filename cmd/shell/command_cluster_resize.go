@@ -1,10 +1,15 @@
 package shell
 
 import (
+	"fmt"
 	"io"
 	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
 )
 
 func init() {
@@ -19,19 +24,123 @@ func (c *commandResizeCluster) Name() string {
 }
 
 func (c *commandResizeCluster) Help() string {
-	return "<cluster_name> <new_cluster_size>"
+	return "<cluster_name> <new_cluster_size> [plan] | <cluster_name> status|cancel, start or plan resizing the cluster, or check or cancel the resize job"
 }
 
 func (c *commandResizeCluster) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
 
-	if len(args) != 2 {
+	if len(args) != 2 && len(args) != 3 {
 		return errInvalidArguments
 	}
 	keyspace := args[0]
+	isPlan := len(args) == 3
+	if isPlan && args[2] != "plan" {
+		return errInvalidArguments
+	}
+
+	switch args[1] {
+	case "cancel":
+		return vastoClient.CancelResize(keyspace)
+	case "status":
+		job, err := vastoClient.GetResizeStatus(keyspace)
+		if err != nil {
+			return err
+		}
+		printResizeJob(writer, job)
+		return nil
+	}
+
 	newClusterSize, err := strconv.ParseUint(args[1], 10, 32)
 	if err != nil {
 		return errInvalidArguments
 	}
 
-	return vastoClient.ResizeCluster(keyspace, int(newClusterSize))
+	if isPlan {
+		plan, err := vastoClient.PlanResizeCluster(keyspace, int(newClusterSize))
+		if err != nil {
+			return err
+		}
+		printTopologyChangePlan(writer, plan)
+		return nil
+	}
+
+	jobId, err := vastoClient.StartResizeCluster(keyspace, int(newClusterSize))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "resize job %s started, check it by: cluster.resize %s status\n", jobId, keyspace)
+
+	return nil
+}
+
+func printResizeJob(writer io.Writer, job *pb.ResizeJob) {
+
+	fmt.Fprintf(writer, "resize job %s: %s %d => %d %v", job.Id, job.Keyspace, job.ClusterSize, job.TargetClusterSize, job.State)
+	if job.State == pb.ResizeJob_RUNNING {
+		fmt.Fprintf(writer, " at %s", job.Step)
+		if job.IsCancelling {
+			fmt.Fprintf(writer, ", cancelling")
+		}
+	}
+	fmt.Fprintf(writer, "\n")
+
+	startedAt := time.Unix(0, job.StartedAtNs)
+	fmt.Fprintf(writer, "started at %v", startedAt.Format(time.RFC3339))
+	if job.FinishedAtNs > 0 {
+		fmt.Fprintf(writer, ", took %v", time.Unix(0, job.FinishedAtNs).Sub(startedAt))
+	}
+	fmt.Fprintf(writer, "\n")
+
+	if job.Error != "" {
+		fmt.Fprintf(writer, "error: %s\n", job.Error)
+	}
+
+	for _, shard := range job.Shards {
+		status := "bootstrapping"
+		if shard.IsReady {
+			status = "ready"
+		}
+		fmt.Fprintf(writer, "shard %d.%d on %s %s, bootstrap copied %d bytes, follow lag %d ms\n",
+			shard.ServerId, shard.ShardId, shard.Address, status, shard.BootstrapBytes, shard.FollowLagMillisecond)
+	}
+
+}
+
+func printTopologyChangePlan(writer io.Writer, plan *pb.TopologyChangePlan) {
+
+	fmt.Fprintf(writer, "cluster %s size %d => %d, estimated %d bytes to copy\n",
+		plan.Keyspace, plan.ClusterSize, plan.TargetClusterSize, plan.EstimatedBytes)
+
+	for _, server := range plan.NewServers {
+		fmt.Fprintf(writer, "new server %d %s zone %q rack %q\n", server.ServerId, server.Address, server.Zone, server.Rack)
+	}
+
+	if len(plan.Shards) == 0 {
+		return
+	}
+
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "SHARD\tADDRESS\tBOOTSTRAP FROM\tFOLLOW\tESTIMATED BYTES\n")
+	for _, shard := range plan.Shards {
+		sources := formatShardLocations(shard.BootstrapSources)
+		if shard.PickBestBootstrapSource && len(shard.BootstrapSources) > 1 {
+			sources = "one of " + sources
+		}
+		fmt.Fprintf(w, "%d.%d\t%s\t%s\t%s\t%d\n", shard.ServerId, shard.ShardId, shard.Address,
+			sources, formatShardLocations(shard.TransitionalFollows), shard.EstimatedBytes)
+	}
+	w.Flush()
+
+}
+
+func formatShardLocations(locations []*pb.ShardLocation) string {
+	if len(locations) == 0 {
+		return "-"
+	}
+	var ids []string
+	for _, location := range locations {
+		ids = append(ids, fmt.Sprintf("%d.%d", location.ServerId, location.ShardId))
+	}
+	return strings.Join(ids, ",")
 }
@@ -0,0 +1,48 @@
+package shell
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+)
+
+func init() {
+	commands = append(commands, &commandUpdateCluster{})
+}
+
+type commandUpdateCluster struct {
+}
+
+func (c *commandUpdateCluster) Name() string {
+	return "cluster.update"
+}
+
+func (c *commandUpdateCluster) Help() string {
+	return "<cluster_name> <default ttl seconds> <max ttl seconds> <retention seconds>, 0 means not set, and the max ttl is the default ttl if the default ttl is not set"
+}
+
+func (c *commandUpdateCluster) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if len(args) != 4 {
+		return errInvalidArguments
+	}
+
+	keyspace := args[0]
+
+	var seconds []uint32
+	for _, arg := range args[1:] {
+		t, err := strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			println("can not parse seconds", arg)
+			return errInvalidArguments
+		}
+		seconds = append(seconds, uint32(t))
+	}
+
+	return vastoClient.UpdateKeyspace(keyspace, &pb.KeyspaceSettings{
+		DefaultTtlSecond: seconds[0],
+		MaxTtlSecond:     seconds[1],
+		RetentionSecond:  seconds[2],
+	})
+}
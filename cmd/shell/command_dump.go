@@ -50,9 +50,9 @@ func (c *commandDump) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 		return err
 	}
 
-	chans := make([]chan *pb.RawKeyValue, cluster.ExpectedSize())
+	chans := make([]chan *pb.RawKeyValue, cluster.LogicalShardCount())
 
-	for i := 0; i < cluster.ExpectedSize(); i++ {
+	for i := 0; i < cluster.LogicalShardCount(); i++ {
 
 		_, ok := cluster.GetNode(i, 0)
 		if !ok {
@@ -69,8 +69,8 @@ func (c *commandDump) Do(vastoClient *vs.VastoClient, args []string, commandEnv
 			request := &pb.BootstrapCopyRequest{
 				Keyspace:          commandEnv.keyspace,
 				ShardId:           uint32(node.ShardInfo.ShardId),
-				ClusterSize:       uint32(cluster.ExpectedSize()),
-				TargetClusterSize: uint32(cluster.ExpectedSize()),
+				ClusterSize:       uint32(cluster.LogicalShardCount()),
+				TargetClusterSize: uint32(cluster.LogicalShardCount()),
 				TargetShardId:     uint32(node.ShardInfo.ShardId),
 				Origin:            "shell dump",
 				Limit:             limit,
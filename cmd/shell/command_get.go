@@ -39,6 +39,8 @@ func (c *commandGet) Do(vastoClient *vs.VastoClient, args []string, commandEnv *
 			switch dt {
 			case pb.OpAndDataType_FLOAT64:
 				fmt.Fprintf(writer, "%f\n", util.BytesToFloat64(value))
+			case pb.OpAndDataType_INT64, pb.OpAndDataType_MAX_INT64, pb.OpAndDataType_MIN_INT64:
+				fmt.Fprintf(writer, "%d\n", util.BytesToInt64(value))
 			default:
 				fmt.Fprintf(writer, "%s\n", string(value))
 			}
@@ -0,0 +1,57 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandIndexLookup{})
+}
+
+type commandIndexLookup struct {
+}
+
+func (c *commandIndexLookup) Name() string {
+	return "index"
+}
+
+func (c *commandIndexLookup) Help() string {
+	return "<partition key> <index_name> <term> [<limit> <lastSeenKey>]"
+}
+
+func (c *commandIndexLookup) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+
+	if len(args) < 3 {
+		return errInvalidArguments
+	}
+
+	limit := uint32(100)
+	var lastSeenKey []byte
+	if len(args) >= 4 {
+		t, err := strconv.ParseUint(args[3], 10, 32)
+		if err != nil {
+			return err
+		}
+		limit = uint32(t)
+	}
+	if len(args) >= 5 {
+		lastSeenKey = []byte(args[4])
+	}
+
+	keys, err := commandEnv.clusterClient.LookupByIndex([]byte(args[0]), args[1], []byte(args[2]), limit, lastSeenKey)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		fmt.Fprintf(writer, "%s\n", string(key))
+	}
+	return nil
+}
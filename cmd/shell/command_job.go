@@ -0,0 +1,136 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+)
+
+func init() {
+	commands = append(commands, &commandJobList{})
+	commands = append(commands, &commandJobDesc{})
+	commands = append(commands, &commandJobCancel{})
+}
+
+type commandJobList struct {
+}
+
+func (c *commandJobList) Name() string {
+	return "job.list"
+}
+
+func (c *commandJobList) Help() string {
+	return "[<cluster_name>] [limit], list the latest admin jobs first"
+}
+
+func (c *commandJobList) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) > 2 {
+		return errInvalidArguments
+	}
+
+	var keyspace string
+	if len(args) > 0 {
+		keyspace = args[0]
+	}
+	limit := 20
+	if len(args) > 1 {
+		t, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return errInvalidArguments
+		}
+		limit = int(t)
+	}
+
+	jobs, err := vastoClient.ListJobs(keyspace, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		fmt.Fprintf(writer, "%s %v started at %s", job.Id, job.State, time.Unix(0, job.StartedAtNs).Format(time.RFC3339))
+		if job.Error != "" {
+			fmt.Fprintf(writer, ": %s", job.Error)
+		}
+		fmt.Fprintf(writer, "\n")
+	}
+
+	return nil
+}
+
+type commandJobDesc struct {
+}
+
+func (c *commandJobDesc) Name() string {
+	return "job.desc"
+}
+
+func (c *commandJobDesc) Help() string {
+	return "<job_id>, show the admin job with its steps"
+}
+
+func (c *commandJobDesc) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) != 1 {
+		return errInvalidArguments
+	}
+
+	job, err := vastoClient.GetJob(args[0])
+	if err != nil {
+		return err
+	}
+
+	printAdminJob(writer, job)
+
+	return nil
+}
+
+type commandJobCancel struct {
+}
+
+func (c *commandJobCancel) Name() string {
+	return "job.cancel"
+}
+
+func (c *commandJobCancel) Help() string {
+	return "<job_id>, stop the running admin job"
+}
+
+func (c *commandJobCancel) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) != 1 {
+		return errInvalidArguments
+	}
+
+	return vastoClient.CancelJob(args[0])
+}
+
+func printAdminJob(writer io.Writer, job *pb.AdminJob) {
+
+	fmt.Fprintf(writer, "job %s: %s %v", job.Id, job.Kind, job.State)
+	if job.IsCancelling && job.State == pb.AdminJob_RUNNING {
+		fmt.Fprintf(writer, ", cancelling")
+	}
+	fmt.Fprintf(writer, "\n")
+	fmt.Fprintf(writer, "request: %s\n", job.Request)
+
+	startedAt := time.Unix(0, job.StartedAtNs)
+	fmt.Fprintf(writer, "started at %v", startedAt.Format(time.RFC3339))
+	if job.FinishedAtNs > 0 {
+		fmt.Fprintf(writer, ", took %v", time.Unix(0, job.FinishedAtNs).Sub(startedAt))
+	}
+	fmt.Fprintf(writer, "\n")
+
+	for _, step := range job.Steps {
+		fmt.Fprintf(writer, "  %v %s\n", time.Unix(0, step.AtNs).Sub(startedAt), step.Message)
+	}
+
+	if job.Error != "" {
+		fmt.Fprintf(writer, "error: %s\n", job.Error)
+	}
+
+}
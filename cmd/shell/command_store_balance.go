@@ -0,0 +1,74 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+)
+
+func init() {
+	commands = append(commands, &commandStoreBalance{})
+}
+
+type commandStoreBalance struct {
+}
+
+func (c *commandStoreBalance) Name() string {
+	return "store.balance"
+}
+
+func (c *commandStoreBalance) Help() string {
+	return "plan|run [<store_group> [<store count>]], store count 0 means all stores in the group"
+}
+
+func (c *commandStoreBalance) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) < 1 || len(args) > 3 {
+		return errInvalidArguments
+	}
+
+	var dryRun bool
+	switch args[0] {
+	case "plan":
+		dryRun = true
+	case "run":
+	default:
+		return errInvalidArguments
+	}
+
+	storeGroup := ""
+	if len(args) > 1 {
+		storeGroup = args[1]
+	}
+
+	storeCount := uint64(0)
+	if len(args) > 2 {
+		if storeCount, err = strconv.ParseUint(args[2], 10, 32); err != nil {
+			return errInvalidArguments
+		}
+	}
+
+	moves, doneMoves, err := vastoClient.Balance(storeGroup, int(storeCount), dryRun, 5)
+
+	printMoves(writer, moves, doneMoves)
+	if len(moves) == 0 {
+		fmt.Fprintf(writer, "the stores are balanced\n")
+	}
+
+	return err
+}
+
+// printMoves lists the moves, and marks the done moves with *
+func printMoves(writer io.Writer, moves []*pb.ShardMove, doneMoves int) {
+	for i, move := range moves {
+		mark := " "
+		if i < doneMoves {
+			mark = "*"
+		}
+		fmt.Fprintf(writer, "%s move %s node %d from %s to %s, %d bytes\n",
+			mark, move.Keyspace, move.ServerId, move.FromAddress, move.ToAddress, move.SizeBytes)
+	}
+}
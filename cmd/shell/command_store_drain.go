@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandStoreDrain{})
+}
+
+type commandStoreDrain struct {
+}
+
+func (c *commandStoreDrain) Name() string {
+	return "store.drain"
+}
+
+func (c *commandStoreDrain) Help() string {
+	return "<store_ip:store_port> [plan|cancel], move all shards off the store, or stop draining the store"
+}
+
+func (c *commandStoreDrain) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) < 1 || len(args) > 2 {
+		return errInvalidArguments
+	}
+	address := args[0]
+
+	mode := ""
+	if len(args) > 1 {
+		mode = args[1]
+	}
+
+	switch mode {
+	case "cancel":
+		return vastoClient.CancelDrainStore(address)
+	case "plan", "":
+	default:
+		return errInvalidArguments
+	}
+
+	moves, doneMoves, isEmpty, err := vastoClient.DrainStore(address, mode == "plan")
+
+	printMoves(writer, moves, doneMoves)
+	if isEmpty {
+		fmt.Fprintf(writer, "store %s is empty, and safe to shut down\n", address)
+	}
+
+	return err
+}
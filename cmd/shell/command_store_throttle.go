@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandStoreThrottle{})
+}
+
+type commandStoreThrottle struct {
+}
+
+func (c *commandStoreThrottle) Name() string {
+	return "store.throttle"
+}
+
+func (c *commandStoreThrottle) Help() string {
+	return "<bytes per second> [<store_ip:store_port>], limit sending bootstrap copies, 0 means no limit, on all stores if no store is specified"
+}
+
+func (c *commandStoreThrottle) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) (err error) {
+
+	if len(args) < 1 || len(args) > 2 {
+		return errInvalidArguments
+	}
+
+	bytesPerSecond, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		println("can not parse bytes per second", args[0])
+		return errInvalidArguments
+	}
+
+	address := ""
+	if len(args) > 1 {
+		address = args[1]
+	}
+
+	if err = vastoClient.SetBootstrapThrottle(address, bytesPerSecond); err != nil {
+		return err
+	}
+
+	if address == "" {
+		address = "all stores"
+	}
+	fmt.Fprintf(writer, "bootstrap throttle on %s: %d bytes per second\n", address, bytesPerSecond)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/vasto/goclient/vs"
+)
+
+func init() {
+	commands = append(commands, &commandTtl{})
+}
+
+type commandTtl struct {
+}
+
+func (c *commandTtl) Name() string {
+	return "ttl"
+}
+
+func (c *commandTtl) Help() string {
+	return "<key> [<seconds>|persist], show the remaining ttl, set the key to expire after the seconds, or remove the ttl"
+}
+
+func (c *commandTtl) Do(vastoClient *vs.VastoClient, args []string, commandEnv *commandEnv, writer io.Writer) error {
+	if commandEnv.clusterClient == nil {
+		return errNoKeyspaceSelected
+	}
+
+	if len(args) < 1 {
+		return errInvalidArguments
+	}
+
+	key := vs.Key([]byte(args[0]))
+
+	if len(args) == 1 {
+		remaining, hasTtl, err := commandEnv.clusterClient.GetTtl(key)
+		if err != nil {
+			return err
+		}
+		if !hasTtl {
+			fmt.Fprintln(writer, "no ttl")
+			return nil
+		}
+		fmt.Fprintf(writer, "%v\n", remaining)
+		return nil
+	}
+
+	if args[1] == "persist" {
+		return commandEnv.clusterClient.Persist(key)
+	}
+
+	seconds, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return errInvalidArguments
+	}
+
+	return commandEnv.clusterClient.Touch(key, time.Duration(seconds)*time.Second)
+}
@@ -30,7 +30,8 @@ func (s *shell) runShell() {
 	reg, _ := regexp.Compile(`'.*?'|".*?"|\S+`)
 
 	commandEnv := &commandEnv{
-		keyspace: *s.option.Keyspace,
+		keyspace:   *s.option.Keyspace,
+		dataCenter: *s.option.DataCenter,
 	}
 	if commandEnv.keyspace != "" {
 		commandEnv.clusterClient = s.vastoClient.NewClusterClient(commandEnv.keyspace)
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"fmt"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+// processAggregate computes count, sum, min and max of the entries under the prefix on this shard.
+// Sum, min and max only cover the float64 entries. The client merges the partial results of all shards.
+func (ss *storeServer) processAggregate(shard *shard, aggregateRequest *pb.AggregateRequest) *pb.AggregateResponse {
+
+	resp := &pb.AggregateResponse{
+		Ok: true,
+	}
+
+	filter, err := newScanFilter(aggregateRequest.Filter)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+
+	var decodeErr error
+	err = shard.db.PrefixScan(
+		aggregateRequest.Prefix,
+		nil,
+		0,
+		func(key, value []byte) bool {
+			if isReservedKey(key) {
+				return true
+			}
+			var entry *codec.Entry
+			entry, decodeErr = codec.Decode(value)
+			if decodeErr != nil {
+				decodeErr = fmt.Errorf("decode %v: %v", string(key), decodeErr)
+				return false
+			}
+			if entry.IsExpired() || !filter.matches(key, entry) {
+				return true
+			}
+			resp.Count++
+			if !isFloat64DataType(entry.OpAndDataType) || len(entry.Value) != 8 {
+				return true
+			}
+			x := util.BytesToFloat64(entry.Value)
+			if resp.Float64Count == 0 || x < resp.Min {
+				resp.Min = x
+			}
+			if resp.Float64Count == 0 || x > resp.Max {
+				resp.Max = x
+			}
+			resp.Sum += x
+			resp.Float64Count++
+			return true
+		})
+	if err == nil {
+		err = decodeErr
+	}
+	if err != nil {
+		return &pb.AggregateResponse{
+			Ok:     false,
+			Status: err.Error(),
+		}
+	}
+	return resp
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func TestAggregateByPrefix(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	for i, x := range []float64{2.5, -1, 4} {
+		key := []byte{'s', byte('0' + i)}
+		if err := s.merge(key, newFloatMergeEntry(key, x, 1)); err != nil {
+			t.Fatalf("merge %s: %v", key, err)
+		}
+	}
+	for _, key := range []string{"s9", "t0"} {
+		if err := s.writeEntry([]byte(key), codec.NewPutEntry(&pb.PutRequest{Key: []byte(key), Value: []byte("v")}, 1)); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	ss := &storeServer{}
+
+	resp := ss.processAggregate(s, &pb.AggregateRequest{Prefix: []byte("s")})
+	if !resp.Ok {
+		t.Fatalf("aggregate: %s", resp.Status)
+	}
+	if resp.Count != 4 || resp.Float64Count != 3 {
+		t.Errorf("counted %d entries and %d float64 entries, expecting 4 and 3", resp.Count, resp.Float64Count)
+	}
+	if resp.Sum != 5.5 || resp.Min != -1 || resp.Max != 4 {
+		t.Errorf("sum %v min %v max %v, expecting 5.5, -1 and 4", resp.Sum, resp.Min, resp.Max)
+	}
+
+	// only the matching entries are aggregated
+	resp = ss.processAggregate(s, &pb.AggregateRequest{
+		Prefix: []byte("s"),
+		Filter: &pb.ScanFilter{Float64Range: &pb.ScanFilter_Float64Range{Min: 0, Max: 3}},
+	})
+	if resp.Count != 1 || resp.Sum != 2.5 || resp.Min != 2.5 || resp.Max != 2.5 {
+		t.Errorf("filtered aggregate %+v, expecting only 2.5", resp)
+	}
+
+	if resp = ss.processAggregate(s, &pb.AggregateRequest{Prefix: []byte("x")}); resp.Count != 0 || resp.Float64Count != 0 {
+		t.Errorf("aggregated %+v without any matching entry", resp)
+	}
+
+}
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"bytes"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	chunkSweepInterval = 10 * time.Minute
+	chunkSweepLimit    = 1024
+)
+
+// markChunkManifests is called before a chunk manifest is written.
+// Until then, the writes need not read the old entries to delete the replaced chunks.
+func (s *shard) markChunkManifests() {
+	atomic.StoreInt32(&s.hasChunkManifests, 1)
+}
+
+func (s *shard) mayHaveChunkManifests() bool {
+	return atomic.LoadInt32(&s.hasChunkManifests) == 1
+}
+
+// checkChunkManifests marks the shard if any chunk is stored.
+func (s *shard) checkChunkManifests() {
+	s.db.PrefixScan(pb.ChunkKeyPrefix, nil, 1, func(key, value []byte) bool {
+		s.markChunkManifests()
+		return false
+	})
+}
+
+func isChunkManifest(entry *codec.Entry) bool {
+	return entry != nil && entry.OpAndDataType == codec.OpAndDataType(pb.OpAndDataType_CHUNK_MANIFEST)
+}
+
+// deleteReplacedChunks adds the deletes of the chunks of the old large value to the batch,
+// when the key is deleted, or updated to anything other than the same manifest.
+// Each store deletes the chunks when it applies the change, so the chunk deletes are not logged.
+func (s *shard) deleteReplacedChunks(batch *indexBatch, key []byte, oldEntry, newEntry *codec.Entry) {
+
+	if isChunkManifest(newEntry) {
+		s.markChunkManifests()
+	}
+
+	if !isChunkManifest(oldEntry) {
+		return
+	}
+	if isChunkManifest(newEntry) && bytes.Equal(newEntry.Value, oldEntry.Value) {
+		return
+	}
+
+	manifest := &pb.ChunkManifest{}
+	if err := proto.Unmarshal(oldEntry.Value, manifest); err != nil {
+		glog.Errorf("%s unmarshal chunk manifest %v: %v", s, string(key), err)
+		return
+	}
+
+	for i := 0; i < int(manifest.ChunkCount); i++ {
+		batch.delete(manifest.ChunkKey(key, i))
+	}
+
+}
+
+// sweepOrphanChunks deletes the chunks without a manifest, which are left if a chunked put fails halfway.
+// The chunks are written before their manifest, so a chunk is only deleted
+// if it is still without a manifest in the next sweep.
+// The chunks with a ttl are also expired by the compaction filter.
+func (s *shard) sweepOrphanChunks(now time.Time) {
+
+	if !s.mayHaveChunkManifests() || now.Sub(s.lastChunkSweep) < chunkSweepInterval {
+		return
+	}
+	s.lastChunkSweep = now
+
+	candidates := make(map[string]bool)
+	var orphans [][]byte
+	err := s.db.PrefixScan(pb.ChunkKeyPrefix, nil, 0, func(k, v []byte) bool {
+		chunkKey := make([]byte, len(k))
+		copy(chunkKey, k)
+		if key, version, ok := pb.ParseChunkKey(chunkKey); ok && !s.hasChunkManifest(key, version) {
+			candidates[string(chunkKey)] = true
+			if s.orphanChunkCandidates[string(chunkKey)] {
+				orphans = append(orphans, chunkKey)
+			}
+		}
+		return len(candidates) < chunkSweepLimit
+	})
+	if err != nil {
+		glog.Errorf("%s sweep orphan chunks: %v", s, err)
+		return
+	}
+	s.orphanChunkCandidates = candidates
+
+	for _, chunkKey := range orphans {
+		key, version, _ := pb.ParseChunkKey(chunkKey)
+		unlock := s.lockKey(key)
+		if !s.hasChunkManifest(key, version) {
+			if err = s.db.Delete(chunkKey); err != nil {
+				glog.Errorf("%s delete orphan chunk %v: %v", s, string(chunkKey), err)
+			}
+		}
+		unlock()
+	}
+
+}
+
+// hasChunkManifest checks whether the key is a manifest of the version
+func (s *shard) hasChunkManifest(key []byte, version uint64) bool {
+	b, err := s.db.Get(key)
+	if err != nil || len(b) == 0 {
+		return false
+	}
+	entry := codec.FromBytes(b)
+	if !isChunkManifest(entry) {
+		return false
+	}
+	manifest := &pb.ChunkManifest{}
+	if err = proto.Unmarshal(entry.Value, manifest); err != nil {
+		return false
+	}
+	return manifest.Version == version
+}
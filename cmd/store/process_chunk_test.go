@@ -0,0 +1,122 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestWritesDeleteReplacedChunks(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	key := []byte("large")
+	writeChunked := func(version uint64) *pb.ChunkManifest {
+		manifest := &pb.ChunkManifest{Version: version, ChunkCount: 2}
+		for i := 0; i < int(manifest.ChunkCount); i++ {
+			chunkKey := manifest.ChunkKey(key, i)
+			if err := s.writeEntry(chunkKey, codec.NewPutEntry(&pb.PutRequest{Key: chunkKey, Value: []byte("chunk")}, version)); err != nil {
+				t.Fatalf("put chunk %d: %v", i, err)
+			}
+		}
+		b, _ := proto.Marshal(manifest)
+		if err := s.writeEntry(key, codec.NewPutEntry(&pb.PutRequest{
+			Key:           key,
+			OpAndDataType: pb.OpAndDataType_CHUNK_MANIFEST,
+			Value:         b,
+		}, version)); err != nil {
+			t.Fatalf("put manifest: %v", err)
+		}
+		return manifest
+	}
+	chunkCount := func(manifest *pb.ChunkManifest) (count int) {
+		for i := 0; i < int(manifest.ChunkCount); i++ {
+			if b, _ := s.db.Get(manifest.ChunkKey(key, i)); len(b) > 0 {
+				count++
+			}
+		}
+		return
+	}
+
+	if s.needsOldEntry(key) {
+		t.Errorf("no value is split into chunks yet")
+	}
+
+	first := writeChunked(1)
+	if !s.mayHaveChunkManifests() {
+		t.Errorf("the manifest is written")
+	}
+
+	// rewriting the same manifest keeps its chunks
+	b, _ := s.db.Get(key)
+	if err := s.writeEntry(key, codec.FromBytes(b)); err != nil {
+		t.Fatalf("rewrite manifest: %v", err)
+	}
+	if count := chunkCount(first); count != 2 {
+		t.Errorf("%d chunks left after rewriting the same manifest", count)
+	}
+
+	second := writeChunked(2)
+	if count := chunkCount(first); count != 0 {
+		t.Errorf("%d replaced chunks left", count)
+	}
+
+	if err := s.writeEntry(key, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if count := chunkCount(second); count != 0 {
+		t.Errorf("%d chunks left after the key is deleted", count)
+	}
+
+}
+
+func TestSweepOrphanChunks(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	putChunk := func(key []byte, version uint64, i int) []byte {
+		chunkKey := (&pb.ChunkManifest{Version: version}).ChunkKey(key, i)
+		if err := s.writeEntry(chunkKey, codec.NewPutEntry(&pb.PutRequest{Key: chunkKey, Value: []byte("chunk")}, version)); err != nil {
+			t.Fatalf("put chunk: %v", err)
+		}
+		return chunkKey
+	}
+
+	// a complete value, whose key has dots as in the chunk keys
+	key := []byte("a.1.0")
+	kept := putChunk(key, 1, 0)
+	b, _ := proto.Marshal(&pb.ChunkManifest{Version: 1, ChunkCount: 1})
+	if err := s.writeEntry(key, codec.NewPutEntry(&pb.PutRequest{
+		Key:           key,
+		OpAndDataType: pb.OpAndDataType_CHUNK_MANIFEST,
+		Value:         b,
+	}, 1)); err != nil {
+		t.Fatalf("put manifest: %v", err)
+	}
+
+	// a put failed before its manifest, and another put of a newer version failed on the same key
+	orphan := putChunk([]byte("b"), 1, 0)
+	newerOrphan := putChunk(key, 2, 0)
+
+	now := time.Now()
+	s.sweepOrphanChunks(now)
+	if b, _ := s.db.Get(orphan); len(b) == 0 {
+		t.Errorf("the chunk may be of a put in progress")
+	}
+
+	s.sweepOrphanChunks(now.Add(chunkSweepInterval))
+	for _, chunkKey := range [][]byte{orphan, newerOrphan} {
+		if b, _ := s.db.Get(chunkKey); len(b) > 0 {
+			t.Errorf("orphan chunk %s is not deleted", chunkKey)
+		}
+	}
+	if b, _ := s.db.Get(kept); len(b) == 0 {
+		t.Errorf("the chunk of the manifest is deleted")
+	}
+
+}
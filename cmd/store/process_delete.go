@@ -12,16 +12,17 @@ func (ss *storeServer) processDelete(shard *shard, deleteRequest *pb.DeleteReque
 		Ok: true,
 	}
 
-	err := shard.db.Delete(deleteRequest.Key)
+	nowInNano := deleteRequest.UpdatedAtNs
+	if nowInNano == 0 {
+		nowInNano = uint64(time.Now().UnixNano())
+	}
+
+	err := shard.writeEntry(deleteRequest.Key, nil)
 	if err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
 	} else {
 		if !*ss.option.DisableBinLog {
-			nowInNano := deleteRequest.UpdatedAtNs
-			if nowInNano == 0 {
-				nowInNano = uint64(time.Now().UnixNano())
-			}
 			shard.logDelete(deleteRequest, nowInNano)
 		}
 	}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"github.com/chrislusf/glog"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
 )
@@ -17,7 +18,13 @@ func (ss *storeServer) processGet(shard *shard, getRequest *pb.GetRequest) *pb.G
 			Ok: true,
 		}
 	} else {
-		entry := codec.FromBytes(b)
+		entry, decodeErr := codec.Decode(b)
+		if decodeErr != nil {
+			glog.Errorf("%s get %v: %v", shard, string(key), decodeErr)
+			return &pb.GetResponse{
+				Status: decodeErr.Error(),
+			}
+		}
 		if entry.IsExpired() {
 			return &pb.GetResponse{
 				Ok:     false,
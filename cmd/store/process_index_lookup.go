@@ -0,0 +1,45 @@
+package store
+
+import (
+	"fmt"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func (ss *storeServer) processIndexLookup(shard *shard, lookupRequest *pb.IndexLookupRequest) *pb.IndexLookupResponse {
+
+	resp := &pb.IndexLookupResponse{
+		Ok: true,
+	}
+
+	if !shard.hasIndex(lookupRequest.IndexName) {
+		resp.Ok = false
+		resp.Status = fmt.Sprintf("index %s not found", lookupRequest.IndexName)
+		return resp
+	}
+
+	prefix := indexTermPrefix(lookupRequest.IndexName, lookupRequest.Term)
+	var lastSeenKey []byte
+	if len(lookupRequest.LastSeenKey) > 0 {
+		lastSeenKey = append(indexTermPrefix(lookupRequest.IndexName, lookupRequest.Term), lookupRequest.LastSeenKey...)
+	}
+	limit := int(lookupRequest.Limit)
+
+	err := shard.db.PrefixScan(prefix, lastSeenKey, 0, func(key, value []byte) bool {
+		entry, decodeErr := codec.Decode(value)
+		if decodeErr != nil || entry.IsExpired() || entry.PartitionHash != lookupRequest.PartitionHash {
+			// the other partitions on the same shard
+			return true
+		}
+		t := make([]byte, len(key)-len(prefix))
+		copy(t, key[len(prefix):])
+		resp.Keys = append(resp.Keys, t)
+		return limit <= 0 || len(resp.Keys) < limit
+	})
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		resp.Keys = nil
+	}
+	return resp
+}
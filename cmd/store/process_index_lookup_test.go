@@ -0,0 +1,67 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func TestIndexLookupWithinPartition(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	s.setIndexes([]*pb.IndexDefinition{{Name: "p", Source: pb.IndexDefinition_KEY_PREFIX, Length: 2}})
+
+	// two partitions on the same shard
+	for i, partitionHash := range []uint64{7, 8, 7, 8, 7} {
+		key := []byte(fmt.Sprintf("ab%d", i))
+		if err := s.writeEntry(key, codec.NewPutEntry(&pb.PutRequest{
+			Key:           key,
+			PartitionHash: partitionHash,
+			Value:         []byte("v"),
+		}, 1)); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	ss := &storeServer{}
+	lookup := func(partitionHash uint64, limit uint32, lastSeenKey string) string {
+		resp := ss.processIndexLookup(s, &pb.IndexLookupRequest{
+			IndexName:     "p",
+			Term:          []byte("ab"),
+			Limit:         limit,
+			LastSeenKey:   []byte(lastSeenKey),
+			PartitionHash: partitionHash,
+		})
+		if !resp.Ok {
+			t.Fatalf("lookup: %s", resp.Status)
+		}
+		var keys []string
+		for _, key := range resp.Keys {
+			keys = append(keys, string(key))
+		}
+		return fmt.Sprintf("%v", keys)
+	}
+
+	if keys := lookup(7, 0, ""); keys != "[ab0 ab2 ab4]" {
+		t.Errorf("partition 7 keys %s", keys)
+	}
+	if keys := lookup(8, 0, ""); keys != "[ab1 ab3]" {
+		t.Errorf("partition 8 keys %s", keys)
+	}
+	// the other partition does not count towards the limit
+	if keys := lookup(7, 2, ""); keys != "[ab0 ab2]" {
+		t.Errorf("partition 7 first page %s", keys)
+	}
+	if keys := lookup(7, 2, "ab2"); keys != "[ab4]" {
+		t.Errorf("partition 7 second page %s", keys)
+	}
+
+	if resp := ss.processIndexLookup(s, &pb.IndexLookupRequest{IndexName: "q"}); resp.Ok {
+		t.Errorf("lookup of an undefined index should fail")
+	}
+
+}
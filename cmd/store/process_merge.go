@@ -1,6 +1,7 @@
 package store
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/chrislusf/glog"
@@ -10,6 +11,20 @@ import (
 
 func (ss *storeServer) processMerge(shard *shard, mergeRequest *pb.MergeRequest) *pb.WriteResponse {
 
+	if err := codec.ValidateNamedMerge(mergeRequest); err != nil {
+		return &pb.WriteResponse{
+			Ok:     false,
+			Status: err.Error(),
+		}
+	}
+
+	if err := ss.applyKeyspaceTtl(shard.keyspace, &mergeRequest.TtlSecond); err != nil {
+		return &pb.WriteResponse{
+			Ok:     false,
+			Status: err.Error(),
+		}
+	}
+
 	key := mergeRequest.Key
 	nowInNano := mergeRequest.UpdatedAtNs
 	if nowInNano == 0 {
@@ -17,13 +32,17 @@ func (ss *storeServer) processMerge(shard *shard, mergeRequest *pb.MergeRequest)
 	}
 	entry := codec.NewMergeEntry(mergeRequest, nowInNano)
 
+	if isCounterDataType(mergeRequest.OpAndDataType) {
+		return ss.processCounterMerge(shard, mergeRequest, entry)
+	}
+
 	resp := &pb.WriteResponse{
 		Ok: true,
 	}
 
 	// glog.V(2).Infof"shard %d put key: %v\n", shard.id, string(mergeRequest.KeyValue.Key))
 
-	err := shard.db.Merge(key, entry.ToBytes())
+	err := shard.merge(key, entry)
 	if err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
@@ -36,6 +55,81 @@ func (ss *storeServer) processMerge(shard *shard, mergeRequest *pb.MergeRequest)
 	return resp
 }
 
+func isCounterDataType(t pb.OpAndDataType) bool {
+	switch t {
+	case pb.OpAndDataType_INT64, pb.OpAndDataType_MAX_INT64, pb.OpAndDataType_MIN_INT64:
+		return true
+	}
+	return false
+}
+
+// processCounterMerge applies the int64 merge as read-modify-write, and returns the merged value.
+// The merged value is logged as a put, so replaying the binlog on the followers is idempotent.
+func (ss *storeServer) processCounterMerge(shard *shard, mergeRequest *pb.MergeRequest, entry *codec.Entry) *pb.WriteResponse {
+
+	resp := &pb.WriteResponse{
+		Ok: true,
+	}
+
+	unlock := shard.lockKey(mergeRequest.Key)
+	defer unlock()
+
+	existing, err := shard.db.Get(mergeRequest.Key)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+	var oldEntry *codec.Entry
+	if len(existing) > 0 {
+		oldEntry = codec.FromBytes(existing)
+	}
+	if oldEntry == nil || oldEntry.IsExpired() {
+		existing = nil
+	} else if !isCounterDataType(pb.OpAndDataType(oldEntry.OpAndDataType)) {
+		resp.Ok = false
+		resp.Status = fmt.Sprintf("merge %v: existing %v value is not an int64 counter", string(mergeRequest.Key), pb.OpAndDataType(oldEntry.OpAndDataType))
+		return resp
+	} else {
+		// the merge may change the existing bytes in place
+		existing = append([]byte(nil), existing...)
+	}
+
+	merged, ok := codec.MergeEntry(existing, entry.ToBytes())
+	if !ok {
+		resp.Ok = false
+		resp.Status = fmt.Sprintf("merge %v failed", string(mergeRequest.Key))
+		return resp
+	}
+	merged.UpdatedAtNs = entry.UpdatedAtNs
+
+	if err = shard.replaceEntry(mergeRequest.Key, oldEntry, merged); err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+
+	putRequest := &pb.PutRequest{
+		Key:           mergeRequest.Key,
+		PartitionHash: mergeRequest.PartitionHash,
+		TtlSecond:     merged.TtlSecond,
+		OpAndDataType: pb.OpAndDataType(merged.OpAndDataType),
+		Value:         merged.Value,
+	}
+	if !*ss.option.DisableBinLog {
+		shard.logPut(putRequest, merged.UpdatedAtNs)
+	}
+
+	resp.KeyValue = &pb.KeyTypeValue{
+		Key:           mergeRequest.Key,
+		PartitionHash: merged.PartitionHash,
+		DataType:      pb.OpAndDataType(merged.OpAndDataType),
+		Value:         merged.Value,
+	}
+
+	return resp
+}
+
 func (s *shard) logMerge(mergeRequest *pb.MergeRequest, updatedAtNs uint64) {
 
 	// println("logMerge1", mergeRequest.String())
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+func TestCounterMergeRejectsOtherTypes(t *testing.T) {
+
+	ss, cleanup := newTestStoreServer(t)
+	defer cleanup()
+
+	disableBinLog := true
+	ss.option.DisableBinLog = &disableBinLog
+
+	s, cleanupShard := newTestShard(t)
+	defer cleanupShard()
+
+	key := []byte("f")
+	if err := s.merge(key, newFloatMergeEntry(key, 1.5, 1)); err != nil {
+		t.Fatalf("merge float64: %v", err)
+	}
+
+	resp := ss.processMerge(s, &pb.MergeRequest{
+		Key:           key,
+		OpAndDataType: pb.OpAndDataType_INT64,
+		Value:         util.Int64ToBytes(1),
+	})
+	if resp.Ok {
+		t.Errorf("counter merge onto a float64 value should fail")
+	}
+
+	b, _ := s.db.Get(key)
+	if entry := codec.FromBytes(b); entry.OpAndDataType != codec.OpAndDataType(pb.OpAndDataType_FLOAT64) || util.BytesToFloat64(entry.Value) != 1.5 {
+		t.Errorf("the float64 value is changed to %v %v", entry.OpAndDataType, entry.Value)
+	}
+
+	counter := []byte("c")
+	for i := 1; i <= 2; i++ {
+		resp = ss.processMerge(s, &pb.MergeRequest{
+			Key:           counter,
+			OpAndDataType: pb.OpAndDataType_INT64,
+			Value:         util.Int64ToBytes(1),
+		})
+		if !resp.Ok || util.BytesToInt64(resp.KeyValue.Value) != int64(i) {
+			t.Errorf("counter merge %d: %+v", i, resp)
+		}
+	}
+
+}
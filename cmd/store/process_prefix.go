@@ -1,34 +1,51 @@
 package store
 
 import (
+	"fmt"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
 )
 
 func (ss *storeServer) processPrefix(shard *shard, prefixRequest *pb.GetByPrefixRequest) *pb.GetByPrefixResponse {
 
-	var keyValues []*pb.KeyTypeValue
 	resp := &pb.GetByPrefixResponse{
 		Ok: true,
 	}
-	err := shard.db.PrefixScan(
+
+	filter, err := newScanFilter(prefixRequest.Filter)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+
+	// only the returned entries count against the limit
+	limit := int(prefixRequest.Limit)
+
+	var keyValues []*pb.KeyTypeValue
+	var decodeErr error
+	err = shard.db.PrefixScan(
 		prefixRequest.Prefix,
 		prefixRequest.LastSeenKey,
-		int(prefixRequest.Limit),
+		0,
 		func(key, value []byte) bool {
-			entry := codec.FromBytes(value)
-			if !entry.IsExpired() {
-				t := make([]byte, len(key))
-				copy(t, key)
-				keyValues = append(keyValues, &pb.KeyTypeValue{
-					Key:           t,
-					PartitionHash: entry.PartitionHash,
-					DataType:      pb.OpAndDataType(entry.OpAndDataType),
-					Value:         entry.Value,
-				})
+			if isReservedKey(key) {
+				return true
 			}
-			return true
+			var entry *codec.Entry
+			entry, decodeErr = codec.Decode(value)
+			if decodeErr != nil {
+				decodeErr = fmt.Errorf("decode %v: %v", string(key), decodeErr)
+				return false
+			}
+			if !entry.IsExpired() && filter.matches(key, entry) {
+				keyValues = append(keyValues, filter.toKeyTypeValue(key, entry))
+			}
+			return limit <= 0 || len(keyValues) < limit
 		})
+	if err == nil {
+		err = decodeErr
+	}
 	if err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
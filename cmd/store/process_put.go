@@ -10,6 +10,13 @@ import (
 
 func (ss *storeServer) processPut(shard *shard, putRequest *pb.PutRequest) *pb.WriteResponse {
 
+	if err := ss.applyKeyspaceTtl(shard.keyspace, &putRequest.TtlSecond); err != nil {
+		return &pb.WriteResponse{
+			Ok:     false,
+			Status: err.Error(),
+		}
+	}
+
 	key := putRequest.Key
 	nowInNano := putRequest.UpdatedAtNs
 	if nowInNano == 0 {
@@ -23,7 +30,7 @@ func (ss *storeServer) processPut(shard *shard, putRequest *pb.PutRequest) *pb.W
 
 	// glog.V(2).Infof"shard %d put key: %v\n", shard.id, string(putRequest.KeyValue.Key))
 
-	err := shard.db.Put(key, entry.ToBytes())
+	err := shard.writeEntry(key, entry)
 	if err != nil {
 		resp.Ok = false
 		resp.Status = err.Error()
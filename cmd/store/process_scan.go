@@ -0,0 +1,68 @@
+package store
+
+import (
+	"fmt"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+const (
+	constMaxScanLimit = 1024
+)
+
+func (ss *storeServer) processScan(shard *shard, scanRequest *pb.ScanRequest) *pb.ScanResponse {
+
+	limit := int(scanRequest.Limit)
+	if limit <= 0 || limit > constMaxScanLimit {
+		limit = constMaxScanLimit
+	}
+
+	resp := &pb.ScanResponse{
+		Ok: true,
+	}
+
+	filter, err := newScanFilter(scanRequest.Filter)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+
+	var keyValues []*pb.KeyTypeValue
+	var decodeErr error
+	err = shard.db.RangeScan(
+		scanRequest.StartKey,
+		scanRequest.StartExclusive,
+		scanRequest.EndKey,
+		scanRequest.EndInclusive,
+		scanRequest.Reverse,
+		func(key, value []byte) bool {
+			if isReservedKey(key) {
+				return true
+			}
+			if len(keyValues) >= limit {
+				resp.HasMore = true
+				return false
+			}
+			var entry *codec.Entry
+			entry, decodeErr = codec.Decode(value)
+			if decodeErr != nil {
+				decodeErr = fmt.Errorf("decode %v: %v", string(key), decodeErr)
+				return false
+			}
+			if !entry.IsExpired() && filter.matches(key, entry) {
+				keyValues = append(keyValues, filter.toKeyTypeValue(key, entry))
+			}
+			return true
+		})
+	if err == nil {
+		err = decodeErr
+	}
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+	} else {
+		resp.KeyValues = keyValues
+	}
+	return resp
+}
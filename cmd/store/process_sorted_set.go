@@ -0,0 +1,258 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+var (
+	// SortedSetKeyPrefix is a reserved key prefix for the sorted sets.
+	// Each member has a member key to find its score, and a score key ordered by the score:
+	//
+	//	SortedSetKeyPrefix uint32(len(key)) key 'm' member                -> score
+	//	SortedSetKeyPrefix uint32(len(key)) key 's' sortable(score) member -> empty
+	//
+	// All keys carry the partition hash of the sorted set, so they stay on the same shard.
+	SortedSetKeyPrefix = codec.SortedSetKeyPrefix
+)
+
+func sortedSetPrefix(key []byte, kind byte) []byte {
+	var b bytes.Buffer
+	b.Write(SortedSetKeyPrefix)
+	binary.Write(&b, binary.BigEndian, uint32(len(key)))
+	b.Write(key)
+	b.WriteByte(kind)
+	return b.Bytes()
+}
+
+func sortedSetMemberKey(key, member []byte) []byte {
+	return append(sortedSetPrefix(key, 'm'), member...)
+}
+
+func sortedSetScoreKey(key []byte, score float64, member []byte) []byte {
+	return append(append(sortedSetPrefix(key, 's'), encodeSortableScore(score)...), member...)
+}
+
+// encodeSortableScore encodes the float64 so that the byte order is the same as the numeric order
+func encodeSortableScore(score float64) []byte {
+	bits := math.Float64bits(score)
+	if bits>>63 == 1 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, bits)
+	return b
+}
+
+func decodeSortableScore(b []byte) float64 {
+	bits := binary.BigEndian.Uint64(b)
+	if bits>>63 == 1 {
+		bits &^= 1 << 63
+	} else {
+		bits = ^bits
+	}
+	return math.Float64frombits(bits)
+}
+
+// prefixSuccessor returns the smallest key larger than all keys with the prefix
+func prefixSuccessor(prefix []byte) []byte {
+	t := make([]byte, len(prefix))
+	copy(t, prefix)
+	for i := len(t) - 1; i >= 0; i-- {
+		if t[i] < 0xff {
+			t[i]++
+			return t[:i+1]
+		}
+	}
+	return nil
+}
+
+func (ss *storeServer) processSortedSet(shard *shard, request *pb.SortedSetRequest) *pb.SortedSetResponse {
+
+	resp := &pb.SortedSetResponse{
+		Ok: true,
+	}
+
+	var err error
+	switch request.Op {
+	case pb.SortedSetRequest_ADD:
+		err = ss.sortedSetWrite(shard, request, false)
+	case pb.SortedSetRequest_REMOVE:
+		err = ss.sortedSetWrite(shard, request, true)
+	case pb.SortedSetRequest_TOP:
+		resp.Members, err = shard.sortedSetRange(request.Key, sortedSetPrefix(request.Key, 's'), sortedSetPrefix(request.Key, 't'), request.Ascending, int(request.Limit))
+	case pb.SortedSetRequest_RANGE_BY_SCORE:
+		startKey := append(sortedSetPrefix(request.Key, 's'), encodeSortableScore(request.MinScore)...)
+		endKey := prefixSuccessor(append(sortedSetPrefix(request.Key, 's'), encodeSortableScore(request.MaxScore)...))
+		resp.Members, err = shard.sortedSetRange(request.Key, startKey, endKey, request.Ascending, int(request.Limit))
+	case pb.SortedSetRequest_RANK:
+		if len(request.Members) != 1 {
+			err = fmt.Errorf("rank expects one member")
+			break
+		}
+		resp.Rank, err = shard.sortedSetRank(request.Key, request.Members[0].Member, request.Ascending)
+	default:
+		err = fmt.Errorf("unknown sorted set op %v", request.Op)
+	}
+
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+	}
+	return resp
+}
+
+// sortedSetWrite updates the member keys and score keys in one write batch,
+// and logs each key change to the binlog.
+func (ss *storeServer) sortedSetWrite(shard *shard, request *pb.SortedSetRequest, isRemove bool) error {
+
+	nowInNano := uint64(time.Now().UnixNano())
+
+	// the last score wins if a member is repeated
+	var members [][]byte
+	scores := make(map[string]float64)
+	for _, m := range request.Members {
+		if _, found := scores[string(m.Member)]; !found {
+			members = append(members, m.Member)
+		}
+		scores[string(m.Member)] = m.Score
+	}
+
+	shard.readModifyWriteLock.Lock()
+	defer shard.readModifyWriteLock.Unlock()
+
+	var puts []*pb.PutRequest
+	var deletes []*pb.DeleteRequest
+	for _, member := range members {
+		memberKey := sortedSetMemberKey(request.Key, member)
+		oldScore, found, err := shard.sortedSetScore(memberKey)
+		if err != nil {
+			return err
+		}
+		score := scores[string(member)]
+		if found && !isRemove && oldScore == score {
+			continue
+		}
+		if found {
+			deletes = append(deletes, &pb.DeleteRequest{
+				Key:           sortedSetScoreKey(request.Key, oldScore, member),
+				PartitionHash: request.PartitionHash,
+				UpdatedAtNs:   nowInNano,
+			})
+		}
+		if isRemove {
+			if found {
+				deletes = append(deletes, &pb.DeleteRequest{
+					Key:           memberKey,
+					PartitionHash: request.PartitionHash,
+					UpdatedAtNs:   nowInNano,
+				})
+			}
+			continue
+		}
+		puts = append(puts, &pb.PutRequest{
+			Key:           memberKey,
+			PartitionHash: request.PartitionHash,
+			UpdatedAtNs:   nowInNano,
+			OpAndDataType: pb.OpAndDataType_FLOAT64,
+			Value:         util.Float64ToBytes(score),
+		}, &pb.PutRequest{
+			Key:           sortedSetScoreKey(request.Key, score, member),
+			PartitionHash: request.PartitionHash,
+			UpdatedAtNs:   nowInNano,
+			OpAndDataType: pb.OpAndDataType_BYTES,
+		})
+	}
+
+	var rawPuts []*pb.RawKeyValue
+	for _, put := range puts {
+		rawPuts = append(rawPuts, &pb.RawKeyValue{
+			Key:   put.Key,
+			Value: codec.NewPutEntry(put, nowInNano).ToBytes(),
+		})
+	}
+	var rawDeletes [][]byte
+	for _, del := range deletes {
+		rawDeletes = append(rawDeletes, del.Key)
+	}
+	if err := shard.db.WriteBatch(rawPuts, rawDeletes); err != nil {
+		return err
+	}
+
+	if !*ss.option.DisableBinLog {
+		for _, put := range puts {
+			shard.logPut(put, nowInNano)
+		}
+		for _, del := range deletes {
+			shard.logDelete(del, nowInNano)
+		}
+	}
+
+	return nil
+}
+
+func (s *shard) sortedSetScore(memberKey []byte) (score float64, found bool, err error) {
+	b, err := s.db.Get(memberKey)
+	if err != nil || len(b) == 0 {
+		return 0, false, err
+	}
+	entry, err := codec.Decode(b)
+	if err != nil {
+		return 0, false, fmt.Errorf("decode %v: %v", string(memberKey), err)
+	}
+	if len(entry.Value) != 8 {
+		return 0, false, fmt.Errorf("unexpected score of %v", string(memberKey))
+	}
+	return util.BytesToFloat64(entry.Value), true, nil
+}
+
+// sortedSetRange lists the members between the score keys, by descending scores unless ascending.
+func (s *shard) sortedSetRange(key, startKey, endKey []byte, ascending bool, limit int) (members []*pb.SortedSetMember, err error) {
+
+	prefixLength := len(sortedSetPrefix(key, 's'))
+	err = s.db.RangeScan(startKey, false, endKey, false, !ascending, func(k, v []byte) bool {
+		if len(k) < prefixLength+8 {
+			return true
+		}
+		member := make([]byte, len(k)-prefixLength-8)
+		copy(member, k[prefixLength+8:])
+		members = append(members, &pb.SortedSetMember{
+			Member: member,
+			Score:  decodeSortableScore(k[prefixLength : prefixLength+8]),
+		})
+		return limit <= 0 || len(members) < limit
+	})
+
+	return members, err
+}
+
+// sortedSetRank counts the members ahead of the member, by descending scores unless ascending.
+func (s *shard) sortedSetRank(key, member []byte, ascending bool) (rank int64, err error) {
+
+	score, found, err := s.sortedSetScore(sortedSetMemberKey(key, member))
+	if err != nil || !found {
+		return -1, err
+	}
+
+	scoreKey := sortedSetScoreKey(key, score, member)
+	startKey, startExclusive, endKey := scoreKey, true, sortedSetPrefix(key, 't')
+	if ascending {
+		startKey, startExclusive, endKey = sortedSetPrefix(key, 's'), false, scoreKey
+	}
+
+	err = s.db.RangeScan(startKey, startExclusive, endKey, false, false, func(k, v []byte) bool {
+		rank++
+		return true
+	})
+
+	return rank, err
+}
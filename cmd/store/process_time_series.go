@@ -0,0 +1,88 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func (ss *storeServer) processTimeSeries(shard *shard, request *pb.TimeSeriesRequest) *pb.TimeSeriesResponse {
+
+	resp := &pb.TimeSeriesResponse{
+		Ok: true,
+	}
+
+	b, err := shard.db.Get(request.Key)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+	if len(b) == 0 {
+		return resp
+	}
+	entry, err := codec.Decode(b)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = fmt.Sprintf("decode %v: %v", string(request.Key), err)
+		return resp
+	}
+	if entry.IsExpired() {
+		return resp
+	}
+	if entry.OpAndDataType != codec.OpAndDataType(pb.OpAndDataType_TIME_SERIES) {
+		resp.Ok = false
+		resp.Status = fmt.Sprintf("%v is not a time series", string(request.Key))
+		return resp
+	}
+	ts, err := codec.DecodeTimeSeries(entry.Value)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = fmt.Sprintf("decode time series %v: %v", string(request.Key), err)
+		return resp
+	}
+
+	// the compaction filter may not have dropped the old points yet
+	ts.Trim(time.Now())
+
+	var bucket *pb.TimeSeriesBucket
+	for _, p := range ts.Points {
+		if p.TimestampMs < request.StartMs {
+			continue
+		}
+		if request.EndMs != 0 && p.TimestampMs >= request.EndMs {
+			break
+		}
+		if request.BucketMs <= 0 {
+			resp.Points = append(resp.Points, &pb.TimeSeriesPoint{
+				TimestampMs: p.TimestampMs,
+				Value:       p.Value,
+			})
+			continue
+		}
+		bucketStart := p.TimestampMs - p.TimestampMs%request.BucketMs
+		if p.TimestampMs < 0 && p.TimestampMs%request.BucketMs != 0 {
+			bucketStart -= request.BucketMs
+		}
+		if bucket == nil || bucket.StartMs != bucketStart {
+			bucket = &pb.TimeSeriesBucket{
+				StartMs: bucketStart,
+				Min:     p.Value,
+				Max:     p.Value,
+			}
+			resp.Buckets = append(resp.Buckets, bucket)
+		}
+		if p.Value < bucket.Min {
+			bucket.Min = p.Value
+		}
+		if p.Value > bucket.Max {
+			bucket.Max = p.Value
+		}
+		bucket.Sum += p.Value
+		bucket.Count++
+	}
+
+	return resp
+}
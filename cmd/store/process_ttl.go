@@ -0,0 +1,147 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/golang/protobuf/proto"
+)
+
+func (ss *storeServer) processTtl(shard *shard, request *pb.TtlRequest) *pb.TtlResponse {
+
+	resp := &pb.TtlResponse{
+		Ok: true,
+	}
+
+	if request.Op == pb.TtlRequest_GET {
+		entry, err := shard.getUnexpiredEntry(request.Key)
+		if err != nil {
+			resp.Ok = false
+			resp.Status = err.Error()
+		} else if entry != nil {
+			resp.Found = true
+			resp.RemainingSecond = entry.RemainingTtlSecond(time.Now())
+		}
+		return resp
+	}
+
+	if request.Op == pb.TtlRequest_TOUCH && request.TtlSecond == 0 {
+		resp.Ok = false
+		resp.Status = "missing ttl to touch, use persist to remove the ttl"
+		return resp
+	}
+
+	if maxTtlSecond := ss.getKeyspaceSettings(shard.keyspace).GetMaxTtlSecond(); maxTtlSecond > 0 {
+		if request.Op == pb.TtlRequest_PERSIST || request.TtlSecond > maxTtlSecond {
+			resp.Ok = false
+			resp.Status = fmt.Sprintf("keyspace %s allows ttl up to %d seconds", shard.keyspace, maxTtlSecond)
+			return resp
+		}
+	}
+
+	nowInNano := uint64(time.Now().UnixNano())
+
+	unlock := shard.lockKey(request.Key)
+	defer unlock()
+
+	entry, err := shard.updateTtl(request, nowInNano)
+	if err != nil {
+		resp.Ok = false
+		resp.Status = err.Error()
+		return resp
+	}
+	if entry == nil {
+		return resp
+	}
+
+	resp.Found = true
+	resp.RemainingSecond = entry.RemainingTtlSecond(time.Now())
+	if !*ss.option.DisableBinLog {
+		shard.logTtl(request, nowInNano)
+	}
+
+	return resp
+}
+
+func (s *shard) getUnexpiredEntry(key []byte) (*codec.Entry, error) {
+	b, err := s.db.Get(key)
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+	entry, err := codec.Decode(b)
+	if err != nil {
+		return nil, fmt.Errorf("decode %v: %v", string(key), err)
+	}
+	if entry.IsExpired() {
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// updateTtl changes the ttl of the key, and the chunks of a large value, as of changedAtNs.
+// The updated_at time is kept, so the change does not win over a later write, and
+// replaying the change from the binlog gives the same result.
+// It returns nil entry if the key is not found or already expired. The key should be locked.
+func (s *shard) updateTtl(request *pb.TtlRequest, changedAtNs uint64) (*codec.Entry, error) {
+
+	entry, err := s.getUnexpiredEntry(request.Key)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	var expiresAtNs uint64
+	if request.Op == pb.TtlRequest_TOUCH {
+		expiresAtNs = changedAtNs + uint64(request.TtlSecond)*1e9
+	}
+
+	keys := [][]byte{request.Key}
+	if entry.OpAndDataType == codec.OpAndDataType(pb.OpAndDataType_CHUNK_MANIFEST) {
+		manifest := &pb.ChunkManifest{}
+		if err = proto.Unmarshal(entry.Value, manifest); err != nil {
+			return nil, fmt.Errorf("unmarshal chunk manifest %v: %v", string(request.Key), err)
+		}
+		for i := 0; i < int(manifest.ChunkCount); i++ {
+			keys = append(keys, manifest.ChunkKey(request.Key, i))
+		}
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		// the chunks first, so the manifest does not outlive its chunks if interrupted
+		t := entry
+		if i > 0 {
+			if t, err = s.getUnexpiredEntry(keys[i]); err != nil {
+				return nil, err
+			}
+			if t == nil {
+				glog.Errorf("%s missing chunk %v", s, string(keys[i]))
+				continue
+			}
+		}
+		t.ExpireAt(expiresAtNs)
+		if err = s.writeLockedEntry(keys[i], t); err != nil {
+			return nil, err
+		}
+	}
+
+	return entry, nil
+}
+
+func (s *shard) logTtl(request *pb.TtlRequest, updatedAtNs uint64) {
+
+	if s.lm == nil {
+		return
+	}
+
+	err := s.lm.AppendEntry(&pb.LogEntry{
+		UpdatedAtNs: updatedAtNs,
+		Ttl:         request,
+	})
+
+	if err != nil {
+		glog.Errorf("append ttl log entry: %v", err)
+	}
+
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+// scanFilter evaluates the pb.ScanFilter conditions on each entry during scans
+type scanFilter struct {
+	filter    *pb.ScanFilter
+	dataTypes map[codec.OpAndDataType]bool
+	keyGlob   *regexp.Regexp
+	keyRegex  *regexp.Regexp
+}
+
+func newScanFilter(filter *pb.ScanFilter) (f *scanFilter, err error) {
+	f = &scanFilter{
+		filter: filter,
+	}
+	if filter == nil {
+		return f, nil
+	}
+	if len(filter.DataTypes) > 0 {
+		f.dataTypes = make(map[codec.OpAndDataType]bool)
+		for _, dataType := range filter.DataTypes {
+			f.dataTypes[codec.OpAndDataType(dataType)] = true
+		}
+	}
+	if filter.KeyGlob != "" {
+		if f.keyGlob, err = regexp.Compile(globToRegex(filter.KeyGlob)); err != nil {
+			return nil, fmt.Errorf("key glob %s: %v", filter.KeyGlob, err)
+		}
+	}
+	if filter.KeyRegex != "" {
+		if f.keyRegex, err = regexp.Compile(filter.KeyRegex); err != nil {
+			return nil, fmt.Errorf("key regex %s: %v", filter.KeyRegex, err)
+		}
+	}
+	return f, nil
+}
+
+func (f *scanFilter) matches(key []byte, entry *codec.Entry) bool {
+	if f.filter == nil {
+		return true
+	}
+	if f.dataTypes != nil && !f.dataTypes[valueDataType(entry)] {
+		return false
+	}
+	if f.filter.UpdatedAfterNs > 0 && entry.UpdatedAtNs <= f.filter.UpdatedAfterNs {
+		return false
+	}
+	if r := f.filter.Float64Range; r != nil {
+		if !isFloat64DataType(entry.OpAndDataType) || len(entry.Value) != 8 {
+			return false
+		}
+		if x := util.BytesToFloat64(entry.Value); x < r.Min || x > r.Max {
+			return false
+		}
+	}
+	if f.keyGlob != nil && !f.keyGlob.Match(key) {
+		return false
+	}
+	if f.keyRegex != nil && !f.keyRegex.Match(key) {
+		return false
+	}
+	return true
+}
+
+// valueDataType reports chunked values as bytes, the type the clients see after resolving the chunks.
+func valueDataType(entry *codec.Entry) codec.OpAndDataType {
+	if entry.OpAndDataType == codec.OpAndDataType(pb.OpAndDataType_CHUNK_MANIFEST) {
+		return codec.OpAndDataType(pb.OpAndDataType_BYTES)
+	}
+	return entry.OpAndDataType
+}
+
+func isFloat64DataType(t codec.OpAndDataType) bool {
+	switch pb.OpAndDataType(t) {
+	case pb.OpAndDataType_FLOAT64, pb.OpAndDataType_MAX_FLOAT64, pb.OpAndDataType_MIN_FLOAT64:
+		return true
+	}
+	return false
+}
+
+// toKeyTypeValue converts the matching entry, leaving out the value if only keys are needed.
+func (f *scanFilter) toKeyTypeValue(key []byte, entry *codec.Entry) *pb.KeyTypeValue {
+	t := make([]byte, len(key))
+	copy(t, key)
+	kv := &pb.KeyTypeValue{
+		Key:           t,
+		PartitionHash: entry.PartitionHash,
+		DataType:      pb.OpAndDataType(entry.OpAndDataType),
+	}
+	if !f.filter.GetKeysOnly() {
+		kv.Value = entry.Value
+	}
+	return kv
+}
+
+// globToRegex converts a glob pattern, where * matches any bytes and ? matches one byte, to a regular expression.
+func globToRegex(glob string) string {
+	quoted := regexp.QuoteMeta(glob)
+	quoted = strings.Replace(quoted, `\*`, `.*`, -1)
+	quoted = strings.Replace(quoted, `\?`, `.`, -1)
+	return "^(?s:" + quoted + ")$"
+}
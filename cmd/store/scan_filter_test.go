@@ -0,0 +1,117 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+func TestScanFilterMatches(t *testing.T) {
+
+	bytesEntry := &codec.Entry{
+		UpdatedAtNs:   5,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("v"),
+	}
+	floatEntry := &codec.Entry{
+		UpdatedAtNs:   10,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_MAX_FLOAT64),
+		Value:         util.Float64ToBytes(2.5),
+	}
+	chunkedEntry := &codec.Entry{
+		UpdatedAtNs:   10,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_CHUNK_MANIFEST),
+	}
+
+	tests := []struct {
+		name     string
+		filter   *pb.ScanFilter
+		key      string
+		entry    *codec.Entry
+		expected bool
+	}{
+		{"no filter", nil, "a", bytesEntry, true},
+		{"data type", &pb.ScanFilter{DataTypes: []pb.OpAndDataType{pb.OpAndDataType_BYTES}}, "a", bytesEntry, true},
+		{"other data type", &pb.ScanFilter{DataTypes: []pb.OpAndDataType{pb.OpAndDataType_BYTES}}, "a", floatEntry, false},
+		{"chunked value as bytes", &pb.ScanFilter{DataTypes: []pb.OpAndDataType{pb.OpAndDataType_BYTES}}, "a", chunkedEntry, true},
+		{"updated after", &pb.ScanFilter{UpdatedAfterNs: 5}, "a", floatEntry, true},
+		{"not updated after", &pb.ScanFilter{UpdatedAfterNs: 5}, "a", bytesEntry, false},
+		{"in float64 range", &pb.ScanFilter{Float64Range: &pb.ScanFilter_Float64Range{Min: 2, Max: 2.5}}, "a", floatEntry, true},
+		{"out of float64 range", &pb.ScanFilter{Float64Range: &pb.ScanFilter_Float64Range{Min: 3, Max: 4}}, "a", floatEntry, false},
+		{"float64 range on bytes", &pb.ScanFilter{Float64Range: &pb.ScanFilter_Float64Range{Min: 0, Max: 4}}, "a", bytesEntry, false},
+		{"key glob", &pb.ScanFilter{KeyGlob: "user:*:name"}, "user:12:name", bytesEntry, true},
+		{"key glob one byte", &pb.ScanFilter{KeyGlob: "user:?:name"}, "user:12:name", bytesEntry, false},
+		{"key glob is anchored", &pb.ScanFilter{KeyGlob: "user:*"}, "x.user:1", bytesEntry, false},
+		{"key glob quotes regex", &pb.ScanFilter{KeyGlob: "a.b"}, "axb", bytesEntry, false},
+		{"key regex", &pb.ScanFilter{KeyRegex: "^user:[0-9]+$"}, "user:12", bytesEntry, true},
+		{"key regex mismatch", &pb.ScanFilter{KeyRegex: "^user:[0-9]+$"}, "user:ab", bytesEntry, false},
+		{"all conditions", &pb.ScanFilter{
+			DataTypes:      []pb.OpAndDataType{pb.OpAndDataType_MAX_FLOAT64},
+			UpdatedAfterNs: 5,
+			KeyGlob:        "s*",
+		}, "score", floatEntry, true},
+	}
+
+	for _, test := range tests {
+		f, err := newScanFilter(test.filter)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if matched := f.matches([]byte(test.key), test.entry); matched != test.expected {
+			t.Errorf("%s: matched %v, expecting %v", test.name, matched, test.expected)
+		}
+	}
+
+	if _, err := newScanFilter(&pb.ScanFilter{KeyRegex: "("}); err == nil {
+		t.Errorf("accepted an invalid key regex")
+	}
+
+}
+
+func TestScanFilterKeysOnly(t *testing.T) {
+
+	entry := &codec.Entry{
+		PartitionHash: 3,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("v"),
+	}
+
+	f, _ := newScanFilter(&pb.ScanFilter{KeysOnly: true})
+	if kv := f.toKeyTypeValue([]byte("a"), entry); string(kv.Key) != "a" || kv.PartitionHash != 3 || kv.Value != nil {
+		t.Errorf("keys only %+v", kv)
+	}
+
+	f, _ = newScanFilter(nil)
+	if kv := f.toKeyTypeValue([]byte("a"), entry); string(kv.Value) != "v" {
+		t.Errorf("value %q, expecting v", kv.Value)
+	}
+
+}
+
+func TestPrefixScanLimitsMatchingEntries(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	for i, key := range []string{"k1", "k2", "k3", "k4", "k5"} {
+		entry := codec.NewPutEntry(&pb.PutRequest{Key: []byte(key), Value: []byte("v")}, uint64(i+1))
+		if err := s.writeEntry([]byte(key), entry); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	resp := (&storeServer{}).processPrefix(s, &pb.GetByPrefixRequest{
+		Prefix: []byte("k"),
+		Limit:  2,
+		Filter: &pb.ScanFilter{UpdatedAfterNs: 2},
+	})
+	if !resp.Ok {
+		t.Fatalf("prefix scan: %s", resp.Status)
+	}
+	if len(resp.KeyValues) != 2 || string(resp.KeyValues[0].Key) != "k3" || string(resp.KeyValues[1].Key) != "k4" {
+		t.Errorf("scanned %v, expecting k3 and k4", resp.KeyValues)
+	}
+
+}
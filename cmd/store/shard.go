@@ -15,6 +15,9 @@ import (
 	"time"
 )
 
+// the keys of a shard share this many locks
+const constKeyLockCount = 256
+
 // VastoShardId shard id in vasto
 type VastoShardId int
 
@@ -22,23 +25,52 @@ type VastoShardId int
 type VastoServerId int
 
 type shard struct {
-	keyspace            string
-	id                  VastoShardId
-	serverId            VastoServerId
-	db                  *rocks.Rocks
-	lm                  *binlog.LogManager
-	cluster             *topology.Cluster
-	clusterListener     *clusterlistener.ClusterListener
-	nodeFinishChan      chan bool
-	cancelFunc          context.CancelFunc
-	isShutdown          bool
-	followProgress      map[progressKey]progressValue
-	followProgressLock  sync.Mutex
-	followProcesses     map[topology.ClusterShard]*followProcess
-	followProcessesLock sync.Mutex
-	ctx                 context.Context
-	oneTimeFollowCancel context.CancelFunc
-	hasBackfilled       bool // whether addSst() has been called on this db
+	keyspace              string
+	id                    VastoShardId
+	serverId              VastoServerId
+	db                    *rocks.Rocks
+	lm                    *binlog.LogManager
+	cluster               *topology.Cluster
+	clusterListener       *clusterlistener.ClusterListener
+	nodeFinishChan        chan bool
+	cancelFunc            context.CancelFunc
+	isShutdown            bool
+	shutdownLock          sync.Mutex
+	followProgress        map[progressKey]progressValue
+	followSyncedAtNs      map[progressKey]int64
+	followProgressLock    sync.Mutex
+	followProcesses       map[topology.ClusterShard]*followProcess
+	followProcessesLock   sync.Mutex
+	ctx                   context.Context
+	oneTimeFollowCancel   context.CancelFunc
+	hasBackfilled         bool // whether addSst() has been called on this db
+	indexes               []*pb.IndexDefinition
+	indexesLock           sync.RWMutex
+	readModifyWriteLock   sync.Mutex // for the sorted set writes, which change multiple keys
+	keyLocks              [constKeyLockCount]sync.Mutex
+	hasExpiringEntries    int32 // set once any entry may have a ttl
+	hasChunkManifests     int32 // set once any value may be split into chunks
+	lastChunkSweep        time.Time
+	orphanChunkCandidates map[string]bool // the chunks without a manifest in the last sweep
+	expirySubscribers     map[*expirySubscriber]bool
+	expirySubscribersLock sync.Mutex
+	// following the same keyspace in other data centers
+	remoteClusters                 map[string]*pb.Cluster
+	crossDataCenterFollowProcesses map[crossDataCenterPeer]*followProcess
+	crossDataCenterSyncedAtNs      map[crossDataCenterPeer]int64
+	crossDataCenterLock            sync.Mutex
+	// copying from the bootstrap sources
+	bootstrapProgresses   map[string]*pb.BootstrapProgress
+	bootstrapProgressLock sync.Mutex
+	// the bootstraps in progress, which must finish before the db is closed
+	bootstrapWaitGroup sync.WaitGroup
+}
+
+// lockKey serializes the writes of the key, which read the key before writing it.
+func (s *shard) lockKey(key []byte) (unlock func()) {
+	lock := &s.keyLocks[util.Hash(key)%constKeyLockCount]
+	lock.Lock()
+	return lock.Unlock
 }
 
 func (s *shard) String() string {
@@ -67,14 +99,22 @@ func newShard(keyspaceName, dir string, serverId, nodeId int, cluster *topology.
 			glog.V(1).Infof("cancelling shard %d.%d", serverId, nodeId)
 			cancelFunc()
 		},
-		followProgress:  make(map[progressKey]progressValue),
-		followProcesses: make(map[topology.ClusterShard]*followProcess),
-		ctx:             ctx,
+		followProgress:                 make(map[progressKey]progressValue),
+		followSyncedAtNs:               make(map[progressKey]int64),
+		followProcesses:                make(map[topology.ClusterShard]*followProcess),
+		ctx:                            ctx,
+		expirySubscribers:              make(map[*expirySubscriber]bool),
+		remoteClusters:                 make(map[string]*pb.Cluster),
+		crossDataCenterFollowProcesses: make(map[crossDataCenterPeer]*followProcess),
+		crossDataCenterSyncedAtNs:      make(map[crossDataCenterPeer]int64),
+		bootstrapProgresses:            make(map[string]*pb.BootstrapProgress),
 	}
 	if logFileSizeMb > 0 {
 		s.lm = binlog.NewLogManager(dir, nodeId, int64(logFileSizeMb*1024*1024), logFileCount)
 		s.lm.Initialze()
 	}
+	s.checkExpiringEntries()
+	s.checkChunkManifests()
 
 	return s
 }
@@ -83,7 +123,9 @@ func (s *shard) shutdownNode() {
 
 	glog.V(1).Infof("shutdownNode: %+v", s)
 
+	s.shutdownLock.Lock()
 	s.isShutdown = true
+	s.shutdownLock.Unlock()
 
 	s.cancelFunc()
 
@@ -95,13 +137,62 @@ func (s *shard) shutdownNode() {
 
 }
 
+func (s *shard) hasShutdown() bool {
+	s.shutdownLock.Lock()
+	defer s.shutdownLock.Unlock()
+	return s.isShutdown
+}
+
+// waitForBootstraps waits for the bootstraps cancelled by shutdownNode() to return
+func (s *shard) waitForBootstraps() {
+	s.bootstrapWaitGroup.Wait()
+}
+
+// startBootstrap derives the context of a bootstrap, which is also cancelled when the shard shuts down
+func (s *shard) startBootstrap(ctx context.Context) (context.Context, func(), error) {
+
+	s.shutdownLock.Lock()
+	defer s.shutdownLock.Unlock()
+	if s.isShutdown {
+		return nil, nil, fmt.Errorf("%s is shut down", s.String())
+	}
+	s.bootstrapWaitGroup.Add(1)
+
+	bootstrapCtx, cancelFunc := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			cancelFunc()
+		case <-bootstrapCtx.Done():
+		}
+	}()
+
+	return bootstrapCtx, func() {
+		cancelFunc()
+		s.bootstrapWaitGroup.Done()
+	}, nil
+}
+
 func (s *shard) setCompactionFilterClusterSize(clusterSize int) {
 
-	s.db.SetCompactionForShard(int(s.id), clusterSize)
+	s.db.SetCompactionForShard(int(s.id), s.logicalShardCount(clusterSize))
+
+}
 
+// logicalShardCount is the number of shards the keys are hashed into, for the cluster size
+func (s *shard) logicalShardCount(clusterSize int) int {
+	return topology.LogicalShardCount(clusterSize, s.cluster.ShardCount())
 }
 
-func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, selfAdminAddress string, existingPrimaryShards []*pb.ClusterNode) error {
+// startWithBootstrapPlan bootstraps the shard, and starts following its peers.
+// The bootstrap stops if ctx is cancelled or the shard shuts down.
+func (s *shard) startWithBootstrapPlan(ctx context.Context, bootstrapPlan *topology.BootstrapPlan, selfAdminAddress string, existingPrimaryShards []*pb.ClusterNode) error {
+
+	ctx, finishBootstrap, err := s.startBootstrap(ctx)
+	if err != nil {
+		return err
+	}
+	defer finishBootstrap()
 
 	if len(existingPrimaryShards) == 0 {
 		for i := 0; i < s.cluster.ExpectedSize(); i++ {
@@ -113,7 +204,7 @@ func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, se
 
 	// bootstrap the data from peers
 	if s.cluster != nil {
-		err := s.maybeBootstrapAfterRestart(s.ctx)
+		err := s.maybeBootstrapAfterRestart(ctx)
 		if err != nil {
 			glog.Errorf("normal bootstrap %s: %v", s.String(), err)
 			return fmt.Errorf("normal bootstrap %s: %v", s.String(), err)
@@ -121,10 +212,20 @@ func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, se
 	}
 
 	// bootstrap if any topology change
-	s.topoChangeBootstrap(context.Background(), bootstrapPlan, existingPrimaryShards)
+	if err := s.topoChangeBootstrap(ctx, bootstrapPlan, existingPrimaryShards); err != nil && ctx.Err() != nil {
+		return fmt.Errorf("topology change bootstrap %s: %v", s.String(), err)
+	}
+
+	// the bootstrapped entries may have a ttl, or be split into chunks
+	s.checkExpiringEntries()
+	s.checkChunkManifests()
 
 	// add normal follow
-	s.adjustNormalFollowings(bootstrapPlan.ToClusterSize, s.cluster.ReplicationFactor())
+	replicationFactor := bootstrapPlan.ToReplicationFactor
+	if replicationFactor == 0 {
+		replicationFactor = s.cluster.ReplicationFactor()
+	}
+	s.adjustNormalFollowings(bootstrapPlan.ToClusterSize, replicationFactor)
 
 	oneTimeFollowCtx, oneTimeFollowCancelFunc := context.WithCancel(context.Background())
 
@@ -137,7 +238,7 @@ func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, se
 				fmt.Sprintf("%s one-time follow %d.%d", s.String(), shard.ServerId, shard.ShardId),
 				shard.ServerId,
 				func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
-					return s.followChanges(oneTimeFollowCtx, node, grpcConnection, shard.ShardId, bootstrapPlan.ToClusterSize, true)
+					return s.followChanges(oneTimeFollowCtx, node, grpcConnection, shard.ShardId, s.logicalShardCount(bootstrapPlan.ToClusterSize), true)
 				},
 			)
 			if err != nil {
@@ -158,7 +259,7 @@ func (s *shard) startWithBootstrapPlan(bootstrapPlan *topology.BootstrapPlan, se
 
 func (s *shard) adjustNormalFollowings(clusterSize, replicationFactor int) {
 
-	followTargetPeers := topology.PeerShards(int(s.serverId), int(s.id), clusterSize, replicationFactor)
+	followTargetPeers := topology.PeerVirtualShards(int(s.serverId), int(s.id), clusterSize, s.cluster.ShardCount(), replicationFactor)
 
 	glog.V(2).Infof("%s follow peers %+v cluster %d replication %d", s.String(), followTargetPeers, clusterSize, replicationFactor)
 
@@ -180,7 +281,7 @@ func (s *shard) adjustNormalFollowings(clusterSize, replicationFactor int) {
 					fmt.Sprintf("%s follow %d.%d", s.String(), serverId, shardId),
 					serverId,
 					func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
-						return s.followChanges(ctx, node, grpcConnection, shardId, clusterSize, false)
+						return s.followChanges(ctx, node, grpcConnection, shardId, s.logicalShardCount(clusterSize), false)
 					},
 				)
 			},
@@ -19,7 +19,7 @@ import (
 )
 
 func (s *shard) peerShards() []topology.ClusterShard {
-	return topology.PeerShards(int(s.serverId), int(s.id), s.cluster.ExpectedSize(), s.cluster.ReplicationFactor())
+	return topology.PeerVirtualShards(int(s.serverId), int(s.id), s.cluster.ExpectedSize(), s.cluster.ShardCount(), s.cluster.ReplicationFactor())
 }
 
 /*
@@ -48,7 +48,7 @@ func (s *shard) maybeBootstrapAfterRestart(ctx context.Context) error {
 			return err
 		}
 		if !canTailBinlog {
-			return s.doBootstrapCopy(ctx, grpcConnection, node, s.cluster.ExpectedSize(), 0, 0)
+			return s.doBootstrapCopy(ctx, grpcConnection, node, s.cluster.LogicalShardCount(), 0, 0)
 		}
 		return nil
 	})
@@ -73,7 +73,7 @@ func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology
 
 		return topology.VastoNodes(existingPrimaryShards).WithConnection(fmt.Sprintf("%s bootstrap from one exisiting %d.%d", s.String(), bestPeerToCopy.ServerId, bestPeerToCopy.ShardId),
 			bestPeerToCopy.ServerId, func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
-				return s.doBootstrapCopy(ctx, grpcConnection, node, bootstrapPlan.FromClusterSize, bootstrapPlan.ToClusterSize, int(s.id))
+				return s.doBootstrapCopy(ctx, grpcConnection, node, s.logicalShardCount(bootstrapPlan.FromClusterSize), s.logicalShardCount(bootstrapPlan.ToClusterSize), int(s.id))
 			})
 	}
 
@@ -93,7 +93,7 @@ func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology
 					fmt.Sprintf("%s bootstrap copy from existing server %d", s.String(), serverId),
 					serverId,
 					func(node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
-						return s.doBootstrapCopy2(ctx, grpcConnection, node, bootstrapPlan.FromClusterSize, bootstrapPlan.ToClusterSize, int(s.id), sourceChan)
+						return s.doBootstrapCopy2(ctx, grpcConnection, node, s.logicalShardCount(bootstrapPlan.FromClusterSize), s.logicalShardCount(bootstrapPlan.ToClusterSize), int(s.id), sourceChan)
 					},
 				)
 			})
@@ -135,13 +135,13 @@ func (s *shard) topoChangeBootstrap(ctx context.Context, bootstrapPlan *topology
 				}
 
 				existingRow := codec.FromBytes(b)
-				if existingRow.IsExpired() {
+				if existingRow == nil || existingRow.IsExpired() {
 					expiredCounter++
 					return s.db.Put(keyValue.Key, keyValue.Value)
 				}
 
 				incomingRow := codec.FromBytes(keyValue.Value)
-				if existingRow.UpdatedAtNs < incomingRow.UpdatedAtNs {
+				if incomingRow != nil && existingRow.UpdatedAtNs < incomingRow.UpdatedAtNs {
 					updatedCounter++
 					return s.db.Put(keyValue.Key, keyValue.Value)
 				}
@@ -199,12 +199,28 @@ func (s *shard) doBootstrapCopy(ctx context.Context, grpcConnection *grpc.Client
 
 	glog.V(1).Infof("bootstrap %s from %s %s filter by %d/%d", s.String(), node.StoreResource.Address, node.ShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize)
 
-	s.db.Close()
-	s.db.Destroy()
-	s.db.EnsureDirectory()
-	s.db.Reopen()
+	client := pb.NewVastoStoreClient(grpcConnection)
+
+	checkpointName := bootstrapCheckpointName(node.StoreResource.GetAdminAddress(), node.ShardInfo.ShardId, clusterSize, targetClusterSize, targetShardId)
+	checkpoint, found := s.loadBootstrapCheckpoint(checkpointName)
+	if found {
+		glog.V(1).Infof("bootstrap %s resumes after key %s", s.String(), string(checkpoint.lastKey))
+	} else {
+		s.resetBootstrapDb()
+	}
 
-	counter, segment, offset, err := s.writeToSst(ctx, grpcConnection, node.ShardInfo, clusterSize, targetClusterSize, targetShardId)
+	source := fmt.Sprintf("%s %s", node.StoreResource.Address, node.ShardInfo.IdentifierOnThisServer())
+	s.startBootstrapProgress(source)
+	defer s.stopBootstrapProgress(source)
+
+	counter, err := s.writeToSst(ctx, client, node.ShardInfo, source, checkpoint, clusterSize, targetClusterSize, targetShardId)
+	if err == errBootstrapBinlogGone {
+		// the copied entries can not catch up by following the binlog
+		glog.V(0).Infof("bootstrap %s from %s starts over: %v", s.String(), source, err)
+		s.resetBootstrapDb()
+		checkpoint = &bootstrapCheckpoint{name: checkpointName}
+		counter, err = s.writeToSst(ctx, client, node.ShardInfo, source, checkpoint, clusterSize, targetClusterSize, targetShardId)
+	}
 
 	glog.V(1).Infof("bootstrap %s from %s %s filter by %d/%d received %d entries", s.String(), node.StoreResource.Address, node.ShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize, counter)
 
@@ -212,129 +228,252 @@ func (s *shard) doBootstrapCopy(ctx context.Context, grpcConnection *grpc.Client
 		return fmt.Errorf("writeToSst: %v", err)
 	}
 
-	return s.saveProgress(node.StoreResource.GetAdminAddress(), VastoShardId(node.ShardInfo.ShardId), segment, offset)
+	if err = s.saveProgress(node.StoreResource.GetAdminAddress(), VastoShardId(node.ShardInfo.ShardId), checkpoint.segment, checkpoint.offset); err != nil {
+		return err
+	}
+
+	s.clearBootstrapCheckpoint(checkpointName)
+
+	return nil
+
+}
 
+// resetBootstrapDb deletes all local data, including the bootstrap checkpoints
+func (s *shard) resetBootstrapDb() {
+	s.db.Close()
+	s.db.Destroy()
+	s.db.EnsureDirectory()
+	s.db.Reopen()
 }
 
-func (s *shard) doBootstrapCopy2(ctx context.Context, grpcConnection *grpc.ClientConn, node *pb.ClusterNode, clusterSize, targetClusterSize int, targetShardId int, rowChan chan *pb.RawKeyValue) (err error) {
+// doBootstrapCopy2 sends the entries from the source to rowChan, and resumes after the last sent key if the copy is interrupted.
+func (s *shard) doBootstrapCopy2(ctx context.Context, grpcConnection *grpc.ClientConn, node *pb.ClusterNode, clusterSize, targetClusterSize int, targetShardId int, rowChan chan *pb.RawKeyValue) error {
 
 	glog.V(1).Infof("bootstrap2 %s from %s %s filter by %d/%d", s.String(), node.StoreResource.Address, node.ShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize)
 
 	client := pb.NewVastoStoreClient(grpcConnection)
 
-	request := &pb.BootstrapCopyRequest{
-		Keyspace:          s.keyspace,
-		ShardId:           node.ShardInfo.ShardId,
-		ClusterSize:       uint32(clusterSize),
-		TargetShardId:     uint32(targetShardId),
-		TargetClusterSize: uint32(targetClusterSize),
-		Origin:            s.String(),
+	source := fmt.Sprintf("%s %s", node.StoreResource.Address, node.ShardInfo.IdentifierOnThisServer())
+	s.startBootstrapProgress(source)
+	defer s.stopBootstrapProgress(source)
+
+	// the rows are merged from all sources, so the checkpoint is only kept in memory
+	checkpoint := &bootstrapCheckpoint{}
+	var counter int64
+
+	for retries := 0; ; retries++ {
+
+		if retries > 0 {
+			if err := s.checkBootstrapBinlog(ctx, client, node.ShardInfo, checkpoint); err != nil {
+				return err
+			}
+		}
+
+		request := &pb.BootstrapCopyRequest{
+			Keyspace:          s.keyspace,
+			ShardId:           node.ShardInfo.ShardId,
+			ClusterSize:       uint32(clusterSize),
+			TargetShardId:     uint32(targetShardId),
+			TargetClusterSize: uint32(targetClusterSize),
+			Origin:            s.String(),
+			StartAfterKey:     checkpoint.lastKey,
+		}
+
+		received, isComplete, copyErr := s.sendToChannelOnce(ctx, client, request, source, checkpoint, rowChan)
+		counter += received
+		if isComplete {
+			break
+		}
+
+		if err := s.waitToRetryBootstrap(ctx, source, retries+1, checkpoint, copyErr); err != nil {
+			return fmt.Errorf("bootstrap copy: %v", err)
+		}
+
 	}
 
+	glog.V(1).Infof("bootstrap2 %s from %s received %d entries, segment:offset=%d:%d", s.String(), node.ShardInfo.IdentifierOnThisServer(), counter, checkpoint.segment, checkpoint.offset)
+
+	return s.saveProgress(node.StoreResource.GetAdminAddress(), VastoShardId(node.ShardInfo.ShardId), checkpoint.segment, checkpoint.offset)
+}
+
+// sendToChannelOnce moves the checkpoint after each entry sent to rowChan.
+func (s *shard) sendToChannelOnce(ctx context.Context, client pb.VastoStoreClient, request *pb.BootstrapCopyRequest, source string, checkpoint *bootstrapCheckpoint, rowChan chan *pb.RawKeyValue) (counter int64, isComplete bool, err error) {
+
 	stream, err := client.BootstrapCopy(ctx, request)
 	if err != nil {
-		return fmt.Errorf("client.TailBinlog: %v", err)
+		return 0, false, err
 	}
 
-	var segment uint32
-	var offset uint64
-	var counter int
-
 	for {
 
 		response, err := stream.Recv()
 		if err == io.EOF {
-			break
+			return counter, true, nil
 		}
 		if err != nil {
-			return fmt.Errorf("bootstrap copy: %v", err)
+			return counter, false, err
 		}
 
 		for _, keyValue := range response.KeyValues {
-
-			// fmt.Printf("%s add to sst: %v\n", sourceShardInfo.IdentifierOnThisServer(), string(keyValue.Key))
-			t := keyValue
-			rowChan <- t
+			select {
+			case rowChan <- keyValue:
+			case <-ctx.Done():
+				return counter, false, ctx.Err()
+			}
 			counter++
-
+			checkpoint.lastKey = keyValue.Key
 		}
+		s.addBootstrapProgress(source, response.KeyValues)
 
-		if response.BinlogTailProgress != nil {
-			segment = response.BinlogTailProgress.Segment
-			offset = response.BinlogTailProgress.Offset
-		}
+		checkpoint.setBinlogProgress(response.BinlogTailProgress)
 
 	}
 
-	glog.V(1).Infof("bootstrap2 %s from %s received %d entries, segment:offset=%d:%d : %v", s.String(), node.ShardInfo.IdentifierOnThisServer(), counter, segment, offset, err)
-	s.saveProgress(node.StoreResource.GetAdminAddress(), VastoShardId(node.ShardInfo.ShardId), segment, offset)
-
-	return
 }
 
-func (s *shard) writeToSst(ctx context.Context, grpcConnection *grpc.ClientConn, sourceShardInfo *pb.ShardInfo, clusterSize, targetClusterSize int, targetShardId int) (counter int64, segment uint32, offset uint64, err error) {
+// writeToSst copies from the source shard, resuming after the checkpoint if the copy is interrupted.
+// It returns errBootstrapBinlogGone if the source no longer has the binlog to follow after the checkpoint.
+func (s *shard) writeToSst(ctx context.Context, client pb.VastoStoreClient, sourceShardInfo *pb.ShardInfo, source string, checkpoint *bootstrapCheckpoint, clusterSize, targetClusterSize int, targetShardId int) (counter int64, err error) {
 
-	client := pb.NewVastoStoreClient(grpcConnection)
+	for retries := 0; ; retries++ {
+
+		if len(checkpoint.lastKey) > 0 {
+			if err = s.checkBootstrapBinlog(ctx, client, sourceShardInfo, checkpoint); err != nil {
+				return counter, err
+			}
+		}
+
+		request := &pb.BootstrapCopyRequest{
+			Keyspace:          s.keyspace,
+			ShardId:           sourceShardInfo.ShardId,
+			ClusterSize:       uint32(clusterSize),
+			TargetShardId:     uint32(targetShardId),
+			TargetClusterSize: uint32(targetClusterSize),
+			Origin:            s.String(),
+			StartAfterKey:     checkpoint.lastKey,
+		}
+
+		received, isComplete, copyErr := s.writeToSstOnce(ctx, client, request, sourceShardInfo, source, checkpoint)
+		counter += received
+		if isComplete {
+			return counter, nil
+		}
+
+		if err = s.waitToRetryBootstrap(ctx, source, retries+1, checkpoint, copyErr); err != nil {
+			return counter, err
+		}
 
-	request := &pb.BootstrapCopyRequest{
-		Keyspace:          s.keyspace,
-		ShardId:           sourceShardInfo.ShardId,
-		ClusterSize:       uint32(clusterSize),
-		TargetShardId:     uint32(targetShardId),
-		TargetClusterSize: uint32(targetClusterSize),
-		Origin:            s.String(),
 	}
 
+}
+
+// writeToSstOnce ingests the received entries into sst files of at most constBootstrapSstEntries entries,
+// and moves the checkpoint forward after each ingested file.
+func (s *shard) writeToSstOnce(ctx context.Context, client pb.VastoStoreClient, request *pb.BootstrapCopyRequest, sourceShardInfo *pb.ShardInfo, source string, checkpoint *bootstrapCheckpoint) (counter int64, isComplete bool, err error) {
+
 	stream, err := client.BootstrapCopy(ctx, request)
 	if err != nil {
-		st := status.Convert(err)
-		for st.Code() == codes.Unavailable {
-			glog.V(1).Infof("%s waits on %s ...", s, sourceShardInfo.String())
-			time.Sleep(5 * time.Second)
-			stream, err = client.BootstrapCopy(ctx, request)
-			st = status.Convert(err)
-		}
-		return 0, 0, 0, fmt.Errorf("client.BootstrapCopy: %v", err)
+		return 0, false, err
 	}
 
-	err = s.db.AddSstByWriter(fmt.Sprintf("bootstrap %s from %s %d/%d", s.String(), sourceShardInfo.IdentifierOnThisServer(), targetShardId, targetClusterSize),
+	name := fmt.Sprintf("bootstrap %s from %s %d/%d", s.String(), sourceShardInfo.IdentifierOnThisServer(), request.TargetShardId, request.TargetClusterSize)
 
-		func(w *gorocksdb.SSTFileWriter) (int64, error) {
+	var streamErr error
+	for !isComplete && streamErr == nil {
 
-			for {
+		lastKey := checkpoint.lastKey
+		var sstCounter int64
+		err = s.db.AddSstByWriter(name, func(w *gorocksdb.SSTFileWriter) (int64, error) {
 
-				// println("TailBinlog receive from", s.id)
+			for sstCounter < constBootstrapSstEntries {
 
 				response, err := stream.Recv()
 				if err == io.EOF {
-					return counter, nil
+					isComplete = true
+					return sstCounter, nil
 				}
 				if err != nil {
-					return counter, fmt.Errorf("bootstrap copy: %v", err)
+					// keep the received entries, to resume after the last key
+					streamErr = err
+					return sstCounter, nil
 				}
 
 				for _, keyValue := range response.KeyValues {
-
-					// fmt.Printf("%s add to sst: %v\n", sourceShardInfo.IdentifierOnThisServer(), string(keyValue.Key))
-
-					err = w.Add(keyValue.Key, keyValue.Value)
-					if err != nil {
-						return counter, fmt.Errorf("add to sst: %v", err)
+					if err = w.Add(keyValue.Key, keyValue.Value); err != nil {
+						return sstCounter, fmt.Errorf("add to sst: %v", err)
 					}
-					counter++
-
+					sstCounter++
+					lastKey = keyValue.Key
 				}
+				s.addBootstrapProgress(source, response.KeyValues)
 
-				if response.BinlogTailProgress != nil {
-					segment = response.BinlogTailProgress.Segment
-					offset = response.BinlogTailProgress.Offset
-				}
+				checkpoint.setBinlogProgress(response.BinlogTailProgress)
 
 			}
 
+			return sstCounter, nil
 		})
+		if err != nil {
+			return counter, false, err
+		}
+		counter += sstCounter
 
-	return
+		checkpoint.lastKey = lastKey
+		if err = s.saveBootstrapCheckpoint(checkpoint); err != nil {
+			return counter, false, err
+		}
+
+	}
+
+	return counter, isComplete, streamErr
+}
+
+// checkBootstrapBinlog checks whether the source still has the binlog from the checkpoint's binlog position.
+func (s *shard) checkBootstrapBinlog(ctx context.Context, client pb.VastoStoreClient, sourceShardInfo *pb.ShardInfo, checkpoint *bootstrapCheckpoint) error {
+
+	if !checkpoint.hasBinlogProgress {
+		return nil
+	}
+
+	resp, err := client.CheckBinlog(ctx, &pb.CheckBinlogRequest{
+		Keyspace: s.keyspace,
+		ShardId:  sourceShardInfo.ShardId,
+	})
+	if err != nil {
+		return fmt.Errorf("check binlog on %s: %v", sourceShardInfo.IdentifierOnThisServer(), err)
+	}
+
+	if resp.EarliestSegment > checkpoint.segment {
+		glog.V(1).Infof("%s bootstrap checkpoint segment %d, but %s binlog starts from segment %d", s, checkpoint.segment, sourceShardInfo.IdentifierOnThisServer(), resp.EarliestSegment)
+		return errBootstrapBinlogGone
+	}
+
+	return nil
+}
+
+// waitToRetryBootstrap returns the copy error if the copy is cancelled or has failed too many times.
+func (s *shard) waitToRetryBootstrap(ctx context.Context, source string, retries int, checkpoint *bootstrapCheckpoint, copyErr error) error {
+
+	if ctx.Err() != nil {
+		return copyErr
+	}
+	if retries > constBootstrapCopyRetries {
+		return copyErr
+	}
+
+	if status.Code(copyErr) == codes.Unavailable {
+		glog.V(1).Infof("%s waits on %s, retry %d ...", s, source, retries)
+	} else {
+		glog.Errorf("%s bootstrap from %s, retry %d after key %s: %v", s, source, retries, string(checkpoint.lastKey), copyErr)
+	}
+	s.addBootstrapRetry(source)
+
+	select {
+	case <-time.After(bootstrapCopyRetryInterval):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func eachInt(ints []int, eachFunc func(index, x int) error) (err error) {
@@ -0,0 +1,160 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+)
+
+// errBootstrapBinlogGone means the copy can not resume, since the source has dropped the binlog after the checkpoint.
+var errBootstrapBinlogGone = errors.New("binlog after the bootstrap checkpoint is gone")
+
+// bootstrapCheckpoint is saved after each ingested sst file of a bootstrap copy,
+// so an interrupted copy can resume after the last key instead of starting over.
+// It is kept under VastoInternalKeyPrefix, which is skipped by compactions.
+type bootstrapCheckpoint struct {
+	name              string
+	lastKey           []byte
+	hasBinlogProgress bool
+	segment           uint32
+	offset            uint64
+}
+
+// the checkpoint is only valid for the same source shard and the same filter
+func bootstrapCheckpointName(serverAdminAddress string, shardId uint32, clusterSize, targetClusterSize, targetShardId int) string {
+	return fmt.Sprintf("%s.%d.%d.%d.%d", serverAdminAddress, shardId, clusterSize, targetShardId, targetClusterSize)
+}
+
+// setBinlogProgress keeps the binlog position from the first copy, since resumed copies start later
+func (checkpoint *bootstrapCheckpoint) setBinlogProgress(progress *pb.BootstrapCopyResponse_BinlogTailProgress) {
+	if progress == nil || checkpoint.hasBinlogProgress {
+		return
+	}
+	checkpoint.hasBinlogProgress = true
+	checkpoint.segment = progress.Segment
+	checkpoint.offset = progress.Offset
+}
+
+func genBootstrapCheckpointKeys(name string) (lastKeyKey, segmentKey, offsetKey []byte) {
+	lastKeyKey = []byte(fmt.Sprintf("%sbootstrap.key.%s", VastoInternalKeyPrefix, name))
+	segmentKey = []byte(fmt.Sprintf("%sbootstrap.segment.%s", VastoInternalKeyPrefix, name))
+	offsetKey = []byte(fmt.Sprintf("%sbootstrap.offset.%s", VastoInternalKeyPrefix, name))
+	return
+}
+
+func (s *shard) loadBootstrapCheckpoint(name string) (checkpoint *bootstrapCheckpoint, found bool) {
+
+	checkpoint = &bootstrapCheckpoint{name: name}
+
+	lastKeyKey, segmentKey, offsetKey := genBootstrapCheckpointKeys(name)
+
+	if t, err := s.db.Get(lastKeyKey); err == nil && len(t) > 0 {
+		found = true
+		checkpoint.lastKey = t
+	}
+	if t, err := s.db.Get(segmentKey); err == nil && len(t) > 0 {
+		checkpoint.hasBinlogProgress = true
+		checkpoint.segment = util.BytesToUint32(t)
+	}
+	if t, err := s.db.Get(offsetKey); err == nil && len(t) > 0 {
+		checkpoint.offset = util.BytesToUint64(t)
+	}
+
+	return
+}
+
+func (s *shard) saveBootstrapCheckpoint(checkpoint *bootstrapCheckpoint) error {
+
+	if len(checkpoint.lastKey) == 0 {
+		return nil
+	}
+
+	lastKeyKey, segmentKey, offsetKey := genBootstrapCheckpointKeys(checkpoint.name)
+
+	if err := s.db.Put(lastKeyKey, checkpoint.lastKey); err != nil {
+		return fmt.Errorf("setting %s: %v", string(lastKeyKey), err)
+	}
+	if !checkpoint.hasBinlogProgress {
+		return nil
+	}
+	if err := s.db.Put(segmentKey, util.Uint32toBytes(checkpoint.segment)); err != nil {
+		return fmt.Errorf("setting %s: %v", string(segmentKey), err)
+	}
+	if err := s.db.Put(offsetKey, util.Uint64toBytes(checkpoint.offset)); err != nil {
+		return fmt.Errorf("setting %s: %v", string(offsetKey), err)
+	}
+	return nil
+}
+
+func (s *shard) clearBootstrapCheckpoint(name string) {
+
+	lastKeyKey, segmentKey, offsetKey := genBootstrapCheckpointKeys(name)
+
+	s.db.Delete(lastKeyKey)
+	s.db.Delete(segmentKey)
+	s.db.Delete(offsetKey)
+
+}
+
+// the progress is reported to the master until the copy from the source finishes
+func (s *shard) startBootstrapProgress(source string) {
+	s.bootstrapProgressLock.Lock()
+	s.bootstrapProgresses[source] = &pb.BootstrapProgress{
+		Keyspace: s.keyspace,
+		ServerId: uint32(s.serverId),
+		ShardId:  uint32(s.id),
+		Source:   source,
+	}
+	s.bootstrapProgressLock.Unlock()
+}
+
+func (s *shard) addBootstrapProgress(source string, rows []*pb.RawKeyValue) {
+	var size int
+	for _, row := range rows {
+		size += len(row.Key) + len(row.Value)
+	}
+	s.bootstrapProgressLock.Lock()
+	if progress, found := s.bootstrapProgresses[source]; found {
+		progress.CopiedEntries += uint64(len(rows))
+		progress.CopiedBytes += uint64(size)
+	}
+	s.bootstrapProgressLock.Unlock()
+}
+
+func (s *shard) addBootstrapRetry(source string) {
+	s.bootstrapProgressLock.Lock()
+	if progress, found := s.bootstrapProgresses[source]; found {
+		progress.Retries++
+	}
+	s.bootstrapProgressLock.Unlock()
+}
+
+func (s *shard) stopBootstrapProgress(source string) {
+	s.bootstrapProgressLock.Lock()
+	if progress, found := s.bootstrapProgresses[source]; found {
+		glog.V(1).Infof("%s bootstrap from %s copied %d entries %d bytes with %d retries",
+			s, source, progress.CopiedEntries, progress.CopiedBytes, progress.Retries)
+	}
+	delete(s.bootstrapProgresses, source)
+	s.bootstrapProgressLock.Unlock()
+}
+
+func (s *shard) getBootstrapProgresses() (progresses []*pb.BootstrapProgress) {
+	s.bootstrapProgressLock.Lock()
+	for _, progress := range s.bootstrapProgresses {
+		progresses = append(progresses, &pb.BootstrapProgress{
+			Keyspace:      progress.Keyspace,
+			ServerId:      progress.ServerId,
+			ShardId:       progress.ShardId,
+			Source:        progress.Source,
+			CopiedEntries: progress.CopiedEntries,
+			CopiedBytes:   progress.CopiedBytes,
+			Retries:       progress.Retries,
+		})
+	}
+	s.bootstrapProgressLock.Unlock()
+	return
+}
@@ -0,0 +1,189 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBootstrapSource sends the rows in batches, and interrupts the first copy after some batches
+type fakeBootstrapSource struct {
+	pb.VastoStoreClient
+	rows            []*pb.RawKeyValue
+	batchSize       int
+	interruptAfter  int
+	segment         uint32
+	earliestSegment uint32
+	requests        []*pb.BootstrapCopyRequest
+}
+
+type fakeBootstrapStream struct {
+	grpc.ClientStream
+	responses []*pb.BootstrapCopyResponse
+	err       error
+}
+
+func (stream *fakeBootstrapStream) Recv() (*pb.BootstrapCopyResponse, error) {
+	if len(stream.responses) == 0 {
+		return nil, stream.err
+	}
+	response := stream.responses[0]
+	stream.responses = stream.responses[1:]
+	return response, nil
+}
+
+func (source *fakeBootstrapSource) BootstrapCopy(ctx context.Context, in *pb.BootstrapCopyRequest, opts ...grpc.CallOption) (pb.VastoStore_BootstrapCopyClient, error) {
+
+	source.requests = append(source.requests, in)
+
+	progress := &pb.BootstrapCopyResponse_BinlogTailProgress{Segment: source.segment}
+	// later copies start from a later binlog position
+	source.segment++
+
+	stream := &fakeBootstrapStream{err: io.EOF}
+	stream.responses = append(stream.responses, &pb.BootstrapCopyResponse{BinlogTailProgress: progress})
+
+	var rows []*pb.RawKeyValue
+	for _, row := range source.rows {
+		if string(row.Key) > string(in.StartAfterKey) {
+			rows = append(rows, row)
+		}
+	}
+	for batch := 0; len(rows) > 0; batch++ {
+		if len(source.requests) == 1 && batch == source.interruptAfter {
+			stream.err = status.Error(codes.Unavailable, "interrupted")
+			return stream, nil
+		}
+		n := source.batchSize
+		if n > len(rows) {
+			n = len(rows)
+		}
+		stream.responses = append(stream.responses, &pb.BootstrapCopyResponse{KeyValues: rows[:n]})
+		rows = rows[n:]
+	}
+	stream.responses = append(stream.responses, &pb.BootstrapCopyResponse{BinlogTailProgress: progress})
+
+	return stream, nil
+}
+
+func (source *fakeBootstrapSource) CheckBinlog(ctx context.Context, in *pb.CheckBinlogRequest, opts ...grpc.CallOption) (*pb.CheckBinlogResponse, error) {
+	return &pb.CheckBinlogResponse{
+		ShardId:         in.ShardId,
+		EarliestSegment: source.earliestSegment,
+		LatestSegment:   source.segment,
+	}, nil
+}
+
+func newTestShard(t *testing.T) (s *shard, cleanup func()) {
+
+	dir, err := ioutil.TempDir("", "vasto_shard")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+
+	s = newShard("ks", dir, 0, 0, topology.NewCluster("ks", 1, 1), nil, 1, 0, 0)
+
+	return s, func() {
+		s.db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newBootstrapRows(count int) (rows []*pb.RawKeyValue) {
+	for i := 0; i < count; i++ {
+		rows = append(rows, &pb.RawKeyValue{
+			Key:   []byte(fmt.Sprintf("k%05d", i)),
+			Value: []byte(fmt.Sprintf("v%05d", i)),
+		})
+	}
+	return
+}
+
+func TestBootstrapCopyResumes(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	bootstrapCopyRetryInterval = time.Millisecond
+
+	source := &fakeBootstrapSource{
+		rows:           newBootstrapRows(3000),
+		batchSize:      100,
+		interruptAfter: 5,
+		segment:        7,
+	}
+	sourceShardInfo := &pb.ShardInfo{KeyspaceName: "ks"}
+	checkpoint := &bootstrapCheckpoint{name: "test"}
+
+	counter, err := s.writeToSst(context.Background(), source, sourceShardInfo, "test", checkpoint, 1, 0, 0)
+	if err != nil {
+		t.Fatalf("bootstrap copy: %v", err)
+	}
+	if counter != 3000 {
+		t.Errorf("copied %d entries, expecting 3000", counter)
+	}
+
+	if len(source.requests) != 2 {
+		t.Fatalf("copy requests %d, expecting 2", len(source.requests))
+	}
+	if string(source.requests[1].StartAfterKey) != "k00499" {
+		t.Errorf("resumed after %s, expecting k00499", string(source.requests[1].StartAfterKey))
+	}
+
+	// follow the binlog from the first copy
+	saved, found := s.loadBootstrapCheckpoint("test")
+	if !found || saved.segment != 7 || string(saved.lastKey) != "k02999" {
+		t.Errorf("saved checkpoint %+v", saved)
+	}
+
+	count := 0
+	s.db.PrefixScan([]byte("k"), nil, 0, func(key, value []byte) bool {
+		if expected := fmt.Sprintf("k%05d", count); string(key) != expected {
+			t.Errorf("key %s, expecting %s", string(key), expected)
+		}
+		count++
+		return true
+	})
+	if count != 3000 {
+		t.Errorf("bootstrapped %d entries, expecting 3000", count)
+	}
+
+}
+
+func TestBootstrapCheckpointWithoutBinlog(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	source := &fakeBootstrapSource{
+		rows:            newBootstrapRows(10),
+		batchSize:       100,
+		segment:         9,
+		earliestSegment: 8,
+	}
+	checkpoint := &bootstrapCheckpoint{
+		name:              "test",
+		lastKey:           []byte("k00004"),
+		hasBinlogProgress: true,
+		segment:           7,
+	}
+
+	_, err := s.writeToSst(context.Background(), source, &pb.ShardInfo{KeyspaceName: "ks"}, "test", checkpoint, 1, 0, 0)
+	if err != errBootstrapBinlogGone {
+		t.Errorf("resume without the binlog: %v", err)
+	}
+	if len(source.requests) > 0 {
+		t.Errorf("resumed without the binlog")
+	}
+
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+var (
+	// ExpiryKeyPrefix is a reserved key prefix for the expiry index, ordered by the expiry time:
+	//
+	//	ExpiryKeyPrefix uint64(expiresAtSecond) key -> empty
+	//
+	// Expiry index entries carry the partition hash of the key, so they stay on the same shard.
+	ExpiryKeyPrefix = codec.ExpiryKeyPrefix
+)
+
+const (
+	expirySweepLimit        = 1024
+	expirySubscriberBuffer  = 1024
+	expiryIndexHeaderLength = 8
+)
+
+type expirySubscriber struct {
+	prefix []byte
+	events chan *pb.ExpiryEvent
+}
+
+// expiresAtSecond returns the unix second when the entry is expired, or 0 if the entry has no ttl.
+func expiresAtSecond(entry *codec.Entry) uint64 {
+	if entry == nil || entry.TtlSecond == 0 {
+		return 0
+	}
+	return (entry.UpdatedAtNs+uint64(entry.TtlSecond)*1e9)/1e9 + 1
+}
+
+func expiryKey(expiresAt uint64, key []byte) []byte {
+	var b bytes.Buffer
+	b.Write(ExpiryKeyPrefix)
+	binary.Write(&b, binary.BigEndian, expiresAt)
+	b.Write(key)
+	return b.Bytes()
+}
+
+// markExpiringEntries is called before an entry with a ttl is written.
+// Until then, the writes need not read the old entries to change their expiry index entries.
+func (s *shard) markExpiringEntries() {
+	atomic.StoreInt32(&s.hasExpiringEntries, 1)
+}
+
+func (s *shard) mayHaveExpiringEntries() bool {
+	return atomic.LoadInt32(&s.hasExpiringEntries) == 1
+}
+
+// checkExpiringEntries marks the shard if the expiry index is not empty.
+func (s *shard) checkExpiringEntries() {
+	s.db.PrefixScan(ExpiryKeyPrefix, nil, 1, func(key, value []byte) bool {
+		s.markExpiringEntries()
+		return false
+	})
+}
+
+// updateExpiryIndex adds the move of the expiry index entry of the key to the batch,
+// when the key is updated from oldEntry to newEntry.
+// Either entry is nil if the key does not exist before, or is being deleted.
+func (s *shard) updateExpiryIndex(batch *indexBatch, key []byte, oldEntry, newEntry *codec.Entry) {
+
+	oldExpiresAt, newExpiresAt := expiresAtSecond(oldEntry), expiresAtSecond(newEntry)
+	if oldExpiresAt == newExpiresAt {
+		return
+	}
+
+	if oldExpiresAt != 0 {
+		batch.delete(expiryKey(oldExpiresAt, key))
+	}
+
+	if newExpiresAt != 0 {
+		batch.put(expiryKey(newExpiresAt, key), &codec.Entry{
+			PartitionHash: newEntry.PartitionHash,
+			UpdatedAtNs:   newEntry.UpdatedAtNs,
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		})
+	}
+
+}
+
+// sweepExpiry notifies the subscribers of the keys expired before now, in the order of the expiry time,
+// and removes their expiry index entries.
+// The expired keys are left to the compaction filter, so a concurrent write to the key is never lost.
+func (s *shard) sweepExpiry(now time.Time) {
+
+	endKey := expiryKey(uint64(now.Unix()), nil)
+
+	var expiryKeys [][]byte
+	var events []*pb.ExpiryEvent
+	err := s.db.RangeScan(ExpiryKeyPrefix, false, endKey, false, false, func(k, v []byte) bool {
+		if len(k) < len(ExpiryKeyPrefix)+expiryIndexHeaderLength {
+			return true
+		}
+		t := make([]byte, len(k))
+		copy(t, k)
+		expiryKeys = append(expiryKeys, t)
+		events = append(events, &pb.ExpiryEvent{
+			Key:             t[len(ExpiryKeyPrefix)+expiryIndexHeaderLength:],
+			PartitionHash:   codec.GetPartitionHashFromBytes(v),
+			ExpiredAtSecond: int64(binary.BigEndian.Uint64(t[len(ExpiryKeyPrefix):])),
+		})
+		return len(expiryKeys) < expirySweepLimit
+	})
+	if err != nil {
+		glog.Errorf("%s sweep expiry: %v", s, err)
+		return
+	}
+
+	for i, event := range events {
+		// the key is updated after the index entry is read
+		if b, getErr := s.db.Get(event.Key); getErr == nil && len(b) > 0 {
+			if entry, decodeErr := codec.Decode(b); decodeErr == nil && !entry.IsExpired() {
+				continue
+			}
+		}
+		s.publishExpiry(event)
+		if err = s.db.Delete(expiryKeys[i]); err != nil {
+			glog.Errorf("%s delete expiry index of %v: %v", s, string(event.Key), err)
+		}
+	}
+
+}
+
+func (s *shard) subscribeExpiry(prefix []byte) *expirySubscriber {
+	subscriber := &expirySubscriber{
+		prefix: prefix,
+		events: make(chan *pb.ExpiryEvent, expirySubscriberBuffer),
+	}
+	s.expirySubscribersLock.Lock()
+	s.expirySubscribers[subscriber] = true
+	s.expirySubscribersLock.Unlock()
+	return subscriber
+}
+
+func (s *shard) unsubscribeExpiry(subscriber *expirySubscriber) {
+	s.expirySubscribersLock.Lock()
+	delete(s.expirySubscribers, subscriber)
+	s.expirySubscribersLock.Unlock()
+}
+
+// publishExpiry sends the event to the matching subscribers, and drops it for the subscribers falling behind.
+func (s *shard) publishExpiry(event *pb.ExpiryEvent) {
+	s.expirySubscribersLock.Lock()
+	defer s.expirySubscribersLock.Unlock()
+	for subscriber := range s.expirySubscribers {
+		if !bytes.HasPrefix(event.Key, subscriber.prefix) {
+			continue
+		}
+		select {
+		case subscriber.events <- event:
+		default:
+			glog.Warningf("%s drop expiry event of %v for a slow subscriber", s, string(event.Key))
+		}
+	}
+}
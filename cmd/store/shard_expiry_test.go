@@ -0,0 +1,122 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func expiryIndexKeys(s *shard) (keys []string) {
+	s.db.PrefixScan(ExpiryKeyPrefix, nil, 0, func(key, value []byte) bool {
+		keys = append(keys, string(key[len(ExpiryKeyPrefix)+expiryIndexHeaderLength:]))
+		return true
+	})
+	return
+}
+
+func TestWritesKeepExpiryIndex(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	now := uint64(time.Now().UnixNano())
+	put := func(key string, ttlSecond uint32) {
+		if err := s.writeEntry([]byte(key), codec.NewPutEntry(&pb.PutRequest{
+			Key:       []byte(key),
+			TtlSecond: ttlSecond,
+			Value:     []byte("v"),
+		}, now)); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	put("a", 0)
+	if s.mayHaveExpiringEntries() {
+		t.Errorf("no entry has a ttl yet")
+	}
+
+	put("b", 100)
+	if !s.mayHaveExpiringEntries() {
+		t.Errorf("entry b has a ttl")
+	}
+	if keys := expiryIndexKeys(s); len(keys) != 1 || keys[0] != "b" {
+		t.Errorf("expiry index %v, expecting b", keys)
+	}
+
+	put("b", 0)
+	if keys := expiryIndexKeys(s); len(keys) != 0 {
+		t.Errorf("expiry index %v, expecting none after the ttl is removed", keys)
+	}
+
+	// a merge with a ttl goes through the expiry index
+	merge := newFloatMergeEntry([]byte("c"), 1, now)
+	merge.TtlSecond = 100
+	if err := s.merge([]byte("c"), merge); err != nil {
+		t.Fatalf("merge c: %v", err)
+	}
+	if keys := expiryIndexKeys(s); len(keys) != 1 || keys[0] != "c" {
+		t.Errorf("expiry index %v, expecting c", keys)
+	}
+
+	if err := s.writeEntry([]byte("c"), nil); err != nil {
+		t.Fatalf("delete c: %v", err)
+	}
+	if keys := expiryIndexKeys(s); len(keys) != 0 {
+		t.Errorf("expiry index %v, expecting none after c is deleted", keys)
+	}
+
+}
+
+func TestSweepExpiryNotifiesSubscribers(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	now := time.Now()
+	put := func(key string, ttlSecond uint32, updatedAt time.Time) {
+		if err := s.writeEntry([]byte(key), codec.NewPutEntry(&pb.PutRequest{
+			Key:           []byte(key),
+			PartitionHash: 7,
+			TtlSecond:     ttlSecond,
+			Value:         []byte("v"),
+		}, uint64(updatedAt.UnixNano()))); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	put("a2", 1, now.Add(-10*time.Second))
+	put("a1", 1, now.Add(-20*time.Second))
+	put("b1", 1, now.Add(-20*time.Second))
+	put("a3", 100, now)
+
+	// a4 is updated after its expiry index entry is written
+	put("a4", 1, now.Add(-20*time.Second))
+	if err := s.db.Put([]byte("a4"), codec.NewPutEntry(&pb.PutRequest{Key: []byte("a4"), Value: []byte("v")}, uint64(now.UnixNano())).ToBytes()); err != nil {
+		t.Fatalf("update a4: %v", err)
+	}
+
+	subscriber := s.subscribeExpiry([]byte("a"))
+	defer s.unsubscribeExpiry(subscriber)
+
+	s.sweepExpiry(now)
+
+	var keys []string
+	for len(subscriber.events) > 0 {
+		event := <-subscriber.events
+		if event.PartitionHash != 7 || event.ExpiredAtSecond > now.Unix() {
+			t.Errorf("expiry event %+v", event)
+		}
+		keys = append(keys, string(event.Key))
+	}
+	if len(keys) != 2 || keys[0] != "a1" || keys[1] != "a2" {
+		t.Errorf("notified %v, expecting a1 and a2 in the order of expiry", keys)
+	}
+
+	// the expiry index entries of the not expired keys are kept
+	if keys := expiryIndexKeys(s); len(keys) != 2 || keys[0] != "a4" || keys[1] != "a3" {
+		t.Errorf("expiry index %v, expecting a4 and a3", keys)
+	}
+
+}
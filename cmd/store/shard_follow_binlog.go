@@ -46,6 +46,10 @@ func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcCon
 		return fmt.Errorf("client.TailBinlog to server %d %s: %v", node.ShardInfo.ServerId, node.StoreResource.GetAdminAddress(), err)
 	}
 
+	key := progressKey{VastoShardId(sourceShardId), node.StoreResource.GetAdminAddress()}
+	s.setFollowSyncedAtNs(key, time.Now().UnixNano())
+	defer s.deleteFollowSyncedAtNs(key)
+
 	for {
 
 		// println("TailBinlog receive from", s.id)
@@ -61,6 +65,12 @@ func (s *shard) followChanges(ctx context.Context, node *pb.ClusterNode, grpcCon
 			s.processEntry(entry)
 		}
 
+		syncedAtNs := time.Now().UnixNano()
+		if len(changes.Entries) > 0 {
+			syncedAtNs = int64(changes.Entries[len(changes.Entries)-1].UpdatedAtNs)
+		}
+		s.setFollowSyncedAtNs(key, syncedAtNs)
+
 		// set the nextSegment and nextOffset
 		nextSegment, nextOffset = changes.NextSegment, changes.NextOffset
 		if saveFollowProgress {
@@ -78,7 +88,19 @@ func (s *shard) processEntry(entry *pb.LogEntry) {
 		key := merge.Key
 		t := codec.NewMergeEntry(merge, entry.UpdatedAtNs)
 
-		s.db.Merge(key, t.ToBytes())
+		if err := s.merge(key, t); err != nil {
+			glog.Errorf("%s merge %v: %v", s, string(key), err)
+		}
+		return
+	}
+
+	// process ttl changes
+	if entry.GetTtl() != nil {
+		unlock := s.lockKey(entry.GetKey())
+		defer unlock()
+		if _, err := s.updateTtl(entry.GetTtl(), entry.UpdatedAtNs); err != nil {
+			glog.Errorf("%s update ttl %v: %v", s, string(entry.GetKey()), err)
+		}
 		return
 	}
 
@@ -93,13 +115,13 @@ func (s *shard) processEntry(entry *pb.LogEntry) {
 	if entry.GetDelete() != nil {
 		if err == nil && len(b) > 0 {
 			row := codec.FromBytes(b)
-			if row.IsExpired() {
+			if row != nil && row.IsExpired() {
 				return
 			}
-			if row.UpdatedAtNs > entry.UpdatedAtNs {
+			if row != nil && row.UpdatedAtNs > entry.UpdatedAtNs {
 				return
 			}
-			s.db.Delete(entry.GetKey())
+			s.writeEntry(entry.GetKey(), nil)
 		}
 		return
 	}
@@ -112,21 +134,26 @@ func (s *shard) processEntry(entry *pb.LogEntry) {
 
 		if len(b) == 0 {
 			// no existing data found
-			s.db.Put(key, t.ToBytes())
+			s.writeEntry(key, t)
 			return
 		}
 		row := codec.FromBytes(b)
+		if row == nil {
+			// replace the corrupted local entry
+			s.writeEntry(key, t)
+			return
+		}
 		if row.IsExpired() {
 			if !t.IsExpired() {
 				glog.V(3).Infof("%s follow 3 entry: %v", s, string(key))
-				s.db.Put(key, t.ToBytes())
+				s.writeEntry(key, t)
 				return
 			}
 		} else {
 			if row.UpdatedAtNs > entry.UpdatedAtNs {
 				return
 			}
-			s.db.Put(key, t.ToBytes())
+			s.writeEntry(key, t)
 			return
 		}
 		// glog.V(2).Infof("%s follow 4 entry: %v", s, string(entry.Key))
@@ -0,0 +1,280 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"github.com/chrislusf/vasto/util"
+	"google.golang.org/grpc"
+)
+
+// crossDataCenterPeer is a primary shard of the same keyspace in another data center.
+// clusterSize is the local logical shard count, so the following restarts after the local cluster is resized.
+type crossDataCenterPeer struct {
+	dataCenter  string
+	shardId     int
+	clusterSize int
+}
+
+// followRemoteClusters lets the designated shards follow the same keyspaces in other data centers.
+func (ss *storeServer) followRemoteClusters(remoteClusters []*pb.Cluster) {
+
+	keyspaceClusters := make(map[string][]*pb.Cluster)
+	for _, cluster := range remoteClusters {
+		keyspaceClusters[cluster.Keyspace] = append(keyspaceClusters[cluster.Keyspace], cluster)
+	}
+
+	ss.keyspaceShards.RLock()
+	for ksName, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, shard := range shards {
+			shard.adjustCrossDataCenterFollowings(keyspaceClusters[string(ksName)])
+		}
+	}
+	ss.keyspaceShards.RUnlock()
+
+}
+
+func (ss *storeServer) collectReplicationLags() (lags []*pb.ReplicationLag) {
+	ss.keyspaceShards.RLock()
+	for _, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, shard := range shards {
+			lags = append(lags, shard.replicationLags()...)
+		}
+	}
+	ss.keyspaceShards.RUnlock()
+	return
+}
+
+// isDesignatedForCrossDataCenter checks whether the shard follows other data centers.
+// Only the primary copy of each shard follows other data centers, and logs the changes to its binlog,
+// so the other copies in the local data center receive the changes by the normal following.
+func (s *shard) isDesignatedForCrossDataCenter() bool {
+	clusterSize := s.cluster.ExpectedSize()
+	return clusterSize > 0 && int(s.serverId) == int(s.id)%clusterSize
+}
+
+// adjustCrossDataCenterFollowings starts following the primary shards of the remote clusters,
+// and stops following the data centers no longer having the keyspace.
+func (s *shard) adjustCrossDataCenterFollowings(remoteClusters []*pb.Cluster) {
+
+	if s.isShutdown {
+		return
+	}
+
+	clusterSize := s.cluster.LogicalShardCount()
+
+	s.crossDataCenterLock.Lock()
+	defer s.crossDataCenterLock.Unlock()
+
+	s.remoteClusters = make(map[string]*pb.Cluster)
+	var peers []crossDataCenterPeer
+	for _, cluster := range remoteClusters {
+		remoteShardCount := topology.LogicalShardCount(int(cluster.ExpectedClusterSize), int(cluster.ShardCount))
+		if !s.isDesignatedForCrossDataCenter() || clusterSize == 0 || cluster.ExpectedClusterSize == 0 {
+			continue
+		}
+		s.remoteClusters[cluster.DataCenter] = cluster
+		if remoteShardCount == clusterSize {
+			peers = append(peers, crossDataCenterPeer{cluster.DataCenter, int(s.id), clusterSize})
+			continue
+		}
+		// the clusters are of different shard counts, any remote shard may have entries of this shard
+		for i := 0; i < remoteShardCount; i++ {
+			peers = append(peers, crossDataCenterPeer{cluster.DataCenter, i, clusterSize})
+		}
+	}
+
+	for _, peer := range peers {
+		if _, found := s.crossDataCenterFollowProcesses[peer]; found {
+			continue
+		}
+		glog.V(1).Infof("%s follow data center %s shard %d", s, peer.dataCenter, peer.shardId)
+		ctx, cancelFunc := context.WithCancel(s.ctx)
+		s.crossDataCenterFollowProcesses[peer] = &followProcess{cancelFunc: cancelFunc}
+		go util.RetryForever(ctx, fmt.Sprintf("shard %s follow data center %s shard %d", s, peer.dataCenter, peer.shardId),
+			func(peer crossDataCenterPeer) func() error {
+				return func() error {
+					return s.followDataCenter(ctx, peer)
+				}
+			}(peer),
+			2*time.Second,
+		)
+	}
+
+	for peer, followProcess := range s.crossDataCenterFollowProcesses {
+		if !crossDataCenterPeersContains(peers, peer) {
+			glog.V(1).Infof("%s stop following data center %s shard %d", s, peer.dataCenter, peer.shardId)
+			delete(s.crossDataCenterFollowProcesses, peer)
+			delete(s.crossDataCenterSyncedAtNs, peer)
+			followProcess.cancelFunc()
+		}
+	}
+
+}
+
+func crossDataCenterPeersContains(peers []crossDataCenterPeer, peer crossDataCenterPeer) bool {
+	for _, p := range peers {
+		if p == peer {
+			return true
+		}
+	}
+	return false
+}
+
+// remotePrimaryNode finds the primary copy of the shard in the remote cluster
+func (s *shard) remotePrimaryNode(peer crossDataCenterPeer) (*pb.ClusterNode, bool) {
+	s.crossDataCenterLock.Lock()
+	cluster, found := s.remoteClusters[peer.dataCenter]
+	s.crossDataCenterLock.Unlock()
+	if !found {
+		return nil, false
+	}
+	for _, node := range cluster.Nodes {
+		if node.ShardInfo == nil || node.ShardInfo.IsCandidate {
+			continue
+		}
+		if int(node.ShardInfo.ShardId) == peer.shardId && int(node.ShardInfo.ServerId) == peer.shardId%int(cluster.ExpectedClusterSize) {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+func (s *shard) followDataCenter(ctx context.Context, peer crossDataCenterPeer) error {
+
+	node, found := s.remotePrimaryNode(peer)
+	if !found {
+		return fmt.Errorf("data center %s shard %d not found", peer.dataCenter, peer.shardId)
+	}
+
+	grpcConnection, err := grpc.Dial(node.StoreResource.GetAdminAddress(), grpc.WithInsecure())
+	if err != nil {
+		return fmt.Errorf("fail to dial %s: %v", node.StoreResource.GetAdminAddress(), err)
+	}
+	defer grpcConnection.Close()
+
+	return s.followDataCenterChanges(ctx, peer, node, grpcConnection)
+}
+
+// followDataCenterChanges tails the binlog of the remote shard, and applies the changes
+// made in the remote data center.
+func (s *shard) followDataCenterChanges(ctx context.Context, peer crossDataCenterPeer, node *pb.ClusterNode, grpcConnection *grpc.ClientConn) error {
+
+	client := pb.NewVastoStoreClient(grpcConnection)
+
+	adminAddress := node.StoreResource.GetAdminAddress()
+	nextSegment, nextOffset, _, err := s.loadProgress(adminAddress, VastoShardId(peer.shardId))
+	if err != nil {
+		glog.Errorf("read shard %d follow progress: %v", s.id, err)
+	}
+	glog.V(1).Infof("shard %v follows data center %s %d.%d from segment:offset %d:%d", s.String(), peer.dataCenter, node.ShardInfo.ServerId, peer.shardId, nextSegment, nextOffset)
+
+	s.insertInMemoryFollowProgress(adminAddress, VastoShardId(peer.shardId), nextSegment, nextOffset)
+
+	request := &pb.PullUpdateRequest{
+		Keyspace:          s.keyspace,
+		ShardId:           uint32(peer.shardId),
+		Segment:           nextSegment,
+		Offset:            nextOffset,
+		Limit:             8096,
+		TargetClusterSize: uint32(peer.clusterSize),
+		TargetShardId:     uint32(s.id),
+		Origin:            s.String(),
+	}
+
+	stream, err := client.TailBinlog(ctx, request)
+	if err != nil {
+		return fmt.Errorf("client.TailBinlog to data center %s server %d %s: %v", peer.dataCenter, node.ShardInfo.ServerId, adminAddress, err)
+	}
+
+	for {
+
+		changes, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("pull changes from data center %s: %v", peer.dataCenter, err)
+		}
+
+		s.applyDataCenterChanges(peer, changes.Entries)
+
+		syncedAtNs := time.Now().UnixNano()
+		if len(changes.Entries) > 0 {
+			syncedAtNs = int64(changes.Entries[len(changes.Entries)-1].UpdatedAtNs)
+		}
+		s.crossDataCenterLock.Lock()
+		if _, found := s.crossDataCenterFollowProcesses[peer]; found {
+			s.crossDataCenterSyncedAtNs[peer] = syncedAtNs
+		}
+		s.crossDataCenterLock.Unlock()
+
+		nextSegment, nextOffset = changes.NextSegment, changes.NextOffset
+		s.updateInMemoryFollowProgressIfPresent(adminAddress, VastoShardId(peer.shardId), nextSegment, nextOffset)
+
+	}
+
+}
+
+// applyDataCenterChanges applies the changes made in the remote data center, and the latest write wins.
+// The changes are logged with the origin data center,
+// so they are not replicated back to the origin, or again to the other data centers.
+//
+// A delete does not leave a tombstone. If a put to the key made in another data center
+// before the delete arrives after the delete, the put re-creates the key.
+func (s *shard) applyDataCenterChanges(peer crossDataCenterPeer, entries []*pb.LogEntry) {
+	for _, entry := range entries {
+		if entry.OriginDataCenter != "" {
+			// the remote shard replicated it from another data center, which is followed directly
+			continue
+		}
+		s.processEntry(entry)
+		entry.OriginDataCenter = peer.dataCenter
+		s.logReplicatedEntry(entry)
+	}
+}
+
+func (s *shard) logReplicatedEntry(entry *pb.LogEntry) {
+
+	if s.lm == nil {
+		return
+	}
+
+	if err := s.lm.AppendEntry(entry); err != nil {
+		glog.Errorf("append replicated log entry: %v", err)
+	}
+
+}
+
+// replicationLags reports how far behind this shard is, for each data center it follows.
+// The lag keeps growing if the remote data center can not be reached.
+func (s *shard) replicationLags() (lags []*pb.ReplicationLag) {
+
+	now := time.Now().UnixNano()
+
+	s.crossDataCenterLock.Lock()
+	dataCenterLags := make(map[string]int64)
+	for peer, syncedAtNs := range s.crossDataCenterSyncedAtNs {
+		lag := (now - syncedAtNs) / int64(time.Millisecond)
+		if lag < 0 {
+			lag = 0
+		}
+		if existing, found := dataCenterLags[peer.dataCenter]; !found || lag > existing {
+			dataCenterLags[peer.dataCenter] = lag
+		}
+	}
+	s.crossDataCenterLock.Unlock()
+
+	for dataCenter, lag := range dataCenterLags {
+		lags = append(lags, &pb.ReplicationLag{
+			Keyspace:         s.keyspace,
+			ShardId:          uint32(s.id),
+			SourceDataCenter: dataCenter,
+			TargetDataCenter: s.clusterListener.DataCenter(),
+			LagMillisecond:   lag,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,193 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/topology"
+	"github.com/chrislusf/vasto/topology/clusterlistener"
+)
+
+// newTestShardInDataCenter creates shard 0 on the server, with a binlog, in data center dc1
+func newTestShardInDataCenter(t *testing.T, serverId, clusterSize int) (s *shard, cleanup func()) {
+
+	dir, err := ioutil.TempDir("", "vasto_shard")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+
+	s = newShard("ks", dir, serverId, 0, topology.NewCluster("ks", clusterSize, 1),
+		clusterlistener.NewClusterListenerInDataCenter("dc1", "[store@test]"), 1, 1, 3)
+
+	return s, func() {
+		s.shutdownNode()
+		s.db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func putLogEntry(key, value string, updatedAtNs uint64) *pb.LogEntry {
+	return &pb.LogEntry{
+		UpdatedAtNs: updatedAtNs,
+		Put: &pb.PutRequest{
+			Key:   []byte(key),
+			Value: []byte(value),
+		},
+	}
+}
+
+func TestApplyDataCenterChanges(t *testing.T) {
+
+	s, cleanup := newTestShardInDataCenter(t, 0, 1)
+	defer cleanup()
+
+	value := func(key string) string {
+		b, err := s.db.Get([]byte(key))
+		if err != nil || len(b) == 0 {
+			return ""
+		}
+		return string(codec.FromBytes(b).Value)
+	}
+
+	peer := crossDataCenterPeer{dataCenter: "dc2", shardId: 0, clusterSize: 1}
+
+	replicated := putLogEntry("c", "v", 1)
+	replicated.OriginDataCenter = "dc3"
+	s.applyDataCenterChanges(peer, []*pb.LogEntry{
+		putLogEntry("a", "new", 2),
+		putLogEntry("a", "old", 1),
+		// dc2 replicated it from dc3, which is followed directly
+		replicated,
+	})
+
+	if v := value("a"); v != "new" {
+		t.Errorf("a is %q, expecting the latest write", v)
+	}
+	if v := value("c"); v != "" {
+		t.Errorf("applied %q replicated by the remote data center", v)
+	}
+
+	// the changes are logged with the origin, so dc2 does not pull them back
+	entries, _, err := s.lm.ReadEntries(0, 0, 100)
+	if err != nil {
+		t.Fatalf("read binlog: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, expecting 2", len(entries))
+	}
+	for _, entry := range entries {
+		if entry.OriginDataCenter != "dc2" {
+			t.Errorf("logged entry %s with origin %q", entry.Put.Key, entry.OriginDataCenter)
+		}
+	}
+
+	s.applyDataCenterChanges(peer, []*pb.LogEntry{{
+		UpdatedAtNs: 1,
+		Delete:      &pb.DeleteRequest{Key: []byte("a")},
+	}})
+	if v := value("a"); v != "new" {
+		t.Errorf("an older delete removes the latest write")
+	}
+
+	s.applyDataCenterChanges(peer, []*pb.LogEntry{{
+		UpdatedAtNs: 4,
+		Delete:      &pb.DeleteRequest{Key: []byte("a")},
+	}})
+	if v := value("a"); v != "" {
+		t.Errorf("a is %q after the latest delete", v)
+	}
+
+	// deletes leave no tombstones, so an older put arriving late re-creates the key
+	s.applyDataCenterChanges(peer, []*pb.LogEntry{putLogEntry("a", "late", 3)})
+	if v := value("a"); v != "late" {
+		t.Errorf("a is %q, expecting the late put", v)
+	}
+
+}
+
+func TestAdjustCrossDataCenterFollowings(t *testing.T) {
+
+	followedPeers := func(s *shard) map[crossDataCenterPeer]bool {
+		s.crossDataCenterLock.Lock()
+		defer s.crossDataCenterLock.Unlock()
+		peers := make(map[crossDataCenterPeer]bool)
+		for peer := range s.crossDataCenterFollowProcesses {
+			peers[peer] = true
+		}
+		return peers
+	}
+
+	remoteClusters := []*pb.Cluster{
+		{Keyspace: "ks", DataCenter: "dc2", ExpectedClusterSize: 2},
+		{Keyspace: "ks", DataCenter: "dc3", ExpectedClusterSize: 3},
+	}
+
+	// shard 0 on server 0 is the primary copy
+	s, cleanup := newTestShardInDataCenter(t, 0, 2)
+	defer cleanup()
+
+	s.adjustCrossDataCenterFollowings(remoteClusters)
+	peers := followedPeers(s)
+	if len(peers) != 4 || !peers[crossDataCenterPeer{"dc2", 0, 2}] {
+		t.Errorf("follow %v, expecting shard 0 in dc2 and all 3 shards in dc3", peers)
+	}
+	for i := 0; i < 3; i++ {
+		if !peers[crossDataCenterPeer{"dc3", i, 2}] {
+			t.Errorf("not following dc3 shard %d", i)
+		}
+	}
+
+	s.crossDataCenterLock.Lock()
+	s.crossDataCenterSyncedAtNs[crossDataCenterPeer{"dc3", 1, 2}] = time.Now().UnixNano()
+	s.crossDataCenterLock.Unlock()
+
+	// the keyspace is deleted in dc3
+	s.adjustCrossDataCenterFollowings(remoteClusters[:1])
+	if peers := followedPeers(s); len(peers) != 1 {
+		t.Errorf("follow %v after dc3 is gone", peers)
+	}
+	if lags := s.replicationLags(); len(lags) != 0 {
+		t.Errorf("report lags %v of the unfollowed data center", lags)
+	}
+
+	// the replica copy of shard 0 on server 1 receives the changes from the primary copy
+	replica, cleanupReplica := newTestShardInDataCenter(t, 1, 2)
+	defer cleanupReplica()
+
+	replica.adjustCrossDataCenterFollowings(remoteClusters)
+	if peers := followedPeers(replica); len(peers) != 0 {
+		t.Errorf("replica copy follows %v", peers)
+	}
+
+}
+
+func TestReplicationLagsPerDataCenter(t *testing.T) {
+
+	s, cleanup := newTestShardInDataCenter(t, 0, 1)
+	defer cleanup()
+
+	now := time.Now().UnixNano()
+	s.crossDataCenterSyncedAtNs[crossDataCenterPeer{"dc2", 0, 1}] = now - int64(time.Second)
+	s.crossDataCenterSyncedAtNs[crossDataCenterPeer{"dc2", 1, 1}] = now - int64(time.Minute)
+	s.crossDataCenterSyncedAtNs[crossDataCenterPeer{"dc3", 0, 1}] = now + int64(time.Second)
+
+	lags := make(map[string]*pb.ReplicationLag)
+	for _, lag := range s.replicationLags() {
+		lags[lag.SourceDataCenter] = lag
+	}
+
+	if len(lags) != 2 {
+		t.Fatalf("lags %v, expecting one for each data center", lags)
+	}
+	if lag := lags["dc2"]; lag.LagMillisecond < int64(time.Minute/time.Millisecond) || lag.TargetDataCenter != "dc1" {
+		t.Errorf("dc2 lag %+v, expecting the slowest remote shard into dc1", lag)
+	}
+	if lag := lags["dc3"]; lag.LagMillisecond != 0 {
+		t.Errorf("dc3 lag %d, expecting 0 for a remote clock ahead", lag.LagMillisecond)
+	}
+
+}
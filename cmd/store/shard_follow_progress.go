@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/storage/codec"
 	"github.com/chrislusf/vasto/topology"
 	"github.com/chrislusf/vasto/util"
+	"time"
 )
 
 type progressKey struct {
@@ -24,7 +26,7 @@ type followProcess struct {
 
 var (
 	// VastoInternalKeyPrefix is a reserved key prefix for Vasto internal meta data
-	VastoInternalKeyPrefix = []byte("_vasto.")
+	VastoInternalKeyPrefix = codec.InternalKeyPrefix
 )
 
 func genSegmentOffsetKeys(serverAdminAddress string, shardId VastoShardId) (segmentKey []byte, offsetKey []byte) {
@@ -45,6 +47,9 @@ func (s *shard) EverySecond() {
 		}
 	}
 	s.followProcessesLock.Unlock()
+
+	s.sweepExpiry(time.Now())
+	s.sweepOrphanChunks(time.Now())
 }
 
 func (s *shard) loadProgress(serverAdminAddress string, targetShardId VastoShardId) (segment uint32, offset uint64, hasProgress bool, err error) {
@@ -130,3 +135,29 @@ func (s *shard) deleteInMemoryFollowProgress(serverAdminAddress string, targetSh
 	delete(s.followProgress, progressKey{targetShardId, serverAdminAddress})
 	s.followProgressLock.Unlock()
 }
+
+func (s *shard) setFollowSyncedAtNs(key progressKey, syncedAtNs int64) {
+	s.followProgressLock.Lock()
+	s.followSyncedAtNs[key] = syncedAtNs
+	s.followProgressLock.Unlock()
+}
+
+func (s *shard) deleteFollowSyncedAtNs(key progressKey) {
+	s.followProgressLock.Lock()
+	delete(s.followSyncedAtNs, key)
+	s.followProgressLock.Unlock()
+}
+
+// followLag returns how far this shard is behind the slowest shard it follows
+func (s *shard) followLag() (lagMillisecond int64, isFollowing bool) {
+	now := time.Now().UnixNano()
+	s.followProgressLock.Lock()
+	for _, syncedAtNs := range s.followSyncedAtNs {
+		isFollowing = true
+		if lag := (now - syncedAtNs) / int64(time.Millisecond); lag > lagMillisecond {
+			lagMillisecond = lag
+		}
+	}
+	s.followProgressLock.Unlock()
+	return
+}
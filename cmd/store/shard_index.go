@@ -0,0 +1,360 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+var (
+	// IndexKeyPrefix is a reserved key prefix for secondary index entries.
+	// Index entries carry the partition hash of the indexed entry, so they stay on the same shard.
+	IndexKeyPrefix = codec.IndexKeyPrefix
+)
+
+// isReservedKey checks whether the key is maintained by Vasto, and should not be visible to the clients.
+func isReservedKey(key []byte) bool {
+	return codec.IsReservedKey(key)
+}
+
+// indexTermPrefix is IndexKeyPrefix + name + 0x00 + len(term) + term
+// The term length is encoded so that a term is never a prefix of another term.
+func indexTermPrefix(name string, term []byte) []byte {
+	var b bytes.Buffer
+	b.Write(IndexKeyPrefix)
+	b.WriteString(name)
+	b.WriteByte(0)
+	binary.Write(&b, binary.BigEndian, uint32(len(term)))
+	b.Write(term)
+	return b.Bytes()
+}
+
+func indexKey(name string, term, primaryKey []byte) []byte {
+	return append(indexTermPrefix(name, term), primaryKey...)
+}
+
+func indexNamePrefix(name string) []byte {
+	return []byte(fmt.Sprintf("%s%s\x00", IndexKeyPrefix, name))
+}
+
+func validateIndexDefinition(def *pb.IndexDefinition) error {
+	if def == nil || def.Name == "" {
+		return fmt.Errorf("missing index name")
+	}
+	if strings.IndexByte(def.Name, 0) >= 0 {
+		return fmt.Errorf("invalid index name %q", def.Name)
+	}
+	switch def.Source {
+	case pb.IndexDefinition_JSON_PATH:
+		if def.JsonPath == "" {
+			return fmt.Errorf("index %s: missing json path", def.Name)
+		}
+	case pb.IndexDefinition_KEY_PREFIX:
+		if def.Length == 0 {
+			return fmt.Errorf("index %s: missing key prefix length", def.Name)
+		}
+	}
+	return nil
+}
+
+// indexTerm extracts the index term from the entry. It returns false if the entry is not indexed.
+func indexTerm(def *pb.IndexDefinition, key []byte, entry *codec.Entry) ([]byte, bool) {
+	if def.Source == pb.IndexDefinition_KEY_PREFIX {
+		if len(key) < int(def.Length) {
+			return nil, false
+		}
+		return key[:def.Length], true
+	}
+
+	if pb.OpAndDataType(entry.OpAndDataType) != pb.OpAndDataType_BYTES {
+		return nil, false
+	}
+
+	if def.Source == pb.IndexDefinition_JSON_PATH {
+		return jsonPathTerm(entry.Value, def.JsonPath)
+	}
+
+	if len(entry.Value) < int(def.Offset+def.Length) {
+		return nil, false
+	}
+	if def.Length == 0 {
+		return entry.Value[def.Offset:], true
+	}
+	return entry.Value[def.Offset : def.Offset+def.Length], true
+}
+
+// jsonPathTerm returns the scalar value at the dotted path, e.g., "user.email"
+func jsonPathTerm(value []byte, path string) ([]byte, bool) {
+	var doc interface{}
+	if err := json.Unmarshal(value, &doc); err != nil {
+		return nil, false
+	}
+	for _, field := range strings.Split(path, ".") {
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if doc, ok = m[field]; !ok {
+			return nil, false
+		}
+	}
+	switch v := doc.(type) {
+	case string:
+		return []byte(v), true
+	case float64:
+		return []byte(strconv.FormatFloat(v, 'g', -1, 64)), true
+	case bool:
+		return []byte(strconv.FormatBool(v)), true
+	}
+	return nil, false
+}
+
+// setIndexes returns after the writes still using the previous indexes are done,
+// so the index entries of a dropped index are not written after it is dropped.
+func (s *shard) setIndexes(indexes []*pb.IndexDefinition) {
+	s.indexesLock.Lock()
+	s.indexes = indexes
+	s.indexesLock.Unlock()
+
+	// the writes read the indexes with their keys locked
+	for i := range s.keyLocks {
+		s.keyLocks[i].Lock()
+		s.keyLocks[i].Unlock()
+	}
+}
+
+func (s *shard) getIndexes() []*pb.IndexDefinition {
+	s.indexesLock.RLock()
+	defer s.indexesLock.RUnlock()
+	return s.indexes
+}
+
+// indexBatch collects the changes to the index entries and the expiry index entry of a key,
+// so they are written together with the key in one batch.
+type indexBatch struct {
+	puts    []*pb.RawKeyValue
+	deletes [][]byte
+}
+
+func (b *indexBatch) put(key []byte, entry *codec.Entry) {
+	b.puts = append(b.puts, &pb.RawKeyValue{Key: key, Value: entry.ToBytes()})
+}
+
+func (b *indexBatch) delete(key []byte) {
+	b.deletes = append(b.deletes, key)
+}
+
+// writeEntry updates the key to the newEntry, or deletes the key if newEntry is nil,
+// and changes its index entries and expiry index entry, and deletes its replaced chunks, in the same batch.
+func (s *shard) writeEntry(key []byte, newEntry *codec.Entry) error {
+
+	unlock := s.lockKey(key)
+	defer unlock()
+
+	return s.writeLockedEntry(key, newEntry)
+}
+
+// writeLockedEntry is writeEntry, with the key already locked.
+func (s *shard) writeLockedEntry(key []byte, newEntry *codec.Entry) error {
+
+	var oldEntry *codec.Entry
+	if s.needsOldEntry(key) {
+		b, err := s.db.Get(key)
+		if err != nil {
+			return err
+		}
+		if len(b) > 0 {
+			oldEntry = codec.FromBytes(b)
+		}
+	}
+
+	return s.replaceEntry(key, oldEntry, newEntry)
+}
+
+// needsOldEntry checks whether the old entry of the key is needed to change its index entries,
+// its expiry index entry, or its chunks.
+// It is not needed if no index is defined, no entry has a ttl, and no value is split into chunks.
+func (s *shard) needsOldEntry(key []byte) bool {
+	if isReservedKey(key) {
+		return false
+	}
+	return len(s.getIndexes()) > 0 || s.mayHaveExpiringEntries() || s.mayHaveChunkManifests()
+}
+
+// replaceEntry is writeLockedEntry, with the oldEntry already read.
+func (s *shard) replaceEntry(key []byte, oldEntry, newEntry *codec.Entry) error {
+
+	batch := &indexBatch{}
+
+	if !isReservedKey(key) {
+		if expiresAtSecond(newEntry) != 0 {
+			s.markExpiringEntries()
+		}
+		s.updateExpiryIndex(batch, key, oldEntry, newEntry)
+		s.updateIndexes(batch, key, oldEntry, newEntry)
+		s.deleteReplacedChunks(batch, key, oldEntry, newEntry)
+	}
+
+	if newEntry == nil {
+		batch.delete(key)
+	} else {
+		batch.put(key, newEntry)
+	}
+
+	return s.db.WriteBatch(batch.puts, batch.deletes)
+}
+
+// merge applies the merge to the key with the merge operator, or as read-modify-write
+// if the index entries or the expiry index entry need the merged value.
+func (s *shard) merge(key []byte, entry *codec.Entry) error {
+
+	unlock := s.lockKey(key)
+	defer unlock()
+
+	if !s.needsOldEntry(key) && entry.TtlSecond == 0 {
+		return s.db.Merge(key, entry.ToBytes())
+	}
+
+	existing, err := s.db.Get(key)
+	if err != nil {
+		return err
+	}
+
+	var oldEntry *codec.Entry
+	if len(existing) == 0 {
+		existing = nil
+	} else {
+		oldEntry = codec.FromBytes(existing)
+		// the merge may change the existing bytes in place
+		existing = append([]byte(nil), existing...)
+	}
+
+	// the same as the merge operator
+	merged, ok := codec.MergeEntry(existing, entry.ToBytes())
+	if !ok {
+		return fmt.Errorf("merge %v failed", string(key))
+	}
+	merged.FinalizeMerge()
+
+	return s.replaceEntry(key, oldEntry, merged)
+}
+
+// updateIndexes adds the changes of the index entries to the batch, when the key is updated from oldEntry to newEntry.
+// Either entry is nil if the key does not exist before, or is being deleted.
+func (s *shard) updateIndexes(batch *indexBatch, key []byte, oldEntry, newEntry *codec.Entry) {
+
+	for _, def := range s.getIndexes() {
+		var oldTerm, newTerm []byte
+		var hasOldTerm, hasNewTerm bool
+		if oldEntry != nil {
+			oldTerm, hasOldTerm = indexTerm(def, key, oldEntry)
+		}
+		if newEntry != nil {
+			newTerm, hasNewTerm = indexTerm(def, key, newEntry)
+		}
+		if hasOldTerm && (!hasNewTerm || !bytes.Equal(oldTerm, newTerm)) {
+			batch.delete(indexKey(def.Name, oldTerm, key))
+		}
+		if hasNewTerm {
+			batch.put(indexKey(def.Name, newTerm, key), newIndexEntry(newEntry))
+		}
+	}
+
+}
+
+func newIndexEntry(entry *codec.Entry) *codec.Entry {
+	return &codec.Entry{
+		PartitionHash: entry.PartitionHash,
+		UpdatedAtNs:   entry.UpdatedAtNs,
+		TtlSecond:     entry.TtlSecond,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+	}
+}
+
+func (s *shard) putIndexEntry(def *pb.IndexDefinition, term, key []byte, entry *codec.Entry) error {
+	if err := s.db.Put(indexKey(def.Name, term, key), newIndexEntry(entry).ToBytes()); err != nil {
+		glog.Errorf("%s put index %s of %v: %v", s, def.Name, string(key), err)
+		return err
+	}
+	return nil
+}
+
+// rebuildIndex adds the index entries for all existing entries on this shard.
+// Each key is locked and read again, so a concurrent write is not indexed with the value it replaced.
+func (s *shard) rebuildIndex(def *pb.IndexDefinition) (err error) {
+
+	var putErr error
+	err = s.db.PrefixScan(nil, nil, 0, func(key, value []byte) bool {
+		if isReservedKey(key) {
+			return true
+		}
+		t := make([]byte, len(key))
+		copy(t, key)
+		putErr = s.rebuildIndexEntry(def, t)
+		return putErr == nil
+	})
+	if err == nil {
+		err = putErr
+	}
+	if err != nil {
+		return fmt.Errorf("rebuild index %s: %v", def.Name, err)
+	}
+	return nil
+}
+
+func (s *shard) rebuildIndexEntry(def *pb.IndexDefinition, key []byte) error {
+
+	unlock := s.lockKey(key)
+	defer unlock()
+
+	value, err := s.db.Get(key)
+	if err != nil || len(value) == 0 {
+		return err
+	}
+	entry, decodeErr := codec.Decode(value)
+	if decodeErr != nil || entry.IsExpired() {
+		return nil
+	}
+	term, found := indexTerm(def, key, entry)
+	if !found {
+		return nil
+	}
+	return s.putIndexEntry(def, term, key, entry)
+}
+
+// dropIndex deletes all index entries of the index on this shard.
+func (s *shard) dropIndex(name string) (err error) {
+
+	var keys [][]byte
+	err = s.db.PrefixScan(indexNamePrefix(name), nil, 0, func(key, value []byte) bool {
+		t := make([]byte, len(key))
+		copy(t, key)
+		keys = append(keys, t)
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("drop index %s: %v", name, err)
+	}
+	for _, key := range keys {
+		if err = s.db.Delete(key); err != nil {
+			return fmt.Errorf("drop index %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func (s *shard) hasIndex(name string) bool {
+	for _, def := range s.getIndexes() {
+		if def.Name == name {
+			return true
+		}
+	}
+	return false
+}
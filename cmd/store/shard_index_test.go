@@ -0,0 +1,229 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+func newFloatMergeEntry(key []byte, value float64, updatedAtNs uint64) *codec.Entry {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(value))
+	return codec.NewMergeEntry(&pb.MergeRequest{
+		Key:           key,
+		OpAndDataType: pb.OpAndDataType_FLOAT64,
+		Value:         b,
+	}, updatedAtNs)
+}
+
+func TestIndexTerm(t *testing.T) {
+
+	bytesEntry := func(value string) *codec.Entry {
+		return &codec.Entry{OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES), Value: []byte(value)}
+	}
+
+	tests := []struct {
+		name     string
+		def      *pb.IndexDefinition
+		key      string
+		entry    *codec.Entry
+		term     string
+		expected bool
+	}{
+		{"key prefix", &pb.IndexDefinition{Source: pb.IndexDefinition_KEY_PREFIX, Length: 2}, "abc", bytesEntry("v"), "ab", true},
+		{"short key", &pb.IndexDefinition{Source: pb.IndexDefinition_KEY_PREFIX, Length: 4}, "abc", bytesEntry("v"), "", false},
+		{"key prefix of a counter", &pb.IndexDefinition{Source: pb.IndexDefinition_KEY_PREFIX, Length: 1}, "abc", newFloatMergeEntry(nil, 1, 1), "a", true},
+		{"value range", &pb.IndexDefinition{Source: pb.IndexDefinition_VALUE_BYTES, Offset: 1, Length: 2}, "k", bytesEntry("vxyz"), "xy", true},
+		{"value to the end", &pb.IndexDefinition{Source: pb.IndexDefinition_VALUE_BYTES, Offset: 1}, "k", bytesEntry("vxyz"), "xyz", true},
+		{"short value", &pb.IndexDefinition{Source: pb.IndexDefinition_VALUE_BYTES, Offset: 3, Length: 2}, "k", bytesEntry("vxyz"), "", false},
+		{"value of a counter", &pb.IndexDefinition{Source: pb.IndexDefinition_VALUE_BYTES, Length: 1}, "k", newFloatMergeEntry(nil, 1, 1), "", false},
+		{"json string", &pb.IndexDefinition{Source: pb.IndexDefinition_JSON_PATH, JsonPath: "user.email"}, "k", bytesEntry(`{"user":{"email":"a@b"}}`), "a@b", true},
+		{"json number", &pb.IndexDefinition{Source: pb.IndexDefinition_JSON_PATH, JsonPath: "age"}, "k", bytesEntry(`{"age":42}`), "42", true},
+		{"json bool", &pb.IndexDefinition{Source: pb.IndexDefinition_JSON_PATH, JsonPath: "ok"}, "k", bytesEntry(`{"ok":true}`), "true", true},
+		{"json object", &pb.IndexDefinition{Source: pb.IndexDefinition_JSON_PATH, JsonPath: "user"}, "k", bytesEntry(`{"user":{"email":"a@b"}}`), "", false},
+		{"json missing field", &pb.IndexDefinition{Source: pb.IndexDefinition_JSON_PATH, JsonPath: "user.name"}, "k", bytesEntry(`{"user":{"email":"a@b"}}`), "", false},
+		{"not json", &pb.IndexDefinition{Source: pb.IndexDefinition_JSON_PATH, JsonPath: "user"}, "k", bytesEntry("user"), "", false},
+	}
+
+	for _, test := range tests {
+		term, found := indexTerm(test.def, []byte(test.key), test.entry)
+		if found != test.expected || string(term) != test.term {
+			t.Errorf("%s: term %q %v, expecting %q %v", test.name, term, found, test.term, test.expected)
+		}
+	}
+
+}
+
+func TestIndexTermPrefix(t *testing.T) {
+
+	// a term is never a prefix of a longer term
+	if bytes.HasPrefix(indexTermPrefix("i", []byte("ab")), indexTermPrefix("i", []byte("a"))) {
+		t.Errorf("term a prefixes term ab")
+	}
+	// an index name is never a prefix of a longer index name
+	if bytes.HasPrefix(indexKey("ix", []byte("a"), []byte("k")), indexNamePrefix("i")) {
+		t.Errorf("index i prefixes index ix")
+	}
+
+}
+
+func TestWritesUpdateIndexes(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	def := &pb.IndexDefinition{Name: "email", Source: pb.IndexDefinition_JSON_PATH, JsonPath: "email"}
+	s.setIndexes([]*pb.IndexDefinition{def})
+
+	key := []byte("user1")
+	put := func(value string, updatedAtNs uint64) {
+		entry := codec.NewPutEntry(&pb.PutRequest{Key: key, PartitionHash: 7, Value: []byte(value)}, updatedAtNs)
+		if err := s.writeEntry(key, entry); err != nil {
+			t.Fatalf("put %s: %v", value, err)
+		}
+	}
+	indexEntry := func(term string) *codec.Entry {
+		b, _ := s.db.Get(indexKey(def.Name, []byte(term), key))
+		if len(b) == 0 {
+			return nil
+		}
+		return codec.FromBytes(b)
+	}
+
+	put(`{"email":"a@x"}`, 1)
+	if entry := indexEntry("a@x"); entry == nil || entry.PartitionHash != 7 || entry.UpdatedAtNs != 1 {
+		t.Errorf("index entry %+v, expecting the partition and the time of the indexed entry", entry)
+	}
+
+	// the term is changed
+	put(`{"email":"b@x"}`, 2)
+	if indexEntry("a@x") != nil {
+		t.Errorf("the index entry of the old term is left")
+	}
+	if entry := indexEntry("b@x"); entry == nil || entry.UpdatedAtNs != 2 {
+		t.Errorf("index entry of the new term %+v", entry)
+	}
+
+	// the value is no longer indexed
+	put(`{"name":"b"}`, 3)
+	if indexEntry("b@x") != nil {
+		t.Errorf("the index entry is left after the term is removed")
+	}
+
+	put(`{"email":"c@x"}`, 4)
+	if err := s.writeEntry(key, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if indexEntry("c@x") != nil {
+		t.Errorf("the index entry is left after the key is deleted")
+	}
+
+}
+
+func TestMergeUpdatesIndexes(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	def := &pb.IndexDefinition{Name: "p", Source: pb.IndexDefinition_KEY_PREFIX, Length: 2}
+	s.setIndexes([]*pb.IndexDefinition{def})
+
+	key := []byte("ab1")
+	for i := 1; i <= 2; i++ {
+		if err := s.merge(key, newFloatMergeEntry(key, 1.5, uint64(i))); err != nil {
+			t.Fatalf("merge %d: %v", i, err)
+		}
+	}
+
+	b, err := s.db.Get(key)
+	if err != nil || len(b) == 0 {
+		t.Fatalf("get merged entry: %v", err)
+	}
+	if merged := math.Float64frombits(binary.LittleEndian.Uint64(codec.FromBytes(b).Value)); merged != 3 {
+		t.Errorf("merged value %v, expecting 3", merged)
+	}
+
+	if b, _ := s.db.Get(indexKey(def.Name, []byte("ab"), key)); len(b) == 0 {
+		t.Errorf("missing index entry of the merged key")
+	}
+
+	if err := s.writeEntry(key, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if b, _ := s.db.Get(indexKey(def.Name, []byte("ab"), key)); len(b) > 0 {
+		t.Errorf("index entry is left after the key is deleted")
+	}
+
+}
+
+func TestRebuildAndDropIndex(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	for _, key := range []string{"ab1", "ab2", "cd1"} {
+		if err := s.writeEntry([]byte(key), codec.NewPutEntry(&pb.PutRequest{Key: []byte(key), Value: []byte("v")}, 1)); err != nil {
+			t.Fatalf("put %s: %v", key, err)
+		}
+	}
+
+	def := &pb.IndexDefinition{Name: "p", Source: pb.IndexDefinition_KEY_PREFIX, Length: 2}
+	s.setIndexes([]*pb.IndexDefinition{def})
+	if err := s.rebuildIndex(def); err != nil {
+		t.Fatalf("rebuild: %v", err)
+	}
+
+	countIndexEntries := func() (count int) {
+		s.db.PrefixScan(indexNamePrefix(def.Name), nil, 0, func(key, value []byte) bool {
+			count++
+			return true
+		})
+		return
+	}
+
+	if count := countIndexEntries(); count != 3 {
+		t.Errorf("rebuilt %d index entries, expecting 3", count)
+	}
+	if b, _ := s.db.Get(indexKey(def.Name, []byte("cd"), []byte("cd1"))); len(b) == 0 {
+		t.Errorf("missing index entry of cd1")
+	}
+
+	s.setIndexes(nil)
+	if err := s.dropIndex(def.Name); err != nil {
+		t.Fatalf("drop: %v", err)
+	}
+	if count := countIndexEntries(); count != 0 {
+		t.Errorf("%d index entries left after the index is dropped", count)
+	}
+
+}
+
+func TestSetIndexesWaitsForWrites(t *testing.T) {
+
+	s, cleanup := newTestShard(t)
+	defer cleanup()
+
+	// a write in progress
+	unlock := s.lockKey([]byte("ab1"))
+
+	done := make(chan bool)
+	go func() {
+		s.setIndexes(nil)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("set the indexes during a write")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	<-done
+
+}
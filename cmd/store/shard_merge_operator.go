@@ -42,6 +42,7 @@ func (mo vastorMergeOperator) FullMerge(key, existingValue []byte, operands [][]
 			}
 		}
 	}
+	entry.FinalizeMerge()
 	return entry.ToBytes(), true
 }
 
@@ -59,7 +60,7 @@ func (mo vastorMergeOperator) FullMerge(key, existingValue []byte, operands [][]
 // The library will internally keep track of the operations, and apply them in the
 // correct order once a base-value (a Put/Delete/End-of-Database) is seen.
 func (mo vastorMergeOperator) PartialMerge(key, leftOperand, rightOperand []byte) ([]byte, bool) {
-	return codec.Merge(leftOperand, rightOperand)
+	return codec.PartialMerge(leftOperand, rightOperand)
 }
 
 // The name of the MergeOperator.
@@ -12,6 +12,11 @@ import (
 	"time"
 )
 
+const (
+	// the stores report the replication lags and the disk usages, and receive the remote clusters
+	periodicHeartbeatInterval = 3 * time.Second
+)
+
 func (ss *storeServer) keepConnectedToMasterServer(ctx context.Context) {
 
 	util.RetryForever(ctx, "store connect to master", func() error {
@@ -52,8 +57,21 @@ func (ss *storeServer) registerAtMasterServer() error {
 			AdminAddress: ss.selfAdminAddress(),
 			DiskSizeGb:   uint32(*ss.option.DiskSizeGb),
 			Tags:         strings.Split(*ss.option.Tags, ","),
+			DataCenter:   *ss.option.DataCenter,
+			Zone:         *ss.option.Zone,
+			Rack:         *ss.option.Rack,
 		},
 	}
+	ss.statusInClusterLock.RLock()
+	for keyspace, storeStatus := range ss.statusInCluster {
+		if storeStatus.Settings != nil {
+			storeHeartbeat.KeyspaceSettings = append(storeHeartbeat.KeyspaceSettings, storeStatus.Settings)
+		}
+		if storeStatus.IndexesUpdatedAtNs > 0 {
+			storeHeartbeat.KeyspaceIndexes = append(storeHeartbeat.KeyspaceIndexes, localIndexes(keyspace, storeStatus))
+		}
+	}
+	ss.statusInClusterLock.RUnlock()
 
 	// glog.V(2).Infof("Reporting store %v", storeHeartbeat.StoreResource)
 
@@ -77,8 +95,22 @@ func (ss *storeServer) registerAtMasterServer() error {
 	defer close(finishChan)
 
 	go func() {
+		ticker := time.NewTicker(periodicHeartbeatInterval)
+		defer ticker.Stop()
 		for {
 			select {
+			case <-ticker.C:
+				// the master replies with the clusters in other data centers
+				storeHeartbeat = &pb.StoreHeartbeat{
+					ReplicationLags:     ss.collectReplicationLags(),
+					ShardDiskUsages:     ss.collectShardDiskUsages(),
+					BootstrapProgresses: ss.collectBootstrapProgresses(),
+					FollowLags:          ss.collectFollowLags(),
+				}
+				if err := stream.Send(storeHeartbeat); err != nil {
+					glog.Errorf("send periodic heartbeat %v: %v", storeHeartbeat, err)
+					return
+				}
 			case ShardInfo := <-ss.ShardInfoChan:
 				// collect current server's different cluster shard status
 				// log.Println("shard status => ", ShardInfo)
@@ -109,6 +141,51 @@ func (ss *storeServer) registerAtMasterServer() error {
 
 }
 
+func (ss *storeServer) collectShardDiskUsages() (usages []*pb.ShardDiskUsage) {
+	ss.keyspaceShards.RLock()
+	for _, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, shard := range shards {
+			usages = append(usages, &pb.ShardDiskUsage{
+				Keyspace:  shard.keyspace,
+				ServerId:  uint32(shard.serverId),
+				ShardId:   uint32(shard.id),
+				SizeBytes: shard.db.LiveFilesSize(),
+			})
+		}
+	}
+	ss.keyspaceShards.RUnlock()
+	return
+}
+
+func (ss *storeServer) collectBootstrapProgresses() (progresses []*pb.BootstrapProgress) {
+	ss.keyspaceShards.RLock()
+	for _, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, shard := range shards {
+			progresses = append(progresses, shard.getBootstrapProgresses()...)
+		}
+	}
+	ss.keyspaceShards.RUnlock()
+	return
+}
+
+func (ss *storeServer) collectFollowLags() (lags []*pb.FollowLag) {
+	ss.keyspaceShards.RLock()
+	for _, shards := range ss.keyspaceShards.keyspaceToShards {
+		for _, shard := range shards {
+			if lag, isFollowing := shard.followLag(); isFollowing {
+				lags = append(lags, &pb.FollowLag{
+					Keyspace:       shard.keyspace,
+					ServerId:       uint32(shard.serverId),
+					ShardId:        uint32(shard.id),
+					LagMillisecond: lag,
+				})
+			}
+		}
+	}
+	ss.keyspaceShards.RUnlock()
+	return
+}
+
 func (ss *storeServer) sendShardInfoToMaster(ShardInfo *pb.ShardInfo, status pb.ShardInfo_Status) {
 	t := ShardInfo.Clone()
 	t.Status = status
@@ -117,5 +194,6 @@ func (ss *storeServer) sendShardInfoToMaster(ShardInfo *pb.ShardInfo, status pb.
 }
 
 func (ss *storeServer) processStoreMessage(msg *pb.StoreMessage) {
-	glog.V(2).Infof("%s received message %v", ss.storeName, msg)
+	glog.V(4).Infof("%s received message %v", ss.storeName, msg)
+	ss.followRemoteClusters(msg.RemoteClusters)
 }
@@ -7,14 +7,22 @@ import (
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
 	"github.com/dgryski/go-jump"
+	"golang.org/x/net/context"
+	"time"
 )
 
 const (
 	constBootstrapCopyBatchSize = 1024
+	constBootstrapCopyRetries   = 10
+	constBootstrapSstEntries    = 1024 * 1024
 )
 
+var bootstrapCopyRetryInterval = 5 * time.Second
+
 // BootstrapCopy sends all data if BootstrapCopyRequest's TargetClusterSize==0,
-// or sends all data belong to TargetShardId in cluster of TargetClusterSize
+// or sends all data belong to TargetShardId in cluster of TargetClusterSize.
+// The copy starts after the StartAfterKey if resuming, and is throttled by this store's limit,
+// which can be changed by SetBootstrapThrottle while copying.
 func (ss *storeServer) BootstrapCopy(request *pb.BootstrapCopyRequest, stream pb.VastoStore_BootstrapCopyServer) error {
 
 	glog.V(1).Infof("BootstrapCopy %v", request)
@@ -37,9 +45,19 @@ func (ss *storeServer) BootstrapCopy(request *pb.BootstrapCopyRequest, stream pb
 		batchSize *= targetClusterSize
 	}
 
+	binlogTailProgress := &pb.BootstrapCopyResponse_BinlogTailProgress{
+		Segment: segment,
+		Offset:  uint64(offset),
+	}
+
+	// let the receiver know where to follow from, in case the copy is interrupted
+	if err := stream.Send(&pb.BootstrapCopyResponse{BinlogTailProgress: binlogTailProgress}); err != nil {
+		return err
+	}
+
 	sentCounter := 0
 	skippedCounter := 0
-	err := shard.db.FullScan(uint64(batchSize), request.Limit, func(rows []*pb.RawKeyValue) error {
+	err := shard.db.FullScanAfter(request.StartAfterKey, uint64(batchSize), request.Limit, func(rows []*pb.RawKeyValue) error {
 
 		var filteredRows []*pb.RawKeyValue
 		for _, row := range rows {
@@ -66,6 +84,12 @@ func (ss *storeServer) BootstrapCopy(request *pb.BootstrapCopyRequest, stream pb
 			}
 		}
 
+		var size int64
+		for _, row := range filteredRows {
+			size += int64(len(row.Key) + len(row.Value))
+		}
+		ss.bootstrapThrottler.Wait(size)
+
 		t := &pb.BootstrapCopyResponse{
 			KeyValues: filteredRows,
 		}
@@ -76,10 +100,7 @@ func (ss *storeServer) BootstrapCopy(request *pb.BootstrapCopyRequest, stream pb
 	})
 
 	t := &pb.BootstrapCopyResponse{
-		BinlogTailProgress: &pb.BootstrapCopyResponse_BinlogTailProgress{
-			Segment: segment,
-			Offset:  uint64(offset),
-		},
+		BinlogTailProgress: binlogTailProgress,
 	}
 	if err := stream.Send(t); err != nil {
 		return err
@@ -89,3 +110,13 @@ func (ss *storeServer) BootstrapCopy(request *pb.BootstrapCopyRequest, stream pb
 
 	return err
 }
+
+// SetBootstrapThrottle changes the limit of sending bootstrap copies, which applies to the copies in progress.
+func (ss *storeServer) SetBootstrapThrottle(ctx context.Context, request *pb.SetBootstrapThrottleRequest) (*pb.SetBootstrapThrottleResponse, error) {
+
+	glog.V(1).Infof("%s set bootstrap throttle %v", ss.storeName, request)
+	ss.bootstrapThrottler.SetBytesPerSecond(int64(request.BytesPerSecond))
+
+	return &pb.SetBootstrapThrottleResponse{}, nil
+
+}
@@ -14,11 +14,14 @@ import (
 func (ss *storeServer) CreateShard(ctx context.Context, request *pb.CreateShardRequest) (*pb.CreateShardResponse, error) {
 
 	glog.V(1).Infof("%s create shard %v", ss.storeName, request)
-	err := ss.createShards(request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ReplicationFactor), false, func(shardId int) *topology.BootstrapPlan {
+	err := ss.createShards(ctx, request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ShardCount), int(request.ReplicationFactor), false, request.Indexes, func(shardId int) *topology.BootstrapPlan {
 		return &topology.BootstrapPlan{
 			ToClusterSize: int(request.ClusterSize),
 		}
 	})
+	if err == nil && request.Settings != nil {
+		err = ss.updateKeyspaceSettings(request.Keyspace, request.Settings)
+	}
 	if err != nil {
 		glog.Errorf("%s create keyspace %s: %v", ss.storeName, request.Keyspace, err)
 		return &pb.CreateShardResponse{
@@ -32,7 +35,8 @@ func (ss *storeServer) CreateShard(ctx context.Context, request *pb.CreateShardR
 
 }
 
-func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize, replicationFactor int, isCandidate bool, planGen func(shardId int) *topology.BootstrapPlan) error {
+// createShards creates and bootstraps the local shards, and stops if ctx is cancelled.
+func (ss *storeServer) createShards(ctx context.Context, keyspace string, serverId int, clusterSize, shardCount, replicationFactor int, isCandidate bool, indexes *pb.KeyspaceIndexes, planGen func(shardId int) *topology.BootstrapPlan) error {
 
 	var existingPrimaryShards []*pb.ClusterNode
 	if cluster, found := ss.clusterListener.GetCluster(keyspace); found {
@@ -46,7 +50,7 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 		glog.V(1).Infof("%s existing shards: %+v", ss.storeName, existingPrimaryShards)
 	}
 
-	ss.clusterListener.AddNewKeyspace(keyspace, clusterSize, replicationFactor)
+	ss.clusterListener.AddNewKeyspace(keyspace, clusterSize, replicationFactor).SetShardCount(shardCount)
 
 	if _, found := ss.keyspaceShards.getShards(keyspace); found {
 		localShards, foundLocalShards := ss.getServerStatusInCluster(keyspace)
@@ -61,9 +65,14 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 		}
 	}
 
-	localShards := ss.getOrCreateServerStatusInCluster(keyspace, serverId, clusterSize, replicationFactor)
+	localShards := ss.getOrCreateServerStatusInCluster(keyspace, serverId, clusterSize, shardCount, replicationFactor)
+	setLocalIndexes(localShards, indexes)
+
+	for _, clusterShard := range topology.LocalVirtualShards(serverId, clusterSize, shardCount, replicationFactor) {
 
-	for _, clusterShard := range topology.LocalShards(serverId, clusterSize, replicationFactor) {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("%s create shards for keyspace %s: %v", ss.storeName, keyspace, err)
+		}
 
 		shardInfo, foundShardInfo := localShards.ShardMap[uint32(clusterShard.ShardId)]
 
@@ -75,6 +84,7 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 				ClusterSize:       uint32(clusterSize),
 				ReplicationFactor: uint32(replicationFactor),
 				IsCandidate:       isCandidate,
+				ShardCount:        uint32(shardCount),
 			}
 		}
 
@@ -88,19 +98,19 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 			glog.V(1).Infof("%s created new shard %s", ss.storeName, shard.String())
 		} else {
 			glog.V(1).Infof("%s found existing shard %s", ss.storeName, shard.String())
+			shard.setIndexes(localShards.Indexes)
 		}
 
 		plan := planGen(clusterShard.ShardId)
 		glog.V(1).Infof("%s shard %s bootstrap plan: %s", ss.storeName, shardInfo.IdentifierOnThisServer(), plan.String())
 
-		if err := shard.startWithBootstrapPlan(plan, ss.selfAdminAddress(), existingPrimaryShards); err != nil {
+		if err := shard.startWithBootstrapPlan(ctx, plan, ss.selfAdminAddress(), existingPrimaryShards); err != nil {
 			return fmt.Errorf("%s bootstrap shard %v : %v", ss.storeName, shardInfo.IdentifierOnThisServer(), err)
 		}
 
-		localShards.ShardMap[uint32(clusterShard.ShardId)] = shardInfo
-
-		if !foundShardInfo {
-			ss.sendShardInfoToMaster(shardInfo, pb.ShardInfo_READY)
+		// the shard may be deleted by a cleanup after the bootstrap is cancelled
+		if err := ss.addShardInfo(ctx, shard, localShards, shardInfo, !foundShardInfo); err != nil {
+			return err
 		}
 
 	}
@@ -109,6 +119,28 @@ func (ss *storeServer) createShards(keyspace string, serverId int, clusterSize,
 
 }
 
+// addShardInfo adds the bootstrapped shard to the local shards, unless it is cancelled or deleted
+func (ss *storeServer) addShardInfo(ctx context.Context, shard *shard, localShards *pb.LocalShardsInCluster, shardInfo *pb.ShardInfo, isNew bool) error {
+
+	ss.shardsLock.Lock()
+	defer ss.shardsLock.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s create shard %v: %v", ss.storeName, shardInfo.IdentifierOnThisServer(), err)
+	}
+	if shard.hasShutdown() {
+		return fmt.Errorf("%s create shard %v: deleted", ss.storeName, shardInfo.IdentifierOnThisServer())
+	}
+
+	localShards.ShardMap[shardInfo.ShardId] = shardInfo
+
+	if isNew {
+		ss.sendShardInfoToMaster(shardInfo, pb.ShardInfo_READY)
+	}
+
+	return nil
+}
+
 func (ss *storeServer) startExistingNodes(keyspaceName string, storeStatus *pb.LocalShardsInCluster) error {
 	for _, shardInfo := range storeStatus.ShardMap {
 		shard, shardOpenError := ss.openShard(shardInfo)
@@ -123,7 +155,15 @@ func (ss *storeServer) startExistingNodes(keyspaceName string, storeStatus *pb.L
 			}
 		}
 
-		if err := shard.startWithBootstrapPlan(&topology.BootstrapPlan{
+		if *ss.option.MigrateEntryFormat {
+			migratedCount, err := shard.db.MigrateEntryFormat()
+			if err != nil {
+				return fmt.Errorf("%s migrate shard %v : %v", ss.storeName, shardInfo.IdentifierOnThisServer(), err)
+			}
+			glog.V(0).Infof("%s migrated %d entries in shard %v", ss.storeName, migratedCount, shardInfo.IdentifierOnThisServer())
+		}
+
+		if err := shard.startWithBootstrapPlan(context.Background(), &topology.BootstrapPlan{
 			ToClusterSize: int(shardInfo.ClusterSize),
 		}, ss.selfAdminAddress(), nil); err != nil {
 			return fmt.Errorf("%s bootstrap shard %v : %v", ss.storeName, shardInfo.IdentifierOnThisServer(), err)
@@ -136,6 +176,7 @@ func (ss *storeServer) startExistingNodes(keyspaceName string, storeStatus *pb.L
 func (ss *storeServer) openShard(shardInfo *pb.ShardInfo) (shard *shard, err error) {
 
 	cluster := ss.clusterListener.GetOrSetCluster(shardInfo.KeyspaceName, int(shardInfo.ClusterSize), int(shardInfo.ReplicationFactor))
+	cluster.SetShardCount(int(shardInfo.ShardCount))
 
 	dir := fmt.Sprintf("%s/%s/%d", *ss.option.Dir, shardInfo.KeyspaceName, shardInfo.ShardId)
 	err = os.MkdirAll(dir, 0755)
@@ -147,6 +188,10 @@ func (ss *storeServer) openShard(shardInfo *pb.ShardInfo) (shard *shard, err err
 	shard = newShard(shardInfo.KeyspaceName, dir, int(shardInfo.ServerId), int(shardInfo.ShardId), cluster, ss.clusterListener,
 		int(shardInfo.ReplicationFactor), *ss.option.LogFileSizeMb, *ss.option.LogFileCount)
 	shard.setCompactionFilterClusterSize(int(shardInfo.ClusterSize))
+	if localShards, found := ss.getServerStatusInCluster(shardInfo.KeyspaceName); found {
+		shard.setIndexes(localShards.Indexes)
+		shard.db.SetRetention(localShards.Settings.GetRetentionSecond())
+	}
 	// println("loading shard", shard.String())
 	ss.keyspaceShards.addShards(shardInfo.KeyspaceName, shard)
 	ss.RegisterPeriodicTask(shard)
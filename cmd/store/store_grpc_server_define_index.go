@@ -0,0 +1,90 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// DefineKeyspaceIndex
+// 1. save the index definitions to the cluster config
+// 2. build the index entries for the existing data, or delete them if the index is dropped
+func (ss *storeServer) DefineKeyspaceIndex(ctx context.Context, request *pb.DefineIndexRequest) (*pb.DefineIndexResponse, error) {
+
+	glog.V(1).Infof("%s define index %v", ss.storeName, request)
+	err := ss.defineIndex(request)
+	if err != nil {
+		glog.Errorf("%s define index on keyspace %s: %v", ss.storeName, request.Keyspace, err)
+		return &pb.DefineIndexResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &pb.DefineIndexResponse{
+		Error: "",
+	}, nil
+
+}
+
+func (ss *storeServer) defineIndex(request *pb.DefineIndexRequest) error {
+
+	keyspace, def, isDrop := request.Keyspace, request.Index, request.IsDrop
+
+	if err := validateIndexDefinition(def); err != nil {
+		return err
+	}
+
+	localShards, found := ss.getServerStatusInCluster(keyspace)
+	if !found {
+		return fmt.Errorf("%s missing local shard status for keyspace %s", ss.storeName, keyspace)
+	}
+	shards, found := ss.keyspaceShards.getShards(keyspace)
+	if !found {
+		return fmt.Errorf("unexpected shards not found for %s", keyspace)
+	}
+
+	status := proto.Clone(localShards).(*pb.LocalShardsInCluster)
+	indexes := localIndexes(keyspace, status).WithIndex(keyspace, def, isDrop, request.UpdatedAtNs).Indexes
+	status.Indexes = indexes
+	status.IndexesUpdatedAtNs = request.UpdatedAtNs
+
+	if err := ss.saveClusterConfig(status, keyspace); err != nil {
+		return err
+	}
+
+	for _, shard := range shards {
+		shard.setIndexes(indexes)
+		if err := shard.dropIndex(def.Name); err != nil {
+			return fmt.Errorf("%s shard %s: %v", ss.storeName, shard, err)
+		}
+		if isDrop {
+			continue
+		}
+		if err := shard.rebuildIndex(def); err != nil {
+			return fmt.Errorf("%s shard %s: %v", ss.storeName, shard, err)
+		}
+	}
+
+	return nil
+}
+
+func localIndexes(keyspace string, localShards *pb.LocalShardsInCluster) *pb.KeyspaceIndexes {
+	return &pb.KeyspaceIndexes{
+		Keyspace:    keyspace,
+		Indexes:     localShards.Indexes,
+		UpdatedAtNs: localShards.IndexesUpdatedAtNs,
+	}
+}
+
+// setLocalIndexes keeps the index definitions from the master, unless the local ones are later.
+// A server new to the keyspace learns the index definitions this way, before its shards are opened.
+func setLocalIndexes(localShards *pb.LocalShardsInCluster, indexes *pb.KeyspaceIndexes) {
+	if indexes == nil || indexes.UpdatedAtNs < localShards.IndexesUpdatedAtNs {
+		return
+	}
+	localShards.Indexes = indexes.Indexes
+	localShards.IndexesUpdatedAtNs = indexes.UpdatedAtNs
+}
@@ -63,6 +63,7 @@ func (ss *storeServer) shutdownShard(shard *shard) {
 	ss.UnregisterPeriodicTask(shard)
 	shard.clusterListener.UnregisterShardEventProcessor(shard)
 	shard.shutdownNode()
+	shard.waitForBootstraps()
 	shard.db.Close()
 	shard.db.Destroy()
 	ss.keyspaceShards.removeShard(shard)
@@ -0,0 +1,36 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+)
+
+// SubscribeExpiry streams the keys with the prefix expired on the shard, until the client disconnects.
+func (ss *storeServer) SubscribeExpiry(request *pb.SubscribeExpiryRequest, stream pb.VastoStore_SubscribeExpiryServer) error {
+
+	shard, found := ss.keyspaceShards.getShard(request.Keyspace, VastoShardId(request.ShardId))
+	if !found || shard.isShutdown {
+		return fmt.Errorf("shard: %s.%d not found", request.Keyspace, request.ShardId)
+	}
+
+	glog.V(1).Infof("%s subscribe expiry of prefix %v", shard, string(request.Prefix))
+
+	subscriber := shard.subscribeExpiry(request.Prefix)
+	defer shard.unsubscribeExpiry(subscriber)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-shard.nodeFinishChan:
+			return fmt.Errorf("shard %s is shut down", shard)
+		case event := <-subscriber.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+
+}
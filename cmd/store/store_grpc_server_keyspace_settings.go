@@ -0,0 +1,90 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// UpdateKeyspaceSettings
+// 1. save the keyspace settings to the cluster config
+// 2. apply the retention to the compaction of all local shards
+func (ss *storeServer) UpdateKeyspaceSettings(ctx context.Context, request *pb.UpdateKeyspaceRequest) (*pb.UpdateKeyspaceResponse, error) {
+
+	glog.V(1).Infof("%s update keyspace settings %v", ss.storeName, request)
+	err := ss.updateKeyspaceSettings(request.Keyspace, request.Settings)
+	if err != nil {
+		glog.Errorf("%s update keyspace %s settings: %v", ss.storeName, request.Keyspace, err)
+		return &pb.UpdateKeyspaceResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateKeyspaceResponse{
+		Error: "",
+	}, nil
+
+}
+
+func (ss *storeServer) updateKeyspaceSettings(keyspace string, settings *pb.KeyspaceSettings) error {
+
+	if err := pb.ValidateKeyspaceSettings(settings); err != nil {
+		return err
+	}
+
+	localShards, found := ss.getServerStatusInCluster(keyspace)
+	if !found {
+		return fmt.Errorf("%s missing local shard status for keyspace %s", ss.storeName, keyspace)
+	}
+	shards, found := ss.keyspaceShards.getShards(keyspace)
+	if !found {
+		return fmt.Errorf("unexpected shards not found for %s", keyspace)
+	}
+
+	status := proto.Clone(localShards).(*pb.LocalShardsInCluster)
+	status.Settings = settings
+	status.Settings.Keyspace = keyspace
+
+	if err := ss.saveClusterConfig(status, keyspace); err != nil {
+		return err
+	}
+
+	for _, shard := range shards {
+		shard.db.SetRetention(settings.RetentionSecond)
+	}
+
+	return nil
+}
+
+func (ss *storeServer) getKeyspaceSettings(keyspace string) *pb.KeyspaceSettings {
+	if localShards, found := ss.getServerStatusInCluster(keyspace); found {
+		return localShards.Settings
+	}
+	return nil
+}
+
+// applyKeyspaceTtl sets the default ttl to the put or merge without ttl, and rejects the ttl over the max ttl.
+// Without a default ttl, the max ttl is the default, so no entry lives longer than the max ttl.
+func (ss *storeServer) applyKeyspaceTtl(keyspace string, ttlSecond *uint32) error {
+
+	settings := ss.getKeyspaceSettings(keyspace)
+	if settings == nil {
+		return nil
+	}
+
+	if *ttlSecond == 0 {
+		*ttlSecond = settings.DefaultTtlSecond
+		if *ttlSecond == 0 {
+			*ttlSecond = settings.MaxTtlSecond
+		}
+	}
+
+	if settings.MaxTtlSecond > 0 && *ttlSecond > settings.MaxTtlSecond {
+		return fmt.Errorf("ttl %d exceeds the max ttl %d of keyspace %s", *ttlSecond, settings.MaxTtlSecond, keyspace)
+	}
+
+	return nil
+}
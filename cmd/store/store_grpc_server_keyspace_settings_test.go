@@ -0,0 +1,77 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"github.com/chrislusf/vasto/util"
+)
+
+func TestMergeWithKeyspaceTtl(t *testing.T) {
+
+	ss, cleanup := newTestStoreServer(t)
+	defer cleanup()
+
+	disableBinLog := true
+	ss.option.DisableBinLog = &disableBinLog
+
+	ss.clusterListener.AddExistingKeyspace("ks", 1, 1)
+	localShards := ss.getOrCreateServerStatusInCluster("ks", 0, 1, 0, 1)
+	shard, err := ss.openShard(&pb.ShardInfo{
+		KeyspaceName:      "ks",
+		ClusterSize:       1,
+		ReplicationFactor: 1,
+	})
+	if err != nil {
+		t.Fatalf("open shard: %v", err)
+	}
+
+	ttlOf := func(key string) uint32 {
+		b, err := shard.db.Get([]byte(key))
+		if err != nil || len(b) == 0 {
+			t.Fatalf("get %s: %v", key, err)
+		}
+		return codec.FromBytes(b).TtlSecond
+	}
+	merge := func(key string, dataType pb.OpAndDataType, value []byte, ttlSecond uint32) *pb.WriteResponse {
+		return ss.processMerge(shard, &pb.MergeRequest{
+			Key:           []byte(key),
+			OpAndDataType: dataType,
+			Value:         value,
+			TtlSecond:     ttlSecond,
+		})
+	}
+
+	setSettings := func(settings *pb.KeyspaceSettings) {
+		localShards.Settings = settings
+		if err := ss.saveClusterConfig(localShards, "ks"); err != nil {
+			t.Fatalf("save cluster config: %v", err)
+		}
+	}
+
+	// without a default ttl, the max ttl is the default
+	setSettings(&pb.KeyspaceSettings{Keyspace: "ks", MaxTtlSecond: 3600})
+	if resp := merge("a", pb.OpAndDataType_FLOAT64, util.Float64ToBytes(1), 0); !resp.Ok {
+		t.Fatalf("merge a: %s", resp.Status)
+	}
+	if ttl := ttlOf("a"); ttl != 3600 {
+		t.Errorf("merged a with ttl %d, expecting the max ttl", ttl)
+	}
+	if keys := expiryIndexKeys(shard); len(keys) != 1 || keys[0] != "a" {
+		t.Errorf("expiry index %v, expecting a", keys)
+	}
+
+	setSettings(&pb.KeyspaceSettings{Keyspace: "ks", DefaultTtlSecond: 60, MaxTtlSecond: 3600})
+	if resp := merge("b", pb.OpAndDataType_INT64, util.Int64ToBytes(1), 0); !resp.Ok {
+		t.Fatalf("merge counter b: %s", resp.Status)
+	}
+	if ttl := ttlOf("b"); ttl != 60 {
+		t.Errorf("merged counter b with ttl %d, expecting the default ttl", ttl)
+	}
+
+	if resp := merge("c", pb.OpAndDataType_FLOAT64, util.Float64ToBytes(1), 7200); resp.Ok {
+		t.Errorf("merge over the max ttl should fail")
+	}
+
+}
@@ -14,7 +14,7 @@ import (
 func (ss *storeServer) ReplicateNodePrepare(ctx context.Context, request *pb.ReplicateNodePrepareRequest) (*pb.ReplicateNodePrepareResponse, error) {
 
 	glog.V(1).Infof("replicate shard prepare %v", request)
-	err := ss.replicateNode(request)
+	err := ss.replicateNode(ctx, request)
 	if err != nil {
 		glog.Errorf("replicate shard prepare %v: %v", request, err)
 		return &pb.ReplicateNodePrepareResponse{
@@ -64,17 +64,17 @@ func (ss *storeServer) ReplicateNodeCleanup(ctx context.Context, request *pb.Rep
 
 }
 
-func (ss *storeServer) replicateNode(request *pb.ReplicateNodePrepareRequest) (err error) {
+func (ss *storeServer) replicateNode(ctx context.Context, request *pb.ReplicateNodePrepareRequest) (err error) {
 
-	err = ss.createShards(request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ReplicationFactor), true, func(shardId int) *topology.BootstrapPlan {
+	err = ss.createShards(ctx, request.Keyspace, int(request.ServerId), int(request.ClusterSize), int(request.ShardCount), int(request.ReplicationFactor), true, request.Indexes, func(shardId int) *topology.BootstrapPlan {
 
-		return topology.BootstrapPlanWithTopoChange(&topology.BootstrapRequest{
+		return topology.BootstrapPlanWithVirtualShards(&topology.BootstrapRequest{
 			ServerId:          int(request.ServerId),
 			ShardId:           shardId,
 			FromClusterSize:   int(request.ClusterSize),
 			ToClusterSize:     int(request.ClusterSize),
 			ReplicationFactor: int(request.ReplicationFactor),
-		})
+		}, int(request.ShardCount))
 
 	})
 	if err != nil {
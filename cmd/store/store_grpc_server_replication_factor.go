@@ -0,0 +1,208 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/glog"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// 1. create the extra replica shards, and bootstrap them from the existing copies
+func (ss *storeServer) ChangeReplicationFactorPrepare(ctx context.Context, request *pb.ChangeReplicationFactorPrepareRequest) (*pb.ChangeReplicationFactorPrepareResponse, error) {
+
+	glog.V(1).Infof("change replication factor prepare %v", request)
+	err := ss.addReplicaShards(ctx, request)
+	if err != nil {
+		glog.Errorf("change replication factor prepare %v: %v", request, err)
+		return &pb.ChangeReplicationFactorPrepareResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &pb.ChangeReplicationFactorPrepareResponse{
+		Error: "",
+	}, nil
+
+}
+
+// 2. use the new replication factor, delete the surplus replica shards, and adjust the followings
+func (ss *storeServer) ChangeReplicationFactorCommit(ctx context.Context, request *pb.ChangeReplicationFactorCommitRequest) (*pb.ChangeReplicationFactorCommitResponse, error) {
+
+	glog.V(1).Infof("change replication factor commit %v", request)
+	err := ss.commitReplicationFactor(request)
+	if err != nil {
+		glog.Errorf("change replication factor commit %v: %v", request, err)
+		return &pb.ChangeReplicationFactorCommitResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &pb.ChangeReplicationFactorCommitResponse{
+		Error: "",
+	}, nil
+
+}
+
+// addReplicaShards creates and bootstraps the extra replica shards.
+// If any of them fails, the ones created by this call are deleted.
+func (ss *storeServer) addReplicaShards(ctx context.Context, request *pb.ChangeReplicationFactorPrepareRequest) (err error) {
+
+	localShards, found := ss.getServerStatusInCluster(request.Keyspace)
+	if !found {
+		return fmt.Errorf("%s keyspace %s not found", ss.storeName, request.Keyspace)
+	}
+	if localShards.Id != request.ServerId {
+		return fmt.Errorf("%s local server id = %d, not matching requested server id %d", ss.storeName, localShards.Id, request.ServerId)
+	}
+
+	if request.TargetReplicationFactor <= request.ReplicationFactor {
+		// lowering the replication factor has nothing to prepare
+		return nil
+	}
+
+	var existingPrimaryShards []*pb.ClusterNode
+	if cluster, found := ss.clusterListener.GetCluster(request.Keyspace); found {
+		for i := 0; i < cluster.ExpectedSize(); i++ {
+			if n, ok := cluster.GetNode(i, 0); ok {
+				existingPrimaryShards = append(existingPrimaryShards, n)
+			}
+		}
+	}
+
+	var createdShards []*shard
+	var addedShardInfos []*pb.ShardInfo
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, shard := range createdShards {
+			glog.V(1).Infof("%s delete failed replica shard %s", ss.storeName, shard)
+			ss.shutdownShard(shard)
+		}
+		for _, shardInfo := range addedShardInfos {
+			delete(localShards.ShardMap, shardInfo.ShardId)
+			ss.sendShardInfoToMaster(shardInfo, pb.ShardInfo_DELETED)
+		}
+		if len(addedShardInfos) > 0 {
+			if saveErr := ss.saveClusterConfig(localShards, request.Keyspace); saveErr != nil {
+				glog.Errorf("%s save cluster config %s: %v", ss.storeName, request.Keyspace, saveErr)
+			}
+		}
+	}()
+
+	serverId, clusterSize, shardCount := int(request.ServerId), int(request.ClusterSize), int(localShards.ShardCount)
+	for _, clusterShard := range newReplicaShards(serverId, clusterSize, shardCount, int(request.ReplicationFactor), int(request.TargetReplicationFactor)) {
+
+		if _, found := ss.keyspaceShards.getShard(request.Keyspace, VastoShardId(clusterShard.ShardId)); found {
+			// a retried prepare
+			continue
+		}
+
+		shardInfo := &pb.ShardInfo{
+			ServerId:          uint32(serverId),
+			ShardId:           uint32(clusterShard.ShardId),
+			KeyspaceName:      request.Keyspace,
+			ClusterSize:       request.ClusterSize,
+			ReplicationFactor: request.TargetReplicationFactor,
+			ShardCount:        localShards.ShardCount,
+		}
+
+		// the cluster keeps the current replication factor until the commit
+		openShardInfo := proto.Clone(shardInfo).(*pb.ShardInfo)
+		openShardInfo.ReplicationFactor = request.ReplicationFactor
+		shard, openErr := ss.openShard(openShardInfo)
+		if openErr != nil {
+			return fmt.Errorf("creating %s: %v", shardInfo.IdentifierOnThisServer(), openErr)
+		}
+		createdShards = append(createdShards, shard)
+
+		plan := replicaShardBootstrapPlan(serverId, clusterShard.ShardId, clusterSize, shardCount, int(request.ReplicationFactor), int(request.TargetReplicationFactor))
+		glog.V(1).Infof("%s replica shard %s bootstrap plan: %s", ss.storeName, shardInfo.IdentifierOnThisServer(), plan.String())
+
+		if err = shard.startWithBootstrapPlan(ctx, plan, ss.selfAdminAddress(), existingPrimaryShards); err != nil {
+			return fmt.Errorf("%s bootstrap replica shard %v : %v", ss.storeName, shardInfo.IdentifierOnThisServer(), err)
+		}
+		if err = ctx.Err(); err != nil {
+			return fmt.Errorf("%s bootstrap replica shard %v : %v", ss.storeName, shardInfo.IdentifierOnThisServer(), err)
+		}
+
+		localShards.ShardMap[shardInfo.ShardId] = shardInfo
+		addedShardInfos = append(addedShardInfos, shardInfo)
+		if err = ss.saveClusterConfig(localShards, request.Keyspace); err != nil {
+			return err
+		}
+
+		ss.sendShardInfoToMaster(shardInfo, pb.ShardInfo_READY)
+
+	}
+
+	return nil
+}
+
+// newReplicaShards returns the shards the server does not have yet, when the replication factor grows.
+func newReplicaShards(serverId, clusterSize, shardCount, replicationFactor, targetReplicationFactor int) (shards []topology.ClusterShard) {
+	for _, clusterShard := range topology.LocalVirtualShards(serverId, clusterSize, shardCount, targetReplicationFactor) {
+		if !topology.IsVirtualShardInLocal(clusterShard.ShardId, serverId, clusterSize, shardCount, replicationFactor) {
+			shards = append(shards, clusterShard)
+		}
+	}
+	return
+}
+
+// replicaShardBootstrapPlan copies the new replica shard from its existing copies, and then follows its peers
+// with the target replication factor. The server with the same id does not have the shard, unlike replacing a node.
+func replicaShardBootstrapPlan(serverId, shardId, clusterSize, shardCount, replicationFactor, targetReplicationFactor int) *topology.BootstrapPlan {
+	plan := topology.BootstrapPlanWithVirtualShards(&topology.BootstrapRequest{
+		ServerId:          serverId,
+		ShardId:           shardId,
+		FromClusterSize:   clusterSize,
+		ToClusterSize:     clusterSize,
+		ReplicationFactor: replicationFactor,
+	}, shardCount)
+	plan.TransitionalFollowSource = nil
+	plan.ToReplicationFactor = targetReplicationFactor
+	return plan
+}
+
+func (ss *storeServer) commitReplicationFactor(request *pb.ChangeReplicationFactorCommitRequest) error {
+
+	localShards, found := ss.getServerStatusInCluster(request.Keyspace)
+	if !found {
+		return fmt.Errorf("%s keyspace %s not found", ss.storeName, request.Keyspace)
+	}
+
+	replicationFactor := int(request.TargetReplicationFactor)
+	cluster := ss.clusterListener.GetOrSetCluster(request.Keyspace, 0, replicationFactor)
+
+	for _, shardInfo := range localShards.ShardMap {
+		if topology.IsVirtualShardInLocal(int(shardInfo.ShardId), int(localShards.Id), int(localShards.ClusterSize), int(localShards.ShardCount), replicationFactor) {
+			if int(shardInfo.ReplicationFactor) != replicationFactor {
+				shardInfo.ReplicationFactor = request.TargetReplicationFactor
+				ss.sendShardInfoToMaster(shardInfo, pb.ShardInfo_READY)
+			}
+			continue
+		}
+		// the surplus replica shard
+		if shard, found := ss.keyspaceShards.getShard(request.Keyspace, VastoShardId(shardInfo.ShardId)); found {
+			glog.V(1).Infof("%s delete surplus replica shard %s", ss.storeName, shard)
+			ss.shutdownShard(shard)
+		}
+		delete(localShards.ShardMap, shardInfo.ShardId)
+		ss.sendShardInfoToMaster(shardInfo, pb.ShardInfo_DELETED)
+	}
+	localShards.ReplicationFactor = request.TargetReplicationFactor
+
+	if err := ss.saveClusterConfig(localShards, request.Keyspace); err != nil {
+		return err
+	}
+
+	// follow the new peers, or stop following the removed peers
+	ss.eachLocalShard(request.Keyspace, cluster.ExpectedSize(), func(shard *shard) {
+		shard.adjustNormalFollowings(cluster.ExpectedSize(), replicationFactor)
+	})
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+)
+
+func TestNewReplicaShards(t *testing.T) {
+
+	tests := []struct {
+		serverId, clusterSize, shardCount, replicationFactor, targetReplicationFactor int
+		expected                                                                      []int
+	}{
+		{0, 3, 0, 1, 2, []int{2}},
+		{0, 3, 0, 1, 3, []int{2, 1}},
+		{1, 3, 0, 2, 3, []int{2}},
+		{0, 3, 6, 1, 2, []int{2, 5}},
+		{0, 3, 0, 2, 2, nil},
+	}
+
+	for _, test := range tests {
+		var shardIds []int
+		for _, shard := range newReplicaShards(test.serverId, test.clusterSize, test.shardCount, test.replicationFactor, test.targetReplicationFactor) {
+			if shard.ServerId != test.serverId {
+				t.Errorf("%+v: shard %+v on another server", test, shard)
+			}
+			shardIds = append(shardIds, shard.ShardId)
+		}
+		if !reflect.DeepEqual(shardIds, test.expected) {
+			t.Errorf("%+v: new replica shards %v, expecting %v", test, shardIds, test.expected)
+		}
+	}
+
+}
+
+func TestReplicaShardBootstrapPlan(t *testing.T) {
+
+	// shard 2 is only on server 2, and is copied to server 0
+	plan := replicaShardBootstrapPlan(0, 2, 3, 0, 1, 2)
+
+	if !reflect.DeepEqual(plan.BootstrapSource, []topology.ClusterShard{{ShardId: 2, ServerId: 2}}) {
+		t.Errorf("bootstrap source %+v, expecting shard 2 on server 2", plan.BootstrapSource)
+	}
+	if !plan.PickBestBootstrapSource {
+		t.Errorf("should copy from one existing copy")
+	}
+	if len(plan.TransitionalFollowSource) > 0 {
+		t.Errorf("unexpected transitional follow %+v", plan.TransitionalFollowSource)
+	}
+	if plan.ToClusterSize != 3 || plan.ToReplicationFactor != 2 {
+		t.Errorf("follow with cluster size %d replication factor %d, expecting 3 and 2", plan.ToClusterSize, plan.ToReplicationFactor)
+	}
+
+}
+
+func TestChangeReplicationFactor(t *testing.T) {
+
+	ss, cleanup := newTestStoreServer(t)
+	defer cleanup()
+
+	// server 0 has shard 0 in a cluster of 3 servers
+	ss.clusterListener.AddExistingKeyspace("ks", 3, 1)
+	localShards := ss.getOrCreateServerStatusInCluster("ks", 0, 3, 0, 1)
+	shardInfo := &pb.ShardInfo{
+		KeyspaceName:      "ks",
+		ClusterSize:       3,
+		ReplicationFactor: 1,
+	}
+	if _, err := ss.openShard(shardInfo); err != nil {
+		t.Fatalf("open shard: %v", err)
+	}
+	localShards.ShardMap[0] = shardInfo
+	if err := ss.saveClusterConfig(localShards, "ks"); err != nil {
+		t.Fatalf("save cluster config: %v", err)
+	}
+
+	prepare := &pb.ChangeReplicationFactorPrepareRequest{
+		Keyspace:                "ks",
+		ServerId:                0,
+		ClusterSize:             3,
+		ReplicationFactor:       1,
+		TargetReplicationFactor: 3,
+	}
+
+	// a failed prepare deletes its replica shards
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := ss.addReplicaShards(ctx, prepare); err == nil {
+		t.Errorf("cancelled prepare should fail")
+	}
+	if shards, _ := ss.keyspaceShards.getShards("ks"); len(shards) != 1 || len(localShards.ShardMap) != 1 {
+		t.Errorf("failed prepare leaves %d shards, %d shard infos", len(shards), len(localShards.ShardMap))
+	}
+	drainShardInfos(ss)
+
+	// growing adds shards 1 and 2, which follow the peers with the target replication factor
+	if err := ss.addReplicaShards(context.Background(), prepare); err != nil {
+		t.Fatalf("prepare: %v", err)
+	}
+	if len(localShards.ShardMap) != 3 || len(drainShardInfos(ss)) != 2 {
+		t.Errorf("prepared shard infos %v", localShards.ShardMap)
+	}
+	if cluster, _ := ss.clusterListener.GetCluster("ks"); cluster.ReplicationFactor() != 1 {
+		t.Errorf("replication factor %d is changed before the commit", cluster.ReplicationFactor())
+	}
+	shard2, found := ss.keyspaceShards.getShard("ks", 2)
+	if !found {
+		t.Fatalf("missing replica shard 2")
+	}
+	if !shard2.isFollowing(topology.ClusterShard{ShardId: 2, ServerId: 1}) {
+		t.Errorf("replica shard 2 should follow its copy on server 1")
+	}
+
+	// the commit switches to the target replication factor
+	if err := ss.commitReplicationFactor(&pb.ChangeReplicationFactorCommitRequest{Keyspace: "ks", TargetReplicationFactor: 3}); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if localShards.ReplicationFactor != 3 {
+		t.Errorf("committed replication factor %d", localShards.ReplicationFactor)
+	}
+	for _, shardInfo := range localShards.ShardMap {
+		if shardInfo.ReplicationFactor != 3 {
+			t.Errorf("shard %d replication factor %d", shardInfo.ShardId, shardInfo.ReplicationFactor)
+		}
+	}
+	drainShardInfos(ss)
+
+	// shrinking deletes the surplus replica shards
+	if err := ss.commitReplicationFactor(&pb.ChangeReplicationFactorCommitRequest{Keyspace: "ks", TargetReplicationFactor: 1}); err != nil {
+		t.Fatalf("shrink: %v", err)
+	}
+	if _, found := localShards.ShardMap[0]; !found || len(localShards.ShardMap) != 1 {
+		t.Errorf("shrunk shard infos %v", localShards.ShardMap)
+	}
+	if shards, _ := ss.keyspaceShards.getShards("ks"); len(shards) != 1 {
+		t.Errorf("shrunk to %d shards", len(shards))
+	}
+	deleted := 0
+	for _, shardInfo := range drainShardInfos(ss) {
+		if shardInfo.Status == pb.ShardInfo_DELETED {
+			deleted++
+		}
+	}
+	if deleted != 2 {
+		t.Errorf("reported %d deleted shards, expecting 2", deleted)
+	}
+
+}
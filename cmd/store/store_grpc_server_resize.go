@@ -45,7 +45,8 @@ func (ss *storeServer) ResizeCommit(ctx context.Context, request *pb.ResizeCommi
 
 }
 
-// 3. cleanup old shards, and stop one-time follows
+// 3. cleanup old shards, and stop one-time follows.
+// This also rolls back a cancelled resize, with the current cluster size as the target cluster size.
 func (ss *storeServer) ResizeCleanup(ctx context.Context, request *pb.ResizeCleanupRequest) (*pb.ResizeCleanupResponse, error) {
 
 	glog.V(1).Infof("cleanup old shards %v", request)
@@ -69,15 +70,15 @@ func (ss *storeServer) resizeCreateShards(ctx context.Context, request *pb.Resiz
 		shard.db.PrepareForClusterResize()
 	})
 
-	err = ss.createShards(request.Keyspace, int(request.ServerId), int(request.TargetClusterSize), int(request.ReplicationFactor), true, func(shardId int) *topology.BootstrapPlan {
+	err = ss.createShards(ctx, request.Keyspace, int(request.ServerId), int(request.TargetClusterSize), int(request.ShardCount), int(request.ReplicationFactor), true, request.Indexes, func(shardId int) *topology.BootstrapPlan {
 
-		return topology.BootstrapPlanWithTopoChange(&topology.BootstrapRequest{
+		return topology.BootstrapPlanWithVirtualShards(&topology.BootstrapRequest{
 			ServerId:          int(request.ServerId),
 			ShardId:           shardId,
 			FromClusterSize:   int(request.ClusterSize),
 			ToClusterSize:     int(request.TargetClusterSize),
 			ReplicationFactor: int(request.ReplicationFactor),
-		})
+		}, int(request.ShardCount))
 
 	})
 	if err != nil {
@@ -105,7 +106,7 @@ func (ss *storeServer) resizeCommitShardInfoNewCluster(ctx context.Context, requ
 	hasChanges := false
 
 	for _, shardInfo := range localShardsStatus.ShardMap {
-		if topology.IsShardInLocal(int(shardInfo.ShardId), int(localShardsStatus.Id), int(request.TargetClusterSize), int(localShardsStatus.ReplicationFactor)) {
+		if topology.IsVirtualShardInLocal(int(shardInfo.ShardId), int(localShardsStatus.Id), int(request.TargetClusterSize), int(localShardsStatus.ShardCount), int(localShardsStatus.ReplicationFactor)) {
 			if shardInfo.ClusterSize != request.TargetClusterSize {
 				shardInfo.ClusterSize = request.TargetClusterSize
 				glog.V(1).Infof("adjuting shard %v to cluster size %d", shardInfo.String(), request.TargetClusterSize)
@@ -138,12 +139,16 @@ func (ss *storeServer) deleteOldShardsInNewCluster(ctx context.Context, request
 		return nil
 	}
 
+	// wait for the local shards being created, and stop them from being added back
+	ss.shardsLock.Lock()
+	defer ss.shardsLock.Unlock()
+
 	for _, shard := range shards {
 		if shard.oneTimeFollowCancel != nil {
 			glog.V(1).Infof("shard %v cancels one-time following", shard)
 			shard.oneTimeFollowCancel()
 		}
-		if !topology.IsShardInLocal(int(shard.id), int(shard.serverId), int(request.TargetClusterSize), shard.cluster.ReplicationFactor()) {
+		if !topology.IsVirtualShardInLocal(int(shard.id), int(shard.serverId), int(request.TargetClusterSize), shard.cluster.ShardCount(), shard.cluster.ReplicationFactor()) {
 			ss.shutdownShard(shard)
 		}
 	}
@@ -159,12 +164,17 @@ func (ss *storeServer) deleteOldShardsInNewCluster(ctx context.Context, request
 			ss.clusterListener.RemoveKeyspace(request.Keyspace)
 		} else {
 			for _, shard := range shards {
-				if !topology.IsShardInLocal(int(shard.id), int(shard.serverId), int(request.TargetClusterSize), shard.cluster.ReplicationFactor()) {
+				if !topology.IsVirtualShardInLocal(int(shard.id), int(shard.serverId), int(request.TargetClusterSize), shard.cluster.ShardCount(), shard.cluster.ReplicationFactor()) {
 					delete(localShardsStatus.ShardMap, uint32(shard.id))
+				} else {
+					// not committed if the resize is cancelled
+					shard.db.CompleteClusterResize()
 				}
 			}
 			localShardsStatus.ClusterSize = request.TargetClusterSize
 			ss.saveClusterConfig(localShardsStatus, request.Keyspace)
+			// preparing the resize has set the cluster to the new size
+			ss.clusterListener.GetOrSetCluster(request.Keyspace, int(request.TargetClusterSize), 0)
 		}
 	}
 
@@ -179,7 +189,7 @@ func (ss *storeServer) eachLocalShard(keyspace string, targetClusterSize int, ea
 	}
 
 	for _, shard := range shards {
-		if topology.IsShardInLocal(int(shard.id), int(shard.serverId), targetClusterSize, shard.cluster.ReplicationFactor()) {
+		if topology.IsVirtualShardInLocal(int(shard.id), int(shard.serverId), targetClusterSize, shard.cluster.ShardCount(), shard.cluster.ReplicationFactor()) {
 			eachFunc(shard)
 		}
 	}
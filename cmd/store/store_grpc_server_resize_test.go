@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/topology"
+	"github.com/chrislusf/vasto/topology/clusterlistener"
+	"github.com/chrislusf/vasto/util"
+)
+
+func newTestStoreServer(t *testing.T) (ss *storeServer, cleanup func()) {
+
+	dir, err := ioutil.TempDir("", "vasto_store")
+	if err != nil {
+		t.Fatalf("temp dir: %v", err)
+	}
+
+	host, tcpPort := "localhost", int32(0)
+	logFileSizeMb, logFileCount := 0, 0
+	ss = &storeServer{
+		option: &StoreOption{
+			Dir:           &dir,
+			Host:          &host,
+			TcpPort:       &tcpPort,
+			LogFileSizeMb: &logFileSizeMb,
+			LogFileCount:  &logFileCount,
+		},
+		clusterListener:    clusterlistener.NewClusterListener("[store@test]"),
+		ShardInfoChan:      make(chan *pb.ShardInfo, 100),
+		statusInCluster:    make(map[string]*pb.LocalShardsInCluster),
+		keyspaceShards:     newKeyspaceShards(),
+		storeName:          "[store@test]",
+		bootstrapThrottler: util.NewThrottler(0),
+	}
+
+	return ss, func() {
+		if shards, found := ss.keyspaceShards.getShards("ks"); found {
+			for _, shard := range shards {
+				ss.shutdownShard(shard)
+			}
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func drainShardInfos(ss *storeServer) (shardInfos []*pb.ShardInfo) {
+	for {
+		select {
+		case shardInfo := <-ss.ShardInfoChan:
+			shardInfos = append(shardInfos, shardInfo)
+		default:
+			return
+		}
+	}
+}
+
+func TestResizeCreateShardsCancelled(t *testing.T) {
+
+	ss, cleanup := newTestStoreServer(t)
+	defer cleanup()
+
+	// server 0 has shards 0 and 1 in a cluster of 2 servers
+	ss.clusterListener.AddExistingKeyspace("ks", 2, 2)
+	localShards := ss.getOrCreateServerStatusInCluster("ks", 0, 2, 0, 2)
+	for _, clusterShard := range topology.LocalVirtualShards(0, 2, 0, 2) {
+		shardInfo := &pb.ShardInfo{
+			KeyspaceName:      "ks",
+			ShardId:           uint32(clusterShard.ShardId),
+			ClusterSize:       2,
+			ReplicationFactor: 2,
+		}
+		if _, err := ss.openShard(shardInfo); err != nil {
+			t.Fatalf("open shard: %v", err)
+		}
+		localShards.ShardMap[shardInfo.ShardId] = shardInfo
+	}
+
+	// growing to 3 servers adds shard 2, but the resize is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := ss.resizeCreateShards(ctx, &pb.ResizeCreateShardRequest{
+		Keyspace:          "ks",
+		ServerId:          0,
+		ClusterSize:       2,
+		TargetClusterSize: 3,
+		ReplicationFactor: 2,
+	})
+	if err == nil {
+		t.Errorf("cancelled resize should fail")
+	}
+
+	if _, found := localShards.ShardMap[2]; found {
+		t.Errorf("cancelled shard 2 is added")
+	}
+	if shardInfos := drainShardInfos(ss); len(shardInfos) > 0 {
+		t.Errorf("cancelled resize reports shards %v", shardInfos)
+	}
+
+}
+
+func TestCleanupWaitsForBootstrap(t *testing.T) {
+
+	ss, cleanup := newTestStoreServer(t)
+	defer cleanup()
+
+	localShards := ss.getOrCreateServerStatusInCluster("ks", 0, 1, 0, 1)
+	shardInfo := &pb.ShardInfo{
+		KeyspaceName:      "ks",
+		ClusterSize:       1,
+		ReplicationFactor: 1,
+		IsCandidate:       true,
+	}
+	shard, err := ss.openShard(shardInfo)
+	if err != nil {
+		t.Fatalf("open shard: %v", err)
+	}
+
+	// a bootstrap still writing after being cancelled
+	bootstrapCtx, finishBootstrap, err := shard.startBootstrap(context.Background())
+	if err != nil {
+		t.Fatalf("start bootstrap: %v", err)
+	}
+	written := make(chan error, 1)
+	go func() {
+		defer finishBootstrap()
+		<-bootstrapCtx.Done()
+		time.Sleep(100 * time.Millisecond)
+		written <- shard.db.Put([]byte("k"), []byte("v"))
+	}()
+
+	ss.shutdownShard(shard)
+
+	select {
+	case err := <-written:
+		if err != nil {
+			t.Errorf("write during bootstrap: %v", err)
+		}
+	default:
+		t.Errorf("the db is closed before the bootstrap returns")
+	}
+
+	if _, _, err := shard.startBootstrap(context.Background()); err == nil {
+		t.Errorf("bootstrap starts on a deleted shard")
+	}
+	if err := ss.addShardInfo(context.Background(), shard, localShards, shardInfo, true); err == nil {
+		t.Errorf("deleted shard is added back")
+	}
+	if len(localShards.ShardMap) > 0 || len(drainShardInfos(ss)) > 0 {
+		t.Errorf("deleted shard is added back: %v", localShards.ShardMap)
+	}
+
+}
@@ -90,7 +90,7 @@ func (ss *storeServer) deleteServerStatusInCluster(keyspace string) {
 
 }
 
-func (ss *storeServer) getOrCreateServerStatusInCluster(keyspace string, serverId, clusterSize, replicationFactor int) *pb.LocalShardsInCluster {
+func (ss *storeServer) getOrCreateServerStatusInCluster(keyspace string, serverId, clusterSize, shardCount, replicationFactor int) *pb.LocalShardsInCluster {
 
 	ss.statusInClusterLock.Lock()
 	defer ss.statusInClusterLock.Unlock()
@@ -102,6 +102,7 @@ func (ss *storeServer) getOrCreateServerStatusInCluster(keyspace string, serverI
 			ShardMap:          make(map[uint32]*pb.ShardInfo),
 			ClusterSize:       uint32(clusterSize),
 			ReplicationFactor: uint32(replicationFactor),
+			ShardCount:        uint32(shardCount),
 		}
 	}
 
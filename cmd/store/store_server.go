@@ -16,19 +16,25 @@ import (
 
 // StoreOption has options to run a data store
 type StoreOption struct {
-	Dir               *string
-	Host              *string
-	ListenHost        *string
-	TcpPort           *int32
-	Bootstrap         *bool
-	DisableUnixSocket *bool
-	Master            *string
-	LogFileSizeMb     *int
-	LogFileCount      *int
-	DiskSizeGb        *int
-	Tags              *string
-	DisableUseEventIo *bool
-	DisableBinLog     *bool
+	Dir                *string
+	Host               *string
+	ListenHost         *string
+	TcpPort            *int32
+	Bootstrap          *bool
+	DisableUnixSocket  *bool
+	Master             *string
+	DataCenter         *string
+	LogFileSizeMb      *int
+	LogFileCount       *int
+	DiskSizeGb         *int
+	Tags               *string
+	Zone               *string
+	Rack               *string
+	DisableUseEventIo  *bool
+	DisableBinLog      *bool
+	MigrateEntryFormat *bool
+	MaxMessageSizeMb   *int
+	BootstrapThrottle  *int64
 }
 
 // GetAdminPort returns the admin port of the store, which is the data port plus 10000
@@ -42,9 +48,11 @@ type storeServer struct {
 	ShardInfoChan       chan *pb.ShardInfo
 	statusInCluster     map[string]*pb.LocalShardsInCluster // saved to disk
 	statusInClusterLock sync.RWMutex
+	shardsLock          sync.Mutex // adding created shards, and deleting them during cleanups
 	periodTasks         []periodicTask
 	keyspaceShards      *keyspaceShards
 	storeName           string
+	bootstrapThrottler  *util.Throttler
 }
 
 // RunStore starts a store process
@@ -53,7 +61,8 @@ func RunStore(option *StoreOption) {
 	storeName := fmt.Sprintf("[store@%s:%d]", *option.ListenHost, *option.TcpPort)
 
 	ctx := context.Background()
-	clusterListener := clusterlistener.NewClusterListener(storeName)
+	clusterListener := clusterlistener.NewClusterListenerInDataCenter(*option.DataCenter, storeName)
+	clusterListener.SetDataCenterFallback(false)
 
 	var ss = &storeServer{
 		option:          option,
@@ -63,6 +72,11 @@ func RunStore(option *StoreOption) {
 		keyspaceShards:  newKeyspaceShards(),
 		storeName:       storeName,
 	}
+	var bootstrapBytesPerSecond int64
+	if option.BootstrapThrottle != nil {
+		bootstrapBytesPerSecond = *option.BootstrapThrottle
+	}
+	ss.bootstrapThrottler = util.NewThrottler(bootstrapBytesPerSecond)
 	go ss.startPeriodTasks()
 
 	// ss.clusterListener.RegisterShardEventProcessor(&clusterlistener.ClusterEventLogger{})
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"time"
 
@@ -59,7 +60,7 @@ func (ss *storeServer) handleRequest(reader io.Reader, writer io.Writer) error {
 	var input, output []byte
 	var err error
 
-	input, err = util.ReadMessage(reader)
+	input, err = util.ReadMessageWithLimit(reader, maxMessageSize(*ss.option.MaxMessageSizeMb))
 
 	if err == io.EOF {
 		return err
@@ -141,6 +142,48 @@ func (ss *storeServer) processRequest(keyspace string, command *pb.Request) *pb.
 					Status: fmt.Sprintf("keyspace %s not found", keyspace),
 				},
 			}
+		} else if command.GetScan() != nil {
+			return &pb.Response{
+				Scan: &pb.ScanResponse{
+					Ok:     false,
+					Status: fmt.Sprintf("keyspace %s not found", keyspace),
+				},
+			}
+		} else if command.GetAggregate() != nil {
+			return &pb.Response{
+				Aggregate: &pb.AggregateResponse{
+					Ok:     false,
+					Status: fmt.Sprintf("keyspace %s not found", keyspace),
+				},
+			}
+		} else if command.GetIndexLookup() != nil {
+			return &pb.Response{
+				IndexLookup: &pb.IndexLookupResponse{
+					Ok:     false,
+					Status: fmt.Sprintf("keyspace %s not found", keyspace),
+				},
+			}
+		} else if command.GetSortedSet() != nil {
+			return &pb.Response{
+				SortedSet: &pb.SortedSetResponse{
+					Ok:     false,
+					Status: fmt.Sprintf("keyspace %s not found", keyspace),
+				},
+			}
+		} else if command.GetTimeSeries() != nil {
+			return &pb.Response{
+				TimeSeries: &pb.TimeSeriesResponse{
+					Ok:     false,
+					Status: fmt.Sprintf("keyspace %s not found", keyspace),
+				},
+			}
+		} else if command.GetTtl() != nil {
+			return &pb.Response{
+				Ttl: &pb.TtlResponse{
+					Ok:     false,
+					Status: fmt.Sprintf("keyspace %s not found", keyspace),
+				},
+			}
 		}
 	}
 
@@ -164,6 +207,30 @@ func (ss *storeServer) processRequest(keyspace string, command *pb.Request) *pb.
 		return &pb.Response{
 			GetByPrefix: ss.processPrefix(shard, command.GetByPrefix),
 		}
+	} else if command.GetScan() != nil {
+		return &pb.Response{
+			Scan: ss.processScan(shard, command.Scan),
+		}
+	} else if command.GetAggregate() != nil {
+		return &pb.Response{
+			Aggregate: ss.processAggregate(shard, command.Aggregate),
+		}
+	} else if command.GetIndexLookup() != nil {
+		return &pb.Response{
+			IndexLookup: ss.processIndexLookup(shard, command.IndexLookup),
+		}
+	} else if command.GetSortedSet() != nil {
+		return &pb.Response{
+			SortedSet: ss.processSortedSet(shard, command.SortedSet),
+		}
+	} else if command.GetTimeSeries() != nil {
+		return &pb.Response{
+			TimeSeries: ss.processTimeSeries(shard, command.TimeSeries),
+		}
+	} else if command.GetTtl() != nil {
+		return &pb.Response{
+			Ttl: ss.processTtl(shard, command.Ttl),
+		}
 	}
 	return &pb.Response{
 		Write: &pb.WriteResponse{
@@ -171,3 +238,13 @@ func (ss *storeServer) processRequest(keyspace string, command *pb.Request) *pb.
 		},
 	}
 }
+
+// maxMessageSize converts the limit in MB to bytes, 0 for no limit.
+// The message length is an int32, so any limit beyond it is the same as no limit.
+func maxMessageSize(maxMessageSizeMb int) int32 {
+	size := int64(maxMessageSizeMb) * 1024 * 1024
+	if size <= 0 || size > math.MaxInt32 {
+		return 0
+	}
+	return int32(size)
+}
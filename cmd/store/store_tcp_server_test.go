@@ -0,0 +1,26 @@
+package store
+
+import "testing"
+
+func TestMaxMessageSize(t *testing.T) {
+
+	tests := []struct {
+		maxMessageSizeMb int
+		expected         int32
+	}{
+		{64, 64 * 1024 * 1024},
+		{0, 0},
+		{-1, 0},
+		{2047, 2047 * 1024 * 1024},
+		// beyond int32, used to overflow into a negative limit
+		{2048, 0},
+		{4096, 0},
+	}
+
+	for _, test := range tests {
+		if size := maxMessageSize(test.maxMessageSizeMb); size != test.expected {
+			t.Errorf("max message size of %d MB is %d, expecting %d", test.maxMessageSizeMb, size, test.expected)
+		}
+	}
+
+}
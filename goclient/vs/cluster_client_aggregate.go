@@ -0,0 +1,120 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// AggregateResult is the aggregation of the entries under a prefix.
+// Sum, Min and Max only cover the Float64Count entries with float64 values.
+type AggregateResult struct {
+	Count        uint64
+	Float64Count uint64
+	Sum          float64
+	Min          float64
+	Max          float64
+}
+
+// Avg returns the average of the float64 values, or 0 if there are none.
+func (r *AggregateResult) Avg() float64 {
+	if r.Float64Count == 0 {
+		return 0
+	}
+	return r.Sum / float64(r.Float64Count)
+}
+
+func (r *AggregateResult) merge(resp *pb.AggregateResponse) {
+	r.Count += resp.Count
+	if resp.Float64Count == 0 {
+		return
+	}
+	if r.Float64Count == 0 || resp.Min < r.Min {
+		r.Min = resp.Min
+	}
+	if r.Float64Count == 0 || resp.Max > r.Max {
+		r.Max = resp.Max
+	}
+	r.Sum += resp.Sum
+	r.Float64Count += resp.Float64Count
+}
+
+// Aggregate counts the entries keyed by the prefix from all partitions, and computes the sum, min and max
+// of the float64 values. Each shard aggregates locally and only the partial results are merged here.
+// filter: optional conditions on the entries to aggregate
+func (c *ClusterClient) Aggregate(prefix []byte, filter *pb.ScanFilter) (*AggregateResult, error) {
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateRequest := &pb.AggregateRequest{
+		Prefix: prefix,
+		Filter: filter,
+	}
+
+	result := &AggregateResult{}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var aggregateErr error
+	for i := 0; i < cluster.LogicalShardCount(); i++ {
+		wg.Add(1)
+		go func(shardId int) {
+			defer wg.Done()
+			resp, err := c.aggregateOnSingleShard(shardId, aggregateRequest)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				aggregateErr = err
+				return
+			}
+			result.merge(resp)
+		}(i)
+	}
+	wg.Wait()
+
+	if aggregateErr != nil {
+		return nil, aggregateErr
+	}
+	return result, nil
+}
+
+// AggregateByPartition is the same as Aggregate, but limited to the shard of the partition key.
+func (c *ClusterClient) AggregateByPartition(partitionKey, prefix []byte, filter *pb.ScanFilter) (*AggregateResult, error) {
+
+	aggregateRequest := &pb.AggregateRequest{
+		Prefix: prefix,
+		Filter: filter,
+	}
+
+	shardId, _ := c.ClusterListener.GetShardId(c.keyspace, partitionKey)
+	resp, err := c.aggregateOnSingleShard(shardId, aggregateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AggregateResult{}
+	result.merge(resp)
+	return result, nil
+}
+
+func (c *ClusterClient) aggregateOnSingleShard(shardId int, aggregateRequest *pb.AggregateRequest) (*pb.AggregateResponse, error) {
+
+	responses, err := c.sendRequestsToOneShard(shardId, []*pb.Request{{
+		ShardId:   uint32(shardId),
+		Aggregate: aggregateRequest,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) != 1 || responses[0].Aggregate == nil {
+		return nil, fmt.Errorf("shard %d: unexpected aggregate response", shardId)
+	}
+	resp := responses[0].Aggregate
+	if !resp.Ok {
+		return nil, fmt.Errorf("shard %d aggregate: %v", shardId, errors.New(resp.Status))
+	}
+	return resp, nil
+}
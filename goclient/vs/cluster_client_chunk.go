@@ -0,0 +1,187 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// DefaultChunkSize is the default value size limit. Larger values are split into chunks of this size.
+const DefaultChunkSize = 1024 * 1024
+
+var (
+	// ErrorChunkMismatch error when the chunks do not add up to the value described by the manifest
+	ErrorChunkMismatch = errors.New("chunks do not match the manifest")
+)
+
+func (c *ClusterClient) chunkSize() int {
+	if c.ChunkSizeByte > 0 {
+		return c.ChunkSizeByte
+	}
+	return DefaultChunkSize
+}
+
+// putChunked writes the chunks of a large value first, and then the manifest under the key.
+// The chunks are keyed by the manifest version, so readers only see the new value
+// after the manifest is written. The store deletes the chunks of the replaced manifest.
+// The chunks have the same ttl as the manifest, so they expire together.
+// If any write fails, the chunks already written are deleted,
+// and the stores also delete the chunks left without a manifest.
+func (c *ClusterClient) putChunked(key *KeyObject, value []byte) error {
+
+	updatedAtNs := c.UpdatedAtNs
+	if updatedAtNs == 0 {
+		updatedAtNs = uint64(time.Now().UnixNano())
+	}
+
+	chunkSize := c.chunkSize()
+	manifest := &pb.ChunkManifest{
+		Version:    updatedAtNs,
+		ChunkCount: uint32((len(value) + chunkSize - 1) / chunkSize),
+		TotalSize:  uint64(len(value)),
+		Checksum:   crc32.ChecksumIEEE(value),
+	}
+
+	for i := 0; i < int(manifest.ChunkCount); i++ {
+		start, stop := i*chunkSize, (i+1)*chunkSize
+		if stop > len(value) {
+			stop = len(value)
+		}
+		// one chunk for each message, to stay below the message size limit
+		err := c.BatchProcess([]*pb.Request{{
+			Put: &pb.PutRequest{
+				Key:           manifest.ChunkKey(key.GetKey(), i),
+				PartitionHash: key.GetPartitionHash(),
+				UpdatedAtNs:   updatedAtNs,
+				TtlSecond:     c.TtlSecond,
+				OpAndDataType: pb.OpAndDataType_BYTES,
+				Value:         value[start:stop],
+			},
+		}}, checkWriteResponses)
+		if err != nil {
+			c.deleteChunks(key, manifest, i, updatedAtNs)
+			return fmt.Errorf("put chunk %d/%d: %v", i, manifest.ChunkCount, err)
+		}
+	}
+
+	manifestBytes, err := proto.Marshal(manifest)
+	if err != nil {
+		c.deleteChunks(key, manifest, int(manifest.ChunkCount), updatedAtNs)
+		return fmt.Errorf("marshal chunk manifest: %v", err)
+	}
+
+	err = c.BatchProcess([]*pb.Request{{
+		Put: &pb.PutRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   updatedAtNs,
+			TtlSecond:     c.TtlSecond,
+			OpAndDataType: pb.OpAndDataType_CHUNK_MANIFEST,
+			Value:         manifestBytes,
+		},
+	}}, checkWriteResponses)
+	if err != nil {
+		c.deleteChunks(key, manifest, int(manifest.ChunkCount), updatedAtNs)
+		return fmt.Errorf("put chunk manifest: %v", err)
+	}
+
+	return nil
+}
+
+// deleteChunks deletes the first chunkCount chunks of a manifest which is not written.
+// The deletes are best effort, since the put already fails.
+func (c *ClusterClient) deleteChunks(key *KeyObject, manifest *pb.ChunkManifest, chunkCount int, updatedAtNs uint64) {
+
+	if chunkCount == 0 {
+		return
+	}
+
+	var requests []*pb.Request
+	for i := 0; i < chunkCount; i++ {
+		requests = append(requests, &pb.Request{
+			Delete: &pb.DeleteRequest{
+				Key:           manifest.ChunkKey(key.GetKey(), i),
+				PartitionHash: key.GetPartitionHash(),
+				UpdatedAtNs:   updatedAtNs,
+			},
+		})
+	}
+
+	c.BatchProcess(requests, checkWriteResponses)
+}
+
+// getChunked reads back all the chunks described by the manifest, and joins them into the original value.
+func (c *ClusterClient) getChunked(key *KeyObject, manifestBytes []byte) ([]byte, error) {
+
+	manifest := &pb.ChunkManifest{}
+	if err := proto.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal chunk manifest: %v", err)
+	}
+
+	value := make([]byte, 0, manifest.TotalSize)
+	for i := 0; i < int(manifest.ChunkCount); i++ {
+		var chunk []byte
+		err := c.BatchProcess([]*pb.Request{{
+			Get: &pb.GetRequest{
+				Key:           manifest.ChunkKey(key.GetKey(), i),
+				PartitionHash: key.GetPartitionHash(),
+			},
+		}}, func(responses []*pb.Response, err error) error {
+			if err != nil {
+				return err
+			}
+			if len(responses) == 0 || responses[0].Get.KeyValue == nil {
+				return ErrorChunkMismatch
+			}
+			if responses[0].Get.Status != "" {
+				return errors.New(responses[0].Get.Status)
+			}
+			chunk = responses[0].Get.KeyValue.Value
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get chunk %d/%d: %v", i, manifest.ChunkCount, err)
+		}
+		value = append(value, chunk...)
+	}
+
+	if uint64(len(value)) != manifest.TotalSize || crc32.ChecksumIEEE(value) != manifest.Checksum {
+		return nil, ErrorChunkMismatch
+	}
+
+	return value, nil
+}
+
+// resolveChunked replaces the manifest in the key value with the original large value.
+func (c *ClusterClient) resolveChunked(kv *KeyValue) error {
+	if kv.GetValueType() != pb.OpAndDataType_CHUNK_MANIFEST {
+		return nil
+	}
+	if len(kv.GetValue()) == 0 {
+		// keys only, no manifest to resolve
+		kv.ValueObject = BytesValue(nil)
+		return nil
+	}
+	value, err := c.getChunked(kv.KeyObject, kv.GetValue())
+	if err != nil {
+		return err
+	}
+	kv.ValueObject = BytesValue(value)
+	return nil
+}
+
+func checkWriteResponses(responses []*pb.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	for _, response := range responses {
+		if response.Write != nil && !response.Write.Ok {
+			return errors.New(response.Write.Status)
+		}
+	}
+	return nil
+}
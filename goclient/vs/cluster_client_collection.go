@@ -0,0 +1,90 @@
+package vs
+
+import (
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// SetAdd adds the members to the set stored under the key
+func (c *ClusterClient) SetAdd(key *KeyObject, members ...[]byte) error {
+	return c.mergeCollection(key, pb.OpAndDataType_SET, codec.EncodeSet(members, nil))
+}
+
+// SetRemove removes the members from the set stored under the key
+func (c *ClusterClient) SetRemove(key *KeyObject, members ...[]byte) error {
+	return c.mergeCollection(key, pb.OpAndDataType_SET, codec.EncodeSet(nil, members))
+}
+
+// SetMembers returns the members of the set stored under the key, sorted in byte order.
+func (c *ClusterClient) SetMembers(key *KeyObject) ([][]byte, error) {
+	value, err := c.getCollection(key, pb.OpAndDataType_SET)
+	if err != nil {
+		return nil, err
+	}
+	members, _, err := codec.DecodeSet(value)
+	if err != nil {
+		return nil, fmt.Errorf("decode set: %v", err)
+	}
+	return members, nil
+}
+
+// ListPush appends the items to the list stored under the key, and only keeps the last capacity items.
+// 0 capacity means the list is not bounded.
+func (c *ClusterClient) ListPush(key *KeyObject, capacity int, items ...[]byte) error {
+	return c.mergeCollection(key, pb.OpAndDataType_LIST, codec.EncodeList(uint32(capacity), items))
+}
+
+// ListRange returns the items of the list stored under the key, oldest first.
+// offset: number of items to skip
+// limit: number of items to return, 0 means all
+func (c *ClusterClient) ListRange(key *KeyObject, offset, limit int) ([][]byte, error) {
+	value, err := c.getCollection(key, pb.OpAndDataType_LIST)
+	if err != nil {
+		return nil, err
+	}
+	_, items, err := codec.DecodeList(value)
+	if err != nil {
+		return nil, fmt.Errorf("decode list: %v", err)
+	}
+	if offset >= len(items) {
+		return nil, nil
+	}
+	items = items[offset:]
+	if limit > 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+func (c *ClusterClient) mergeCollection(key *KeyObject, dataType pb.OpAndDataType, value []byte) error {
+
+	var requests []*pb.Request
+	request := &pb.Request{
+		Merge: &pb.MergeRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			OpAndDataType: dataType,
+			Value:         value,
+		},
+	}
+	requests = append(requests, request)
+
+	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
+		return err
+	})
+}
+
+func (c *ClusterClient) getCollection(key *KeyObject, dataType pb.OpAndDataType) ([]byte, error) {
+
+	value, valueType, err := c.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if valueType != dataType {
+		return nil, ErrorWrongDataFormat
+	}
+	return value, nil
+}
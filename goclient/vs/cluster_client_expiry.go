@@ -0,0 +1,91 @@
+package vs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"google.golang.org/grpc"
+)
+
+// ExpiryEvent is sent when a key expires
+type ExpiryEvent struct {
+	Key       []byte
+	ExpiredAt time.Time
+}
+
+// SubscribeExpiry calls fn for each key with the prefix expired in this keyspace.
+// Each store notifies the expired keys in the order of expiry time, about one second after the expiry.
+// The events are delivered at most once. Events are dropped if fn falls behind.
+// fn is called concurrently for different shards.
+// It blocks until the ctx is cancelled, or the connection to any shard fails.
+func (c *ClusterClient) SubscribeExpiry(ctx context.Context, prefix []byte, fn func(event *ExpiryEvent)) error {
+
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errChan := make(chan error, cluster.LogicalShardCount())
+	for shardId := 0; shardId < cluster.LogicalShardCount(); shardId++ {
+		node, found := cluster.GetNode(shardId, 0)
+		if !found {
+			return fmt.Errorf("shard %d not found", shardId)
+		}
+		wg.Add(1)
+		go func(shardId int, node *pb.ClusterNode) {
+			defer wg.Done()
+			if err := c.subscribeShardExpiry(ctx, shardId, node, prefix, fn); err != nil {
+				errChan <- fmt.Errorf("subscribe expiry of shard %d: %v", shardId, err)
+				cancel()
+			}
+		}(shardId, node)
+	}
+	wg.Wait()
+
+	select {
+	case err = <-errChan:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+func (c *ClusterClient) subscribeShardExpiry(ctx context.Context, shardId int, node *pb.ClusterNode, prefix []byte, fn func(event *ExpiryEvent)) error {
+
+	grpcConnection, err := grpc.Dial(node.StoreResource.AdminAddress, grpc.WithInsecure())
+	if err != nil {
+		return fmt.Errorf("dial %s: %v", node.StoreResource.AdminAddress, err)
+	}
+	defer grpcConnection.Close()
+
+	stream, err := pb.NewVastoStoreClient(grpcConnection).SubscribeExpiry(ctx, &pb.SubscribeExpiryRequest{
+		Keyspace: c.keyspace,
+		ShardId:  uint32(shardId),
+		Prefix:   prefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fn(&ExpiryEvent{
+			Key:       event.Key,
+			ExpiredAt: time.Unix(event.ExpiredAtSecond, 0),
+		})
+	}
+}
@@ -49,5 +49,13 @@ func (c *ClusterClient) Get(key *KeyObject) ([]byte, pb.OpAndDataType, error) {
 		return nil, pb.OpAndDataType_BYTES, ErrorNotFound
 	}
 
+	if kv.DataType == pb.OpAndDataType_CHUNK_MANIFEST {
+		value, err := c.getChunked(key, kv.Value)
+		if err != nil {
+			return nil, pb.OpAndDataType_BYTES, fmt.Errorf("get error: %v", err)
+		}
+		return value, pb.OpAndDataType_BYTES, nil
+	}
+
 	return kv.Value, kv.DataType, nil
 }
@@ -51,5 +51,11 @@ func (c *ClusterClient) BatchGet(keys []*KeyObject) (ret []*KeyValue, err error)
 		ret = append(ret, ans.keyValues...)
 	}
 
+	for _, kv := range ret {
+		if err = c.resolveChunked(kv); err != nil {
+			return nil, err
+		}
+	}
+
 	return ret, err
 }
@@ -36,15 +36,43 @@ func (c *ClusterClient) CollectByPrefix(prefix []byte, limit uint32, lastSeenKey
 	return c.broadcastEachShard(prefixRequest)
 }
 
+// GetByPrefixWithFilter is the same as GetByPrefix, but only returns the entries matching the filter.
+// The filter is evaluated on the store, and only the matching entries count toward the limit.
+func (c *ClusterClient) GetByPrefixWithFilter(partitionKey, prefix []byte, limit uint32, lastSeenKey []byte, filter *pb.ScanFilter) ([]*KeyValue, error) {
+
+	prefixRequest := &pb.GetByPrefixRequest{
+		Prefix:      prefix,
+		Limit:       limit,
+		LastSeenKey: lastSeenKey,
+		Filter:      filter,
+	}
+
+	shardId, _ := c.ClusterListener.GetShardId(c.keyspace, partitionKey)
+	return c.prefixQueryToSingleShard(shardId, prefixRequest)
+}
+
+// CollectByPrefixWithFilter is the same as CollectByPrefix, but only returns the entries matching the filter.
+func (c *ClusterClient) CollectByPrefixWithFilter(prefix []byte, limit uint32, lastSeenKey []byte, filter *pb.ScanFilter) ([]*KeyValue, error) {
+
+	prefixRequest := &pb.GetByPrefixRequest{
+		Prefix:      prefix,
+		Limit:       limit,
+		LastSeenKey: lastSeenKey,
+		Filter:      filter,
+	}
+
+	return c.broadcastEachShard(prefixRequest)
+}
+
 func (c *ClusterClient) broadcastEachShard(prefixRequest *pb.GetByPrefixRequest) (results []*KeyValue, broadcastErr error) {
 	cluster, err := c.GetCluster()
 	if err != nil {
 		return nil, err
 	}
 
-	chans := make([]chan *KeyValue, 16*cluster.ExpectedSize())
+	chans := make([]chan *KeyValue, 16*cluster.LogicalShardCount())
 
-	for i := 0; i < cluster.ExpectedSize(); i++ {
+	for i := 0; i < cluster.LogicalShardCount(); i++ {
 
 		shardId := i
 		chans[shardId] = make(chan *KeyValue)
@@ -82,6 +110,9 @@ func (c *ClusterClient) prefixQueryToSingleShard(shardId int, prefixRequest *pb.
 	if len(responses) == 1 {
 		for _, keyValue := range responses[0].GetByPrefix.KeyValues {
 			kv := fromPbKeyTypeValue(keyValue)
+			if err = c.resolveChunked(kv); err != nil {
+				return nil, err
+			}
 			results = append(results, kv)
 		}
 	}
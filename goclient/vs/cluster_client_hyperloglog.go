@@ -0,0 +1,74 @@
+package vs
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// PFAdd adds the elements to the HyperLogLog sketch stored under the key.
+// Only the sketch registers changed by the elements are sent to the store.
+func (c *ClusterClient) PFAdd(key *KeyObject, elements ...[]byte) error {
+
+	h := codec.NewHyperLogLog()
+	for _, element := range elements {
+		h.Add(element)
+	}
+
+	return c.mergeCollection(key, pb.OpAndDataType_HYPERLOGLOG, h.Bytes())
+}
+
+// PFCount returns the estimated number of distinct elements added to the keys.
+// For multiple keys, the sketches are read from all the partitions and unioned here.
+// Missing keys count as empty sketches.
+func (c *ClusterClient) PFCount(keys ...*KeyObject) (uint64, error) {
+
+	var requests []*pb.Request
+	for _, key := range keys {
+		requests = append(requests, &pb.Request{
+			Get: &pb.GetRequest{
+				Key:           key.GetKey(),
+				PartitionHash: key.GetPartitionHash(),
+			},
+		})
+	}
+
+	union := codec.NewHyperLogLog()
+	var lock sync.Mutex
+	err := c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		for _, response := range responses {
+			if response.Get == nil {
+				return fmt.Errorf("unexpected get response")
+			}
+			if response.Get.Status != "" {
+				return fmt.Errorf(response.Get.Status)
+			}
+			kv := response.Get.KeyValue
+			if kv == nil {
+				continue
+			}
+			if kv.DataType != pb.OpAndDataType_HYPERLOGLOG {
+				return ErrorWrongDataFormat
+			}
+			h, err := codec.DecodeHyperLogLog(kv.Value)
+			if err != nil {
+				return fmt.Errorf("decode %s: %v", string(kv.Key), err)
+			}
+			union.Merge(h)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("pfcount error: %v", err)
+	}
+
+	return union.Count(), nil
+}
@@ -0,0 +1,42 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// LookupByIndex lists the primary keys having the index term, within the partition of the partitionKey.
+// The index entries are kept on the same shard as the indexed entries.
+// indexName: the secondary index defined by VastoClient.DefineIndex
+// term: the value extracted by the index definition
+// limit: number of keys to return
+// lastSeenKey: the last primary key seen during pagination
+func (c *ClusterClient) LookupByIndex(partitionKey []byte, indexName string, term []byte, limit uint32, lastSeenKey []byte) ([][]byte, error) {
+
+	shardId, partitionHash := c.ClusterListener.GetShardId(c.keyspace, partitionKey)
+
+	responses, err := c.sendRequestsToOneShard(shardId, []*pb.Request{{
+		ShardId: uint32(shardId),
+		IndexLookup: &pb.IndexLookupRequest{
+			IndexName:     indexName,
+			Term:          term,
+			Limit:         limit,
+			LastSeenKey:   lastSeenKey,
+			PartitionHash: partitionHash,
+		},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) != 1 || responses[0].IndexLookup == nil {
+		return nil, fmt.Errorf("shard %d: unexpected index lookup response", shardId)
+	}
+	response := responses[0].IndexLookup
+	if !response.Ok {
+		return nil, fmt.Errorf("shard %d index lookup: %v", shardId, errors.New(response.Status))
+	}
+
+	return response.Keys, nil
+}
@@ -0,0 +1,134 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/util"
+)
+
+// GetInt64 get the int64 value by the key
+// The value could have been set by PutInt64, IncrementInt64, DecrementInt64, PutMaxInt64, or PutMinInt64.
+func (c *ClusterClient) GetInt64(key *KeyObject) (int64, error) {
+
+	request := &pb.Request{
+		Get: &pb.GetRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+		},
+	}
+
+	var response *pb.Response
+	err := c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 {
+			return ErrorNotFound
+		}
+		response = responses[0]
+		return nil
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("get int64 error: %v", err)
+	}
+
+	if response.Get.Status != "" {
+		return 0, fmt.Errorf(response.Get.Status)
+	}
+
+	kv := response.Get.KeyValue
+	if kv == nil {
+		return 0, ErrorNotFound
+	}
+
+	if len(kv.Value) != 8 {
+		return 0, ErrorWrongDataFormat
+	}
+
+	return util.BytesToInt64(kv.Value), nil
+
+}
+
+// PutInt64 sets an int64 value to the key
+func (c *ClusterClient) PutInt64(key *KeyObject, value int64) error {
+
+	var requests []*pb.Request
+	request := &pb.Request{
+		Put: &pb.PutRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			TtlSecond:     c.TtlSecond,
+			OpAndDataType: pb.OpAndDataType_INT64,
+			Value:         util.Int64ToBytes(value),
+		},
+	}
+	requests = append(requests, request)
+
+	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
+		return err
+	})
+}
+
+// IncrementInt64 atomically adds the delta to the int64 value of the key, and returns the value after the increment.
+// A missing key starts from 0.
+func (c *ClusterClient) IncrementInt64(key *KeyObject, delta int64) (int64, error) {
+	return c.mergeInt64(key, pb.OpAndDataType_INT64, delta)
+}
+
+// DecrementInt64 atomically subtracts the delta from the int64 value of the key, and returns the value after the decrement.
+func (c *ClusterClient) DecrementInt64(key *KeyObject, delta int64) (int64, error) {
+	return c.mergeInt64(key, pb.OpAndDataType_INT64, -delta)
+}
+
+// PutMaxInt64 keeps the maximum of all int64 values put to the key, and returns the current maximum.
+func (c *ClusterClient) PutMaxInt64(key *KeyObject, value int64) (int64, error) {
+	return c.mergeInt64(key, pb.OpAndDataType_MAX_INT64, value)
+}
+
+// PutMinInt64 keeps the minimum of all int64 values put to the key, and returns the current minimum.
+func (c *ClusterClient) PutMinInt64(key *KeyObject, value int64) (int64, error) {
+	return c.mergeInt64(key, pb.OpAndDataType_MIN_INT64, value)
+}
+
+func (c *ClusterClient) mergeInt64(key *KeyObject, dataType pb.OpAndDataType, value int64) (int64, error) {
+
+	request := &pb.Request{
+		Merge: &pb.MergeRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			OpAndDataType: dataType,
+			Value:         util.Int64ToBytes(value),
+		},
+	}
+
+	var response *pb.WriteResponse
+	err := c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 || responses[0].Write == nil {
+			return fmt.Errorf("unexpected merge response")
+		}
+		response = responses[0].Write
+		return nil
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("merge int64 error: %v", err)
+	}
+
+	if !response.Ok {
+		return 0, errors.New(response.Status)
+	}
+
+	if response.KeyValue == nil || len(response.KeyValue.Value) != 8 {
+		return 0, ErrorWrongDataFormat
+	}
+
+	return util.BytesToInt64(response.KeyValue.Value), nil
+
+}
@@ -0,0 +1,49 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// MergeWithFunction merges the operand into the value of the key, by the merge function
+// registered in the stores with codec.RegisterMergeFunction.
+// An unknown merge function name is rejected by the store.
+func (c *ClusterClient) MergeWithFunction(key *KeyObject, mergeFunction string, operand []byte) error {
+
+	request := &pb.Request{
+		Merge: &pb.MergeRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			UpdatedAtNs:   c.UpdatedAtNs,
+			OpAndDataType: pb.OpAndDataType_NAMED_MERGE,
+			Value:         operand,
+			MergeFunction: mergeFunction,
+		},
+	}
+
+	return c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 || responses[0].Write == nil {
+			return fmt.Errorf("unexpected merge response")
+		}
+		if !responses[0].Write.Ok {
+			return errors.New(responses[0].Write.Status)
+		}
+		return nil
+	})
+}
+
+// GetMerged returns the value merged by the merge function, and the name of the merge function.
+func (c *ClusterClient) GetMerged(key *KeyObject) (mergeFunction string, value []byte, err error) {
+
+	value, err = c.getCollection(key, pb.OpAndDataType_NAMED_MERGE)
+	if err != nil {
+		return "", nil, err
+	}
+	return codec.DecodeNamedMerge(value)
+}
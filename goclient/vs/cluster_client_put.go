@@ -8,6 +8,10 @@ import (
 // Put puts one key value pair to one partition
 func (c *ClusterClient) Put(key *KeyObject, value []byte) error {
 
+	if len(value) > c.chunkSize() {
+		return c.putChunked(key, value)
+	}
+
 	var requests []*pb.Request
 	request := &pb.Request{
 		Put: &pb.PutRequest{
@@ -21,9 +25,7 @@ func (c *ClusterClient) Put(key *KeyObject, value []byte) error {
 	}
 	requests = append(requests, request)
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	return c.BatchProcess(requests, checkWriteResponses)
 }
 
 // Append appends []byte to existing value
@@ -61,6 +63,12 @@ func (c *ClusterClient) BatchPut(rows []*KeyValue) error {
 
 	var requests []*pb.Request
 	for _, row := range rows {
+		if len(row.GetValue()) > c.chunkSize() {
+			if err := c.putChunked(row.KeyObject, row.GetValue()); err != nil {
+				return err
+			}
+			continue
+		}
 		request := &pb.Request{
 			Put: &pb.PutRequest{
 				Key:           row.KeyObject.GetKey(),
@@ -74,7 +82,9 @@ func (c *ClusterClient) BatchPut(rows []*KeyValue) error {
 		requests = append(requests, request)
 	}
 
-	return c.BatchProcess(requests, func(responses []*pb.Response, err error) error {
-		return err
-	})
+	if len(requests) == 0 {
+		return nil
+	}
+
+	return c.BatchProcess(requests, checkWriteResponses)
 }
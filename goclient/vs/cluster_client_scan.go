@@ -0,0 +1,202 @@
+package vs
+
+import (
+	"bytes"
+	"container/heap"
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// DefaultScanBatchSize is the default number of entries fetched from each shard per request.
+const DefaultScanBatchSize = 256
+
+// ScanOption defines the key range and the order of a scan.
+type ScanOption struct {
+	StartKey       []byte         // the first key to scan. Empty means from the first key.
+	StartExclusive bool           // whether to skip the StartKey itself
+	EndKey         []byte         // the last key to scan. Empty means till the last key.
+	EndInclusive   bool           // whether to include the EndKey itself
+	Reverse        bool           // scan from EndKey to StartKey
+	BatchSize      uint32         // number of entries fetched from each shard per request. 0 means DefaultScanBatchSize.
+	Filter         *pb.ScanFilter // optional conditions evaluated on the stores. Only matching entries are returned.
+}
+
+// ScanIterator iterates through the entries in the key range, merge sorted across all shards.
+//
+//	it, err := c.Scan(&vs.ScanOption{StartKey: start, EndKey: end})
+//	for it.Next() {
+//		kv := it.KeyValue()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type ScanIterator struct {
+	client       *ClusterClient
+	option       ScanOption
+	sendRequests func(shardId int, requests []*pb.Request) ([]*pb.Response, error)
+	cursors []*shardCursor
+	pq      *scanQueue
+	current *KeyValue
+	err     error
+	started bool
+}
+
+// shardCursor tracks the scan progress on one shard.
+// lastKey is the continuation token for the next page from this shard.
+type shardCursor struct {
+	shardId  int
+	lastKey  []byte
+	buffered []*KeyValue
+	hasMore  bool
+}
+
+// Scan creates an iterator to go through all entries in the key range.
+func (c *ClusterClient) Scan(option *ScanOption) (*ScanIterator, error) {
+	cluster, err := c.GetCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	return newScanIterator(c, option, cluster.LogicalShardCount(), c.sendRequestsToOneShard), nil
+}
+
+// newScanIterator creates an iterator over the shards, which receive the scan requests through sendRequests.
+func newScanIterator(c *ClusterClient, option *ScanOption, shardCount int,
+	sendRequests func(shardId int, requests []*pb.Request) ([]*pb.Response, error)) *ScanIterator {
+
+	it := &ScanIterator{
+		client:       c,
+		option:       *option,
+		sendRequests: sendRequests,
+		pq:           &scanQueue{reverse: option.Reverse},
+	}
+	if it.option.BatchSize == 0 {
+		it.option.BatchSize = DefaultScanBatchSize
+	}
+	for i := 0; i < shardCount; i++ {
+		it.cursors = append(it.cursors, &shardCursor{shardId: i, hasMore: true})
+	}
+
+	return it
+}
+
+// Next moves to the next entry. It returns false when there are no more entries or an error happened.
+func (it *ScanIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		for i := range it.cursors {
+			if !it.advance(i) {
+				return false
+			}
+		}
+	}
+
+	if it.pq.Len() == 0 {
+		it.current = nil
+		return false
+	}
+
+	t := heap.Pop(it.pq).(*typeItem)
+	it.current = t.KeyValue
+
+	return it.advance(t.chanIndex)
+}
+
+// KeyValue returns the current entry.
+func (it *ScanIterator) KeyValue() *KeyValue {
+	return it.current
+}
+
+// Err returns the error stopping the iteration, if any.
+func (it *ScanIterator) Err() error {
+	return it.err
+}
+
+// advance pushes the next entry of the shard cursor into the queue, fetching the next page if needed.
+func (it *ScanIterator) advance(cursorIndex int) bool {
+	cursor := it.cursors[cursorIndex]
+	if len(cursor.buffered) == 0 && cursor.hasMore {
+		if err := it.fetch(cursor); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	if len(cursor.buffered) == 0 {
+		return true
+	}
+	heap.Push(it.pq, &typeItem{
+		KeyValue:  cursor.buffered[0],
+		chanIndex: cursorIndex,
+	})
+	cursor.buffered = cursor.buffered[1:]
+	return true
+}
+
+func (it *ScanIterator) fetch(cursor *shardCursor) error {
+
+	scanRequest := &pb.ScanRequest{
+		StartKey:       it.option.StartKey,
+		StartExclusive: it.option.StartExclusive,
+		EndKey:         it.option.EndKey,
+		EndInclusive:   it.option.EndInclusive,
+		Reverse:        it.option.Reverse,
+		Limit:          it.option.BatchSize,
+		Filter:         it.option.Filter,
+	}
+	if cursor.lastKey != nil {
+		if it.option.Reverse {
+			scanRequest.EndKey, scanRequest.EndInclusive = cursor.lastKey, false
+		} else {
+			scanRequest.StartKey, scanRequest.StartExclusive = cursor.lastKey, true
+		}
+	}
+
+	responses, err := it.sendRequests(cursor.shardId, []*pb.Request{{
+		ShardId: uint32(cursor.shardId),
+		Scan:    scanRequest,
+	}})
+	if err != nil {
+		return err
+	}
+	if len(responses) != 1 || responses[0].Scan == nil {
+		return fmt.Errorf("shard %d: unexpected scan response", cursor.shardId)
+	}
+	response := responses[0].Scan
+	if !response.Ok {
+		return fmt.Errorf("shard %d scan: %v", cursor.shardId, errors.New(response.Status))
+	}
+
+	cursor.buffered = cursor.buffered[:0]
+	for _, keyValue := range response.KeyValues {
+		kv := fromPbKeyTypeValue(keyValue)
+		if err = it.client.resolveChunked(kv); err != nil {
+			return err
+		}
+		cursor.buffered = append(cursor.buffered, kv)
+	}
+	if len(response.KeyValues) > 0 {
+		cursor.lastKey = response.KeyValues[len(response.KeyValues)-1].Key
+	}
+	cursor.hasMore = response.HasMore && len(response.KeyValues) > 0
+
+	return nil
+}
+
+// A scanQueue orders the items by key, in ascending or descending order.
+type scanQueue struct {
+	pqKeyTypeValue
+	reverse bool
+}
+
+func (pq scanQueue) Less(i, j int) bool {
+	c := bytes.Compare(pq.pqKeyTypeValue[i].KeyObject.key, pq.pqKeyTypeValue[j].KeyObject.key)
+	if pq.reverse {
+		return c > 0
+	}
+	return c < 0
+}
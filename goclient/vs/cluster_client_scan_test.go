@@ -0,0 +1,172 @@
+package vs
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// fakeScanShard answers the scan requests like a store, from the sorted keys.
+type fakeScanShard struct {
+	keys     []string
+	requests []*pb.ScanRequest
+}
+
+func (shard *fakeScanShard) scan(request *pb.ScanRequest) *pb.ScanResponse {
+
+	shard.requests = append(shard.requests, request)
+
+	var keys []string
+	for _, key := range shard.keys {
+		if len(request.StartKey) > 0 {
+			if c := bytes.Compare([]byte(key), request.StartKey); c < 0 || c == 0 && request.StartExclusive {
+				continue
+			}
+		}
+		if len(request.EndKey) > 0 {
+			if c := bytes.Compare([]byte(key), request.EndKey); c > 0 || c == 0 && !request.EndInclusive {
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	if request.Reverse {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+
+	resp := &pb.ScanResponse{Ok: true}
+	for i, key := range keys {
+		if request.Limit > 0 && i >= int(request.Limit) {
+			resp.HasMore = true
+			break
+		}
+		resp.KeyValues = append(resp.KeyValues, &pb.KeyTypeValue{
+			Key:      []byte(key),
+			DataType: pb.OpAndDataType_BYTES,
+			Value:    []byte("v"),
+		})
+	}
+	return resp
+}
+
+func newFakeScanIterator(option *ScanOption, shards []*fakeScanShard) *ScanIterator {
+	return newScanIterator(&ClusterClient{}, option, len(shards), func(shardId int, requests []*pb.Request) ([]*pb.Response, error) {
+		return []*pb.Response{{Scan: shards[shardId].scan(requests[0].Scan)}}, nil
+	})
+}
+
+func scannedKeys(t *testing.T, it *ScanIterator) string {
+	var keys []string
+	for it.Next() {
+		keys = append(keys, string(it.KeyValue().GetKey()))
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	return strings.Join(keys, ",")
+}
+
+func TestScanMergeSortsShards(t *testing.T) {
+
+	shards := []*fakeScanShard{
+		{keys: []string{"a", "d", "g", "j"}},
+		{keys: []string{"b", "e"}},
+		{keys: []string{"c", "f", "h", "i", "k"}},
+		{},
+	}
+
+	it := newFakeScanIterator(&ScanOption{BatchSize: 2}, shards)
+	if keys := scannedKeys(t, it); keys != "a,b,c,d,e,f,g,h,i,j,k" {
+		t.Errorf("scanned %s", keys)
+	}
+
+	for i, shard := range shards {
+		for _, request := range shard.requests {
+			if request.Limit != 2 {
+				t.Errorf("shard %d requested %d entries, expecting the batch size 2", i, request.Limit)
+			}
+		}
+	}
+
+	// the shards ending early are not asked again
+	if len(shards[1].requests) != 1 || len(shards[3].requests) != 1 {
+		t.Errorf("requested %d and %d times from the shards ending early", len(shards[1].requests), len(shards[3].requests))
+	}
+
+	// each page resumes after the last key of the previous page
+	if len(shards[2].requests) != 3 {
+		t.Fatalf("requested %d pages from shard 2, expecting 3", len(shards[2].requests))
+	}
+	for i, lastKey := range []string{"f", "i"} {
+		request := shards[2].requests[i+1]
+		if string(request.StartKey) != lastKey || !request.StartExclusive {
+			t.Errorf("page %d starts from %q exclusive %v, expecting after %q", i+1, request.StartKey, request.StartExclusive, lastKey)
+		}
+	}
+
+}
+
+func TestScanReverseRange(t *testing.T) {
+
+	shards := []*fakeScanShard{
+		{keys: []string{"a", "d", "g", "j"}},
+		{keys: []string{"b", "e", "h"}},
+		{keys: []string{"c", "f", "i"}},
+	}
+
+	it := newFakeScanIterator(&ScanOption{
+		StartKey:  []byte("b"),
+		EndKey:    []byte("i"),
+		Reverse:   true,
+		BatchSize: 1,
+	}, shards)
+	if keys := scannedKeys(t, it); keys != "h,g,f,e,d,c,b" {
+		t.Errorf("scanned %s", keys)
+	}
+
+	// a reverse page resumes before the last key of the previous page
+	if request := shards[1].requests[1]; string(request.EndKey) != "h" || request.EndInclusive {
+		t.Errorf("page 1 ends at %q inclusive %v, expecting before h", request.EndKey, request.EndInclusive)
+	}
+
+}
+
+func TestScanDefaultBatchSize(t *testing.T) {
+
+	shard := &fakeScanShard{}
+	for i := 0; i < DefaultScanBatchSize+1; i++ {
+		shard.keys = append(shard.keys, fmt.Sprintf("k%04d", i))
+	}
+
+	it := newFakeScanIterator(&ScanOption{}, []*fakeScanShard{shard})
+	if keys := strings.Split(scannedKeys(t, it), ","); len(keys) != DefaultScanBatchSize+1 {
+		t.Errorf("scanned %d keys", len(keys))
+	}
+	if len(shard.requests) != 2 || shard.requests[0].Limit != DefaultScanBatchSize {
+		t.Errorf("requested %d pages", len(shard.requests))
+	}
+
+}
+
+func TestScanStopsOnShardError(t *testing.T) {
+
+	it := newScanIterator(&ClusterClient{}, &ScanOption{}, 2, func(shardId int, requests []*pb.Request) ([]*pb.Response, error) {
+		if shardId == 1 {
+			return []*pb.Response{{Scan: &pb.ScanResponse{Status: "shard is down"}}}, nil
+		}
+		return []*pb.Response{{Scan: (&fakeScanShard{keys: []string{"a"}}).scan(requests[0].Scan)}}, nil
+	})
+
+	if it.Next() {
+		t.Errorf("scanned %s from a failed shard", it.KeyValue().GetKey())
+	}
+	if err := it.Err(); err == nil || !strings.Contains(err.Error(), "shard is down") {
+		t.Errorf("scan error %v", err)
+	}
+
+}
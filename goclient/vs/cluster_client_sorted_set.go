@@ -0,0 +1,105 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// SortedSetAdd adds the members to the sorted set stored under the key, or updates the scores of existing members.
+// The sorted set lives in the partition of the key, and is updated atomically by the store.
+func (c *ClusterClient) SortedSetAdd(key *KeyObject, members ...*pb.SortedSetMember) error {
+	_, err := c.sortedSetRequest(key, &pb.SortedSetRequest{
+		Op:      pb.SortedSetRequest_ADD,
+		Members: members,
+	})
+	return err
+}
+
+// SortedSetRemove removes the members from the sorted set stored under the key
+func (c *ClusterClient) SortedSetRemove(key *KeyObject, members ...[]byte) error {
+	request := &pb.SortedSetRequest{
+		Op: pb.SortedSetRequest_REMOVE,
+	}
+	for _, member := range members {
+		request.Members = append(request.Members, &pb.SortedSetMember{Member: member})
+	}
+	_, err := c.sortedSetRequest(key, request)
+	return err
+}
+
+// SortedSetTop returns the n members with the highest scores, or the lowest scores if ascending.
+func (c *ClusterClient) SortedSetTop(key *KeyObject, n int, ascending bool) ([]*pb.SortedSetMember, error) {
+	resp, err := c.sortedSetRequest(key, &pb.SortedSetRequest{
+		Op:        pb.SortedSetRequest_TOP,
+		Limit:     uint32(n),
+		Ascending: ascending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Members, nil
+}
+
+// SortedSetRangeByScore returns the members with scores between min and max, both inclusive,
+// ordered by descending scores, or ascending scores if ascending.
+// limit: number of members to return, 0 means all
+func (c *ClusterClient) SortedSetRangeByScore(key *KeyObject, min, max float64, limit int, ascending bool) ([]*pb.SortedSetMember, error) {
+	resp, err := c.sortedSetRequest(key, &pb.SortedSetRequest{
+		Op:        pb.SortedSetRequest_RANGE_BY_SCORE,
+		MinScore:  min,
+		MaxScore:  max,
+		Limit:     uint32(limit),
+		Ascending: ascending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Members, nil
+}
+
+// SortedSetRank returns the 0-based rank of the member by descending scores, or ascending scores if ascending.
+// ErrorNotFound is returned if the member is not in the sorted set.
+func (c *ClusterClient) SortedSetRank(key *KeyObject, member []byte, ascending bool) (int64, error) {
+	resp, err := c.sortedSetRequest(key, &pb.SortedSetRequest{
+		Op:        pb.SortedSetRequest_RANK,
+		Members:   []*pb.SortedSetMember{{Member: member}},
+		Ascending: ascending,
+	})
+	if err != nil {
+		return -1, err
+	}
+	if resp.Rank < 0 {
+		return -1, ErrorNotFound
+	}
+	return resp.Rank, nil
+}
+
+func (c *ClusterClient) sortedSetRequest(key *KeyObject, sortedSetRequest *pb.SortedSetRequest) (*pb.SortedSetResponse, error) {
+
+	sortedSetRequest.Key = key.GetKey()
+	sortedSetRequest.PartitionHash = key.GetPartitionHash()
+
+	var response *pb.SortedSetResponse
+	err := c.BatchProcess([]*pb.Request{{SortedSet: sortedSetRequest}}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 || responses[0].SortedSet == nil {
+			return fmt.Errorf("unexpected sorted set response")
+		}
+		response = responses[0].SortedSet
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("sorted set %v error: %v", sortedSetRequest.Op, err)
+	}
+
+	if !response.Ok {
+		return nil, errors.New(response.Status)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,87 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
+)
+
+// TimeSeriesAppend appends the points to the time series stored under the key.
+// retention: the points older than this are dropped by the store. 0 keeps all points.
+func (c *ClusterClient) TimeSeriesAppend(key *KeyObject, retention time.Duration, points ...*pb.TimeSeriesPoint) error {
+
+	ts := &codec.TimeSeries{
+		RetentionSecond: uint32(retention / time.Second),
+	}
+	for _, p := range points {
+		ts.Points = append(ts.Points, codec.TimeSeriesPoint{
+			TimestampMs: p.TimestampMs,
+			Value:       p.Value,
+		})
+	}
+	// sort the points, and keep the last one if a timestamp is repeated
+	ts.Merge(&codec.TimeSeries{RetentionSecond: ts.RetentionSecond})
+
+	return c.mergeCollection(key, pb.OpAndDataType_TIME_SERIES, ts.Bytes())
+}
+
+// TimeSeriesRange returns the points with timestamps from startMs, inclusive, to endMs, exclusive.
+// 0 endMs means no end.
+func (c *ClusterClient) TimeSeriesRange(key *KeyObject, startMs, endMs int64) ([]*pb.TimeSeriesPoint, error) {
+	resp, err := c.timeSeriesRequest(key, &pb.TimeSeriesRequest{
+		StartMs: startMs,
+		EndMs:   endMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Points, nil
+}
+
+// TimeSeriesDownsample aggregates the points from startMs, inclusive, to endMs, exclusive,
+// into buckets of bucketMs milliseconds. Empty buckets are skipped.
+func (c *ClusterClient) TimeSeriesDownsample(key *KeyObject, startMs, endMs, bucketMs int64) ([]*pb.TimeSeriesBucket, error) {
+	if bucketMs <= 0 {
+		return nil, fmt.Errorf("invalid bucket size %d", bucketMs)
+	}
+	resp, err := c.timeSeriesRequest(key, &pb.TimeSeriesRequest{
+		StartMs:  startMs,
+		EndMs:    endMs,
+		BucketMs: bucketMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Buckets, nil
+}
+
+func (c *ClusterClient) timeSeriesRequest(key *KeyObject, timeSeriesRequest *pb.TimeSeriesRequest) (*pb.TimeSeriesResponse, error) {
+
+	timeSeriesRequest.Key = key.GetKey()
+	timeSeriesRequest.PartitionHash = key.GetPartitionHash()
+
+	var response *pb.TimeSeriesResponse
+	err := c.BatchProcess([]*pb.Request{{TimeSeries: timeSeriesRequest}}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 || responses[0].TimeSeries == nil {
+			return fmt.Errorf("unexpected time series response")
+		}
+		response = responses[0].TimeSeries
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("time series error: %v", err)
+	}
+
+	if !response.Ok {
+		return nil, errors.New(response.Status)
+	}
+
+	return response, nil
+}
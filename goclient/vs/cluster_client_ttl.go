@@ -0,0 +1,78 @@
+package vs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// Touch sets the key to expire after the ttl from now, without rewriting the value.
+// ErrorNotFound is returned if the key does not exist.
+func (c *ClusterClient) Touch(key *KeyObject, ttl time.Duration) error {
+	if ttl < time.Second {
+		return fmt.Errorf("ttl %v is less than one second", ttl)
+	}
+	_, err := c.ttlRequest(key, pb.TtlRequest_TOUCH, uint32(ttl/time.Second))
+	return err
+}
+
+// Persist removes the ttl of the key, so the key does not expire.
+// ErrorNotFound is returned if the key does not exist.
+func (c *ClusterClient) Persist(key *KeyObject) error {
+	_, err := c.ttlRequest(key, pb.TtlRequest_PERSIST, 0)
+	return err
+}
+
+// GetTtl returns the remaining time before the key expires.
+// hasTtl is false if the key does not expire.
+// ErrorNotFound is returned if the key does not exist.
+func (c *ClusterClient) GetTtl(key *KeyObject) (remaining time.Duration, hasTtl bool, err error) {
+	resp, err := c.ttlRequest(key, pb.TtlRequest_GET, 0)
+	if err != nil {
+		return 0, false, err
+	}
+	if resp.RemainingSecond < 0 {
+		return 0, false, nil
+	}
+	return time.Duration(resp.RemainingSecond) * time.Second, true, nil
+}
+
+func (c *ClusterClient) ttlRequest(key *KeyObject, op pb.TtlRequest_Op, ttlSecond uint32) (*pb.TtlResponse, error) {
+
+	request := &pb.Request{
+		Ttl: &pb.TtlRequest{
+			Key:           key.GetKey(),
+			PartitionHash: key.GetPartitionHash(),
+			Op:            op,
+			TtlSecond:     ttlSecond,
+		},
+	}
+
+	var response *pb.TtlResponse
+	err := c.BatchProcess([]*pb.Request{request}, func(responses []*pb.Response, err error) error {
+		if err != nil {
+			return err
+		}
+		if len(responses) == 0 || responses[0].Ttl == nil {
+			return fmt.Errorf("unexpected ttl response")
+		}
+		response = responses[0].Ttl
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("ttl error: %v", err)
+	}
+
+	if !response.Ok {
+		return nil, errors.New(response.Status)
+	}
+
+	if !response.Found {
+		return nil, ErrorNotFound
+	}
+
+	return response, nil
+}
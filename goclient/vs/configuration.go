@@ -2,8 +2,9 @@ package vs
 
 // WriteConfig stores options for writing
 type WriteConfig struct {
-	UpdatedAtNs uint64 // the update timestamp in nano seconds. Newer entries overwrite older ones. O means now.
-	TtlSecond   uint32 // TTL in seconds. Updated_at + TTL determines the life of the entry. 0 means no TTL.
+	UpdatedAtNs   uint64 // the update timestamp in nano seconds. Newer entries overwrite older ones. O means now.
+	TtlSecond     uint32 // TTL in seconds. Updated_at + TTL determines the life of the entry. 0 means no TTL.
+	ChunkSizeByte int    // values larger than this are split into chunks. 0 means DefaultChunkSize.
 }
 
 // AccessConfig stores options for reading and writing
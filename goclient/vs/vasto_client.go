@@ -14,6 +14,7 @@ import (
 type VastoClient struct {
 	ctx             context.Context
 	Master          string
+	DataCenter      string
 	ClientName      string
 	ClusterListener *clusterlistener.ClusterListener
 	MasterClient    pb.VastoMasterClient
@@ -21,10 +22,18 @@ type VastoClient struct {
 
 // NewVastoClient creates a vasto client which contains a listener for the vasto system topology changes
 func NewVastoClient(ctx context.Context, clientName, master string) *VastoClient {
+	return NewVastoClientInDataCenter(ctx, clientName, master, "")
+}
+
+// NewVastoClientInDataCenter creates a vasto client in a data center.
+// The client reads and writes the cluster in its local dataCenter,
+// or the cluster in another data center if the keyspace is not created in the local data center.
+func NewVastoClientInDataCenter(ctx context.Context, clientName, master, dataCenter string) *VastoClient {
 	c := &VastoClient{
 		ctx:             ctx,
-		ClusterListener: clusterlistener.NewClusterListener(clientName),
+		ClusterListener: clusterlistener.NewClusterListenerInDataCenter(dataCenter, clientName),
 		Master:          master,
+		DataCenter:      dataCenter,
 		ClientName:      clientName,
 	}
 	// c.ClusterListener.RegisterShardEventProcessor(&clusterlistener.ClusterEventLogger{Prefix: clientName + " "})
@@ -40,7 +49,7 @@ func NewVastoClient(ctx context.Context, clientName, master string) *VastoClient
 }
 
 // NewClusterClient create a lightweight client to access a specific cluster
-// in a specific data center. The call will block if the keyspace is not created in this data center.
+// in a specific data center. The call will block if the keyspace is not created in any data center.
 func (c *VastoClient) NewClusterClient(keyspace string) (clusterClient *ClusterClient) {
 	c.ClusterListener.AddNewKeyspace(keyspace, 0, 0)
 	for !c.ClusterListener.HasConnectedKeyspace(keyspace) {
@@ -56,6 +65,42 @@ func (c *VastoClient) NewClusterClient(keyspace string) (clusterClient *ClusterC
 
 // CreateCluster creates a new cluster of the keyspace in the data center, with size and replication factor
 func (c *VastoClient) CreateCluster(keyspace string, clusterSize, replicationFactor int) (*pb.Cluster, error) {
+	return c.CreateClusterWithSettings(keyspace, clusterSize, replicationFactor, nil)
+}
+
+// CreateClusterWithSettings creates a new cluster of the keyspace, with the keyspace settings for ttl and retention.
+// nil settings means no keyspace level ttl or retention.
+func (c *VastoClient) CreateClusterWithSettings(keyspace string, clusterSize, replicationFactor int, settings *pb.KeyspaceSettings) (*pb.Cluster, error) {
+	return c.CreateClusterWithShardCount(keyspace, clusterSize, 0, replicationFactor, settings)
+}
+
+// CreateClusterWithShardCount creates a new cluster of the keyspace, with a fixed number of shards placed on the servers.
+// The cluster can later be resized up to shardCount servers by moving whole shards.
+// 0 shardCount means one shard per server.
+func (c *VastoClient) CreateClusterWithShardCount(keyspace string, clusterSize, shardCount, replicationFactor int, settings *pb.KeyspaceSettings) (*pb.Cluster, error) {
+
+	resp, err := c.createCluster(keyspace, clusterSize, shardCount, replicationFactor, settings, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Cluster, nil
+
+}
+
+// PlanCreateCluster returns the servers and shards that CreateClusterWithShardCount would create, without creating them.
+func (c *VastoClient) PlanCreateCluster(keyspace string, clusterSize, shardCount, replicationFactor int) (*pb.TopologyChangePlan, error) {
+
+	resp, err := c.createCluster(keyspace, clusterSize, shardCount, replicationFactor, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Plan, nil
+
+}
+
+func (c *VastoClient) createCluster(keyspace string, clusterSize, shardCount, replicationFactor int, settings *pb.KeyspaceSettings, dryRun bool) (*pb.CreateClusterResponse, error) {
 
 	if replicationFactor == 0 {
 		return nil, fmt.Errorf("replication factor %d should be greater than 0", replicationFactor)
@@ -65,12 +110,20 @@ func (c *VastoClient) CreateCluster(keyspace string, clusterSize, replicationFac
 		return nil, fmt.Errorf("replication factor %d should not be bigger than cluster size %d", replicationFactor, clusterSize)
 	}
 
+	if shardCount != 0 && shardCount < clusterSize {
+		return nil, fmt.Errorf("shard count %d should not be less than cluster size %d", shardCount, clusterSize)
+	}
+
 	resp, err := c.MasterClient.CreateCluster(
 		c.ctx,
 		&pb.CreateClusterRequest{
 			Keyspace:          keyspace,
 			ClusterSize:       uint32(clusterSize),
 			ReplicationFactor: uint32(replicationFactor),
+			Settings:          settings,
+			DataCenter:        c.DataCenter,
+			ShardCount:        uint32(shardCount),
+			DryRun:            dryRun,
 		},
 	)
 
@@ -81,7 +134,7 @@ func (c *VastoClient) CreateCluster(keyspace string, clusterSize, replicationFac
 		return nil, fmt.Errorf("%s create cluster: %v", c.ClientName, resp.Error)
 	}
 
-	return resp.Cluster, nil
+	return resp, nil
 
 }
 
@@ -91,7 +144,8 @@ func (c *VastoClient) DeleteCluster(keyspace string) error {
 	resp, err := c.MasterClient.DeleteCluster(
 		c.ctx,
 		&pb.DeleteClusterRequest{
-			Keyspace: keyspace,
+			Keyspace:   keyspace,
+			DataCenter: c.DataCenter,
 		},
 	)
 
@@ -106,7 +160,7 @@ func (c *VastoClient) DeleteCluster(keyspace string) error {
 
 }
 
-// CompactCluster deletes the cluster of the keyspace in the data center
+// CompactCluster compacts the clusters of the keyspace in all data centers
 func (c *VastoClient) CompactCluster(keyspace string) error {
 
 	resp, err := c.MasterClient.CompactCluster(
@@ -127,22 +181,216 @@ func (c *VastoClient) CompactCluster(keyspace string) error {
 
 }
 
-// ResizeCluster changes the size of the cluster of the keyspace and data center
+// DefineIndex adds or replaces a secondary index on the keyspace, and builds the index for the existing data.
+func (c *VastoClient) DefineIndex(keyspace string, index *pb.IndexDefinition) error {
+
+	resp, err := c.MasterClient.DefineIndex(
+		c.ctx,
+		&pb.DefineIndexRequest{
+			Keyspace: keyspace,
+			Index:    index,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("define index request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("define index: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// DropIndex removes the secondary index and its index entries from the keyspace
+func (c *VastoClient) DropIndex(keyspace string, indexName string) error {
+
+	resp, err := c.MasterClient.DefineIndex(
+		c.ctx,
+		&pb.DefineIndexRequest{
+			Keyspace: keyspace,
+			Index:    &pb.IndexDefinition{Name: indexName},
+			IsDrop:   true,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("drop index request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("drop index: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// UpdateKeyspace changes the keyspace settings for ttl and retention
+func (c *VastoClient) UpdateKeyspace(keyspace string, settings *pb.KeyspaceSettings) error {
+
+	resp, err := c.MasterClient.UpdateKeyspace(
+		c.ctx,
+		&pb.UpdateKeyspaceRequest{
+			Keyspace: keyspace,
+			Settings: settings,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("update keyspace request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("update keyspace: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// ResizeCluster changes the size of the cluster of the keyspace and data center,
+// and waits for the resize job to finish.
 func (c *VastoClient) ResizeCluster(keyspace string, newClusterSize int) error {
 
+	jobId, err := c.StartResizeCluster(keyspace, newClusterSize)
+	if err != nil {
+		return err
+	}
+
+	for {
+		time.Sleep(time.Second)
+		job, err := c.GetResizeStatus(keyspace)
+		if err != nil {
+			return err
+		}
+		if job.Id != jobId {
+			return fmt.Errorf("resize job %s is replaced by %s", jobId, job.Id)
+		}
+		switch job.State {
+		case pb.ResizeJob_RUNNING:
+			continue
+		case pb.ResizeJob_SUCCEEDED:
+			return nil
+		case pb.ResizeJob_CANCELLED:
+			return fmt.Errorf("resize job %s is cancelled", jobId)
+		default:
+			return fmt.Errorf("resize job %s: %v", jobId, job.Error)
+		}
+	}
+
+}
+
+// StartResizeCluster starts to change the size of the cluster of the keyspace and data center.
+// The resize runs in the background, and can be checked by GetResizeStatus.
+func (c *VastoClient) StartResizeCluster(keyspace string, newClusterSize int) (jobId string, err error) {
+
+	resp, err := c.MasterClient.ResizeCluster(
+		c.ctx,
+		&pb.ResizeRequest{
+			Keyspace:          keyspace,
+			TargetClusterSize: uint32(newClusterSize),
+			DataCenter:        c.DataCenter,
+		},
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("resize request: %v", err)
+	}
+	if resp.Error != "" {
+		return "", fmt.Errorf("resize: %v", resp.Error)
+	}
+
+	return resp.JobId, nil
+
+}
+
+// PlanResizeCluster returns the new servers and the shard bootstrapping that resizing the cluster would do,
+// without changing the cluster.
+func (c *VastoClient) PlanResizeCluster(keyspace string, newClusterSize int) (*pb.TopologyChangePlan, error) {
+
 	resp, err := c.MasterClient.ResizeCluster(
 		c.ctx,
 		&pb.ResizeRequest{
 			Keyspace:          keyspace,
 			TargetClusterSize: uint32(newClusterSize),
+			DataCenter:        c.DataCenter,
+			DryRun:            true,
 		},
 	)
 
 	if err != nil {
-		return fmt.Errorf("resize request: %v", err)
+		return nil, fmt.Errorf("plan resize request: %v", err)
 	}
 	if resp.Error != "" {
-		return fmt.Errorf("resize: %v", resp.Error)
+		return nil, fmt.Errorf("plan resize: %v", resp.Error)
+	}
+
+	return resp.Plan, nil
+
+}
+
+// GetResizeStatus returns the latest resize job of the cluster of the keyspace and data center.
+func (c *VastoClient) GetResizeStatus(keyspace string) (*pb.ResizeJob, error) {
+
+	resp, err := c.MasterClient.GetResizeStatus(
+		c.ctx,
+		&pb.ResizeStatusRequest{
+			Keyspace:   keyspace,
+			DataCenter: c.DataCenter,
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("resize status request: %v", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("resize status: %v", resp.Error)
+	}
+
+	return resp.Job, nil
+
+}
+
+// CancelResize stops the running resize job of the cluster of the keyspace and data center,
+// if the new cluster size is not committed yet. The new shards are deleted.
+func (c *VastoClient) CancelResize(keyspace string) error {
+
+	resp, err := c.MasterClient.CancelResize(
+		c.ctx,
+		&pb.CancelResizeRequest{
+			Keyspace:   keyspace,
+			DataCenter: c.DataCenter,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("cancel resize request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("cancel resize: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// ChangeReplicationFactor changes the replication factor of the cluster of the keyspace and data center.
+func (c *VastoClient) ChangeReplicationFactor(keyspace string, replicationFactor int) error {
+
+	resp, err := c.MasterClient.ChangeReplicationFactor(
+		c.ctx,
+		&pb.ChangeReplicationFactorRequest{
+			Keyspace:          keyspace,
+			DataCenter:        c.DataCenter,
+			ReplicationFactor: uint32(replicationFactor),
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("change replication factor request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("change replication factor: %v", resp.Error)
 	}
 
 	return nil
@@ -158,6 +406,7 @@ func (c *VastoClient) ReplaceNode(keyspace string, nodeId uint32, newAddress str
 			Keyspace:   keyspace,
 			NodeId:     uint32(nodeId),
 			NewAddress: newAddress,
+			DataCenter: c.DataCenter,
 		},
 	)
 
@@ -171,3 +420,193 @@ func (c *VastoClient) ReplaceNode(keyspace string, nodeId uint32, newAddress str
 	return nil
 
 }
+
+// PlanReplaceNode returns the shard bootstrapping that replacing the server would do, without changing the cluster.
+func (c *VastoClient) PlanReplaceNode(keyspace string, nodeId uint32, newAddress string) (*pb.TopologyChangePlan, error) {
+
+	resp, err := c.MasterClient.ReplaceNode(
+		c.ctx,
+		&pb.ReplaceNodeRequest{
+			Keyspace:   keyspace,
+			NodeId:     uint32(nodeId),
+			NewAddress: newAddress,
+			DataCenter: c.DataCenter,
+			DryRun:     true,
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("plan replace node request: %v", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plan replace node: %v", resp.Error)
+	}
+
+	return resp.Plan, nil
+
+}
+
+// Balance moves the cluster nodes among the stores of the data center to even out the disk usage.
+// The stores are the ones tagged with storeGroup, or all stores if storeGroup is empty.
+// If dryRun is true, the moves are only planned. Both the planned and done moves are returned.
+func (c *VastoClient) Balance(storeGroup string, storeCount int, dryRun bool, moveIntervalSecond int) (moves []*pb.ShardMove, doneMoves int, err error) {
+
+	resp, err := c.MasterClient.Balance(
+		c.ctx,
+		&pb.BalanceRequest{
+			StoreGroup:         storeGroup,
+			StoreCount:         uint32(storeCount),
+			DataCenter:         c.DataCenter,
+			DryRun:             dryRun,
+			MoveIntervalSecond: uint32(moveIntervalSecond),
+		},
+	)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("balance request: %v", err)
+	}
+	if resp.Error != "" {
+		return resp.Moves, int(resp.DoneMoves), fmt.Errorf("balance: %v", resp.Error)
+	}
+
+	return resp.Moves, int(resp.DoneMoves), nil
+
+}
+
+// DrainStore stops allocating new shards to the store, and moves its shards to other stores.
+// The store is safe to shut down when isEmpty is true.
+// If dryRun is true, the moves are only planned, and the store is not marked as draining.
+func (c *VastoClient) DrainStore(address string, dryRun bool) (moves []*pb.ShardMove, doneMoves int, isEmpty bool, err error) {
+
+	resp, err := c.MasterClient.DrainStore(
+		c.ctx,
+		&pb.DrainStoreRequest{
+			Address:    address,
+			DataCenter: c.DataCenter,
+			DryRun:     dryRun,
+		},
+	)
+
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("drain store request: %v", err)
+	}
+	if resp.Error != "" {
+		return resp.Moves, int(resp.DoneMoves), resp.IsEmpty, fmt.Errorf("drain store: %v", resp.Error)
+	}
+
+	return resp.Moves, int(resp.DoneMoves), resp.IsEmpty, nil
+
+}
+
+// CancelDrainStore lets the store be allocated again. The moved shards are not moved back.
+func (c *VastoClient) CancelDrainStore(address string) error {
+
+	resp, err := c.MasterClient.DrainStore(
+		c.ctx,
+		&pb.DrainStoreRequest{
+			Address:    address,
+			DataCenter: c.DataCenter,
+			Cancel:     true,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("cancel drain store request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("cancel drain store: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// SetBootstrapThrottle limits sending bootstrap copies on the store to bytesPerSecond,
+// or on all stores in the data center if address is empty. 0 bytesPerSecond means no limit.
+func (c *VastoClient) SetBootstrapThrottle(address string, bytesPerSecond uint64) error {
+
+	resp, err := c.MasterClient.SetBootstrapThrottle(
+		c.ctx,
+		&pb.SetBootstrapThrottleRequest{
+			DataCenter:     c.DataCenter,
+			Address:        address,
+			BytesPerSecond: bytesPerSecond,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("set bootstrap throttle request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("set bootstrap throttle: %v", resp.Error)
+	}
+
+	return nil
+
+}
+
+// ListJobs returns the latest admin jobs first, of the keyspace if not empty, in the data center of the client.
+// 0 limit means all jobs.
+func (c *VastoClient) ListJobs(keyspace string, limit int) ([]*pb.AdminJob, error) {
+
+	resp, err := c.MasterClient.ListJobs(
+		c.ctx,
+		&pb.ListJobsRequest{
+			Keyspace:   keyspace,
+			DataCenter: c.DataCenter,
+			Limit:      uint32(limit),
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("list jobs request: %v", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("list jobs: %v", resp.Error)
+	}
+
+	return resp.Jobs, nil
+
+}
+
+// GetJob returns the admin job with the job id returned by the admin requests
+func (c *VastoClient) GetJob(jobId string) (*pb.AdminJob, error) {
+
+	resp, err := c.MasterClient.GetJob(
+		c.ctx,
+		&pb.GetJobRequest{
+			Id: jobId,
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("get job request: %v", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("get job: %v", resp.Error)
+	}
+
+	return resp.Job, nil
+
+}
+
+// CancelJob stops the running admin job, if the job has not passed the point of no return
+func (c *VastoClient) CancelJob(jobId string) error {
+
+	resp, err := c.MasterClient.CancelJob(
+		c.ctx,
+		&pb.CancelJobRequest{
+			Id: jobId,
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("cancel job request: %v", err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("cancel job: %v", resp.Error)
+	}
+
+	return nil
+
+}
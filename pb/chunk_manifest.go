@@ -0,0 +1,42 @@
+package pb
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// ChunkKeyPrefix is a reserved key prefix for the chunks of large values
+var ChunkKeyPrefix = []byte("_vasto_chunk.")
+
+// ChunkKey returns the key of the i-th chunk of the value stored under the key.
+// The chunks of different versions never overwrite each other.
+func (m *ChunkManifest) ChunkKey(key []byte, i int) []byte {
+	return []byte(fmt.Sprintf("%s%s.%d.%d", ChunkKeyPrefix, key, m.Version, i))
+}
+
+// IsChunkKey checks whether the key is for one chunk of a large value
+func IsChunkKey(key []byte) bool {
+	return bytes.HasPrefix(key, ChunkKeyPrefix)
+}
+
+// ParseChunkKey returns the key and the manifest version of the chunk key
+func ParseChunkKey(chunkKey []byte) (key []byte, version uint64, ok bool) {
+	if !IsChunkKey(chunkKey) {
+		return nil, 0, false
+	}
+	t := chunkKey[len(ChunkKeyPrefix):]
+	indexDot := bytes.LastIndexByte(t, '.')
+	if indexDot < 0 {
+		return nil, 0, false
+	}
+	versionDot := bytes.LastIndexByte(t[:indexDot], '.')
+	if versionDot < 0 {
+		return nil, 0, false
+	}
+	version, err := strconv.ParseUint(string(t[versionDot+1:indexDot]), 10, 64)
+	if err != nil {
+		return nil, 0, false
+	}
+	return t[:versionDot], version, true
+}
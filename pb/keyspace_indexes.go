@@ -0,0 +1,19 @@
+package pb
+
+// WithIndex returns the index definitions after the index is added, replaced, or dropped.
+// The receiver can be nil, and is not changed.
+func (m *KeyspaceIndexes) WithIndex(keyspace string, def *IndexDefinition, isDrop bool, updatedAtNs uint64) *KeyspaceIndexes {
+	t := &KeyspaceIndexes{
+		Keyspace:    keyspace,
+		UpdatedAtNs: updatedAtNs,
+	}
+	for _, existing := range m.GetIndexes() {
+		if existing.Name != def.Name {
+			t.Indexes = append(t.Indexes, existing)
+		}
+	}
+	if !isDrop {
+		t.Indexes = append(t.Indexes, def)
+	}
+	return t
+}
@@ -0,0 +1,14 @@
+package pb
+
+import "fmt"
+
+// ValidateKeyspaceSettings checks the default ttl is within the max ttl
+func ValidateKeyspaceSettings(settings *KeyspaceSettings) error {
+	if settings == nil {
+		return fmt.Errorf("missing keyspace settings")
+	}
+	if settings.MaxTtlSecond > 0 && settings.DefaultTtlSecond > settings.MaxTtlSecond {
+		return fmt.Errorf("default ttl %d exceeds the max ttl %d", settings.DefaultTtlSecond, settings.MaxTtlSecond)
+	}
+	return nil
+}
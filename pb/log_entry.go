@@ -16,9 +16,14 @@ func (entry *LogEntry) GetKey() []byte {
 }
 
 func (entry *LogEntry) getWriteRequest() (request writeRequest) {
-	request = entry.GetPut()
-	if request == nil {
-		request = entry.GetDelete()
+	if put := entry.GetPut(); put != nil {
+		return put
 	}
-	return request
+	if merge := entry.GetMerge(); merge != nil {
+		return merge
+	}
+	if ttl := entry.GetTtl(); ttl != nil {
+		return ttl
+	}
+	return entry.GetDelete()
 }
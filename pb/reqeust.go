@@ -4,7 +4,7 @@ import (
 	"github.com/chrislusf/glog"
 )
 
-// GetPartitionHash returns the partition hash of Get, Put, Delete, and Merge requests
+// GetPartitionHash returns the partition hash of Get, Put, Delete, Merge, SortedSet, TimeSeries, and Ttl requests
 func (r *Request) GetPartitionHash() uint64 {
 	if r.Get != nil {
 		return r.Get.PartitionHash
@@ -23,6 +23,15 @@ func (r *Request) GetPartitionHash() uint64 {
 	if r.Merge != nil {
 		return r.Merge.PartitionHash
 	}
+	if r.SortedSet != nil {
+		return r.SortedSet.PartitionHash
+	}
+	if r.TimeSeries != nil {
+		return r.TimeSeries.PartitionHash
+	}
+	if r.Ttl != nil {
+		return r.Ttl.PartitionHash
+	}
 
 	glog.Fatalf("unexpected request without partition hash %v", r)
 	return 0
@@ -10,14 +10,23 @@ It is generated from these files:
 
 It has these top-level messages:
 	BalanceRequest
+	BalanceResponse
+	ShardMove
 	StoreHeartbeat
 	StoreMessage
+	ShardDiskUsage
+	BootstrapProgress
+	FollowLag
+	ReplicationLag
 	ClientHeartbeat
 	ClientMessage
 	Cluster
 	ClusterNode
 	StoreResource
 	LocalShardsInCluster
+	KeyspaceSettings
+	KeyspaceIndexes
+	IndexDefinition
 	ShardInfo
 	Empty
 	KeyTypeValue
@@ -31,8 +40,25 @@ It has these top-level messages:
 	GetRequest
 	GetResponse
 	GetByPrefixRequest
+	ScanFilter
 	GetByPrefixResponse
+	ScanRequest
+	ScanResponse
+	AggregateRequest
+	AggregateResponse
+	IndexLookupRequest
+	IndexLookupResponse
+	SortedSetRequest
+	SortedSetMember
+	SortedSetResponse
+	TimeSeriesRequest
+	TimeSeriesPoint
+	TimeSeriesBucket
+	TimeSeriesResponse
+	TtlRequest
+	TtlResponse
 	Response
+	ChunkManifest
 	RawKeyValue
 	LogEntry
 	CopyDoneMessge
@@ -42,6 +68,8 @@ It has these top-level messages:
 	PullUpdateResponse
 	CheckBinlogRequest
 	CheckBinlogResponse
+	SubscribeExpiryRequest
+	ExpiryEvent
 	DescribeRequest
 	DescribeResponse
 	CreateClusterRequest
@@ -50,8 +78,16 @@ It has these top-level messages:
 	DeleteClusterResponse
 	CompactClusterRequest
 	CompactClusterResponse
+	DefineIndexRequest
+	DefineIndexResponse
+	UpdateKeyspaceRequest
+	UpdateKeyspaceResponse
 	ReplaceNodeRequest
 	ReplaceNodeResponse
+	DrainStoreRequest
+	DrainStoreResponse
+	SetBootstrapThrottleRequest
+	SetBootstrapThrottleResponse
 	CreateShardRequest
 	CreateShardResponse
 	DeleteKeyspaceRequest
@@ -72,6 +108,30 @@ It has these top-level messages:
 	ResizeCleanupResponse
 	ResizeRequest
 	ResizeResponse
+	TopologyChangePlan
+	PlannedServer
+	ShardBootstrapPlan
+	ShardLocation
+	ResizeJob
+	ResizeShardProgress
+	ResizeStatusRequest
+	ResizeStatusResponse
+	CancelResizeRequest
+	CancelResizeResponse
+	ChangeReplicationFactorRequest
+	ChangeReplicationFactorResponse
+	ChangeReplicationFactorPrepareRequest
+	ChangeReplicationFactorPrepareResponse
+	ChangeReplicationFactorCommitRequest
+	ChangeReplicationFactorCommitResponse
+	AdminJob
+	AdminJobStep
+	ListJobsRequest
+	ListJobsResponse
+	GetJobRequest
+	GetJobResponse
+	CancelJobRequest
+	CancelJobResponse
 */
 package pb
 
@@ -98,23 +158,55 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type OpAndDataType int32
 
 const (
-	OpAndDataType_BYTES       OpAndDataType = 0
-	OpAndDataType_FLOAT64     OpAndDataType = 1
-	OpAndDataType_MAX_FLOAT64 OpAndDataType = 2
-	OpAndDataType_MIN_FLOAT64 OpAndDataType = 3
+	OpAndDataType_BYTES          OpAndDataType = 0
+	OpAndDataType_FLOAT64        OpAndDataType = 1
+	OpAndDataType_MAX_FLOAT64    OpAndDataType = 2
+	OpAndDataType_MIN_FLOAT64    OpAndDataType = 3
+	OpAndDataType_CHUNK_MANIFEST OpAndDataType = 4
+	OpAndDataType_INT64          OpAndDataType = 5
+	OpAndDataType_MAX_INT64      OpAndDataType = 6
+	OpAndDataType_MIN_INT64      OpAndDataType = 7
+	// members added and removed, see codec.EncodeSet
+	OpAndDataType_SET OpAndDataType = 8
+	// items appended with the capacity, see codec.EncodeList
+	OpAndDataType_LIST OpAndDataType = 9
+	// sketch of distinct elements, see codec.HyperLogLog
+	OpAndDataType_HYPERLOGLOG OpAndDataType = 10
+	// points sorted by the timestamp, see codec.TimeSeries
+	OpAndDataType_TIME_SERIES OpAndDataType = 11
+	// value merged by a function registered in the store, see codec.RegisterMergeFunction
+	OpAndDataType_NAMED_MERGE OpAndDataType = 12
 )
 
 var OpAndDataType_name = map[int32]string{
-	0: "BYTES",
-	1: "FLOAT64",
-	2: "MAX_FLOAT64",
-	3: "MIN_FLOAT64",
+	0:  "BYTES",
+	1:  "FLOAT64",
+	2:  "MAX_FLOAT64",
+	3:  "MIN_FLOAT64",
+	4:  "CHUNK_MANIFEST",
+	5:  "INT64",
+	6:  "MAX_INT64",
+	7:  "MIN_INT64",
+	8:  "SET",
+	9:  "LIST",
+	10: "HYPERLOGLOG",
+	11: "TIME_SERIES",
+	12: "NAMED_MERGE",
 }
 var OpAndDataType_value = map[string]int32{
-	"BYTES":       0,
-	"FLOAT64":     1,
-	"MAX_FLOAT64": 2,
-	"MIN_FLOAT64": 3,
+	"BYTES":          0,
+	"FLOAT64":        1,
+	"MAX_FLOAT64":    2,
+	"MIN_FLOAT64":    3,
+	"CHUNK_MANIFEST": 4,
+	"INT64":          5,
+	"MAX_INT64":      6,
+	"MIN_INT64":      7,
+	"SET":            8,
+	"LIST":           9,
+	"HYPERLOGLOG":    10,
+	"TIME_SERIES":    11,
+	"NAMED_MERGE":    12,
 }
 
 func (x OpAndDataType) String() string {
@@ -122,6 +214,33 @@ func (x OpAndDataType) String() string {
 }
 func (OpAndDataType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
 
+type IndexDefinition_Source int32
+
+const (
+	// value[offset:offset+length], length 0 means till the end of the value
+	IndexDefinition_VALUE_BYTES IndexDefinition_Source = 0
+	// the scalar value at the dotted json_path of a JSON value
+	IndexDefinition_JSON_PATH IndexDefinition_Source = 1
+	// key[:length]
+	IndexDefinition_KEY_PREFIX IndexDefinition_Source = 2
+)
+
+var IndexDefinition_Source_name = map[int32]string{
+	0: "VALUE_BYTES",
+	1: "JSON_PATH",
+	2: "KEY_PREFIX",
+}
+var IndexDefinition_Source_value = map[string]int32{
+	"VALUE_BYTES": 0,
+	"JSON_PATH":   1,
+	"KEY_PREFIX":  2,
+}
+
+func (x IndexDefinition_Source) String() string {
+	return proto.EnumName(IndexDefinition_Source_name, int32(x))
+}
+func (IndexDefinition_Source) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{17, 0} }
+
 type ShardInfo_Status int32
 
 const (
@@ -147,13 +266,132 @@ var ShardInfo_Status_value = map[string]int32{
 func (x ShardInfo_Status) String() string {
 	return proto.EnumName(ShardInfo_Status_name, int32(x))
 }
-func (ShardInfo_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9, 0} }
+func (ShardInfo_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{18, 0} }
+
+type SortedSetRequest_Op int32
+
+const (
+	// add the members, or update the scores of existing members
+	SortedSetRequest_ADD    SortedSetRequest_Op = 0
+	SortedSetRequest_REMOVE SortedSetRequest_Op = 1
+	// the members with the highest scores, or the lowest scores if ascending
+	SortedSetRequest_TOP SortedSetRequest_Op = 2
+	// the 0-based rank of the first member, by descending scores, or ascending scores if ascending
+	SortedSetRequest_RANK SortedSetRequest_Op = 3
+	// the members with scores between min_score and max_score, both inclusive
+	SortedSetRequest_RANGE_BY_SCORE SortedSetRequest_Op = 4
+)
+
+var SortedSetRequest_Op_name = map[int32]string{
+	0: "ADD",
+	1: "REMOVE",
+	2: "TOP",
+	3: "RANK",
+	4: "RANGE_BY_SCORE",
+}
+var SortedSetRequest_Op_value = map[string]int32{
+	"ADD":            0,
+	"REMOVE":         1,
+	"TOP":            2,
+	"RANK":           3,
+	"RANGE_BY_SCORE": 4,
+}
+
+func (x SortedSetRequest_Op) String() string {
+	return proto.EnumName(SortedSetRequest_Op_name, int32(x))
+}
+func (SortedSetRequest_Op) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{39, 0} }
+
+type TtlRequest_Op int32
+
+const (
+	// read the remaining ttl
+	TtlRequest_GET TtlRequest_Op = 0
+	// the key expires ttl_second after now
+	TtlRequest_TOUCH TtlRequest_Op = 1
+	// remove the ttl
+	TtlRequest_PERSIST TtlRequest_Op = 2
+)
+
+var TtlRequest_Op_name = map[int32]string{
+	0: "GET",
+	1: "TOUCH",
+	2: "PERSIST",
+}
+var TtlRequest_Op_value = map[string]int32{
+	"GET":     0,
+	"TOUCH":   1,
+	"PERSIST": 2,
+}
+
+func (x TtlRequest_Op) String() string {
+	return proto.EnumName(TtlRequest_Op_name, int32(x))
+}
+func (TtlRequest_Op) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{46, 0} }
+
+type ResizeJob_State int32
+
+const (
+	ResizeJob_RUNNING   ResizeJob_State = 0
+	ResizeJob_SUCCEEDED ResizeJob_State = 1
+	ResizeJob_FAILED    ResizeJob_State = 2
+	ResizeJob_CANCELLED ResizeJob_State = 3
+)
+
+var ResizeJob_State_name = map[int32]string{
+	0: "RUNNING",
+	1: "SUCCEEDED",
+	2: "FAILED",
+	3: "CANCELLED",
+}
+var ResizeJob_State_value = map[string]int32{
+	"RUNNING":   0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+	"CANCELLED": 3,
+}
+
+func (x ResizeJob_State) String() string {
+	return proto.EnumName(ResizeJob_State_name, int32(x))
+}
+func (ResizeJob_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{103, 0} }
+
+type AdminJob_State int32
+
+const (
+	AdminJob_RUNNING   AdminJob_State = 0
+	AdminJob_SUCCEEDED AdminJob_State = 1
+	AdminJob_FAILED    AdminJob_State = 2
+	AdminJob_CANCELLED AdminJob_State = 3
+)
+
+var AdminJob_State_name = map[int32]string{
+	0: "RUNNING",
+	1: "SUCCEEDED",
+	2: "FAILED",
+	3: "CANCELLED",
+}
+var AdminJob_State_value = map[string]int32{
+	"RUNNING":   0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+	"CANCELLED": 3,
+}
+
+func (x AdminJob_State) String() string {
+	return proto.EnumName(AdminJob_State_name, int32(x))
+}
+func (AdminJob_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{115, 0} }
 
 // ////////////////////////////////////////////////
 // 1. master received request to balance the data
 type BalanceRequest struct {
-	StoreGroup string `protobuf:"bytes,2,opt,name=store_group,json=storeGroup" json:"store_group,omitempty"`
-	StoreCount uint32 `protobuf:"varint,3,opt,name=store_count,json=storeCount" json:"store_count,omitempty"`
+	StoreGroup         string `protobuf:"bytes,2,opt,name=store_group,json=storeGroup" json:"store_group,omitempty"`
+	StoreCount         uint32 `protobuf:"varint,3,opt,name=store_count,json=storeCount" json:"store_count,omitempty"`
+	DataCenter         string `protobuf:"bytes,4,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	DryRun             bool   `protobuf:"varint,5,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	MaxMoves           uint32 `protobuf:"varint,6,opt,name=max_moves,json=maxMoves" json:"max_moves,omitempty"`
+	MoveIntervalSecond uint32 `protobuf:"varint,7,opt,name=move_interval_second,json=moveIntervalSecond" json:"move_interval_second,omitempty"`
 }
 
 func (m *BalanceRequest) Reset()                    { *m = BalanceRequest{} }
@@ -175,2141 +413,5138 @@ func (m *BalanceRequest) GetStoreCount() uint32 {
 	return 0
 }
 
-type StoreHeartbeat struct {
-	// only in the initial heartbeat
-	StoreResource *StoreResource `protobuf:"bytes,1,opt,name=store_resource,json=storeResource" json:"store_resource,omitempty"`
-	// sent to master one at a time, after the initial heartbeat
-	ShardInfo *ShardInfo `protobuf:"bytes,2,opt,name=ShardInfo" json:"ShardInfo,omitempty"`
+func (m *BalanceRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
 }
 
-func (m *StoreHeartbeat) Reset()                    { *m = StoreHeartbeat{} }
-func (m *StoreHeartbeat) String() string            { return proto.CompactTextString(m) }
-func (*StoreHeartbeat) ProtoMessage()               {}
-func (*StoreHeartbeat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (m *BalanceRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
 
-func (m *StoreHeartbeat) GetStoreResource() *StoreResource {
+func (m *BalanceRequest) GetMaxMoves() uint32 {
 	if m != nil {
-		return m.StoreResource
+		return m.MaxMoves
 	}
-	return nil
+	return 0
 }
 
-func (m *StoreHeartbeat) GetShardInfo() *ShardInfo {
+func (m *BalanceRequest) GetMoveIntervalSecond() uint32 {
 	if m != nil {
-		return m.ShardInfo
+		return m.MoveIntervalSecond
 	}
-	return nil
+	return 0
 }
 
-type StoreMessage struct {
+type BalanceResponse struct {
+	Moves     []*ShardMove `protobuf:"bytes,1,rep,name=moves" json:"moves,omitempty"`
+	DoneMoves uint32       `protobuf:"varint,2,opt,name=done_moves,json=doneMoves" json:"done_moves,omitempty"`
+	Error     string       `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *StoreMessage) Reset()                    { *m = StoreMessage{} }
-func (m *StoreMessage) String() string            { return proto.CompactTextString(m) }
-func (*StoreMessage) ProtoMessage()               {}
-func (*StoreMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (m *BalanceResponse) Reset()                    { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()               {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
-type ClientHeartbeat struct {
-	ClientName    string                                `protobuf:"bytes,2,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
-	ClusterFollow *ClientHeartbeat_ClusterFollowMessage `protobuf:"bytes,3,opt,name=cluster_follow,json=clusterFollow" json:"cluster_follow,omitempty"`
+func (m *BalanceResponse) GetMoves() []*ShardMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
 }
 
-func (m *ClientHeartbeat) Reset()                    { *m = ClientHeartbeat{} }
-func (m *ClientHeartbeat) String() string            { return proto.CompactTextString(m) }
-func (*ClientHeartbeat) ProtoMessage()               {}
-func (*ClientHeartbeat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (m *BalanceResponse) GetDoneMoves() uint32 {
+	if m != nil {
+		return m.DoneMoves
+	}
+	return 0
+}
 
-func (m *ClientHeartbeat) GetClientName() string {
+func (m *BalanceResponse) GetError() string {
 	if m != nil {
-		return m.ClientName
+		return m.Error
 	}
 	return ""
 }
 
-func (m *ClientHeartbeat) GetClusterFollow() *ClientHeartbeat_ClusterFollowMessage {
+// ShardMove moves one node of a keyspace cluster, with all its shards, to another store
+type ShardMove struct {
+	Keyspace    string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId    uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	FromAddress string `protobuf:"bytes,3,opt,name=from_address,json=fromAddress" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,4,opt,name=to_address,json=toAddress" json:"to_address,omitempty"`
+	SizeBytes   uint64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes" json:"size_bytes,omitempty"`
+}
+
+func (m *ShardMove) Reset()                    { *m = ShardMove{} }
+func (m *ShardMove) String() string            { return proto.CompactTextString(m) }
+func (*ShardMove) ProtoMessage()               {}
+func (*ShardMove) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ShardMove) GetKeyspace() string {
 	if m != nil {
-		return m.ClusterFollow
+		return m.Keyspace
 	}
-	return nil
+	return ""
 }
 
-type ClientHeartbeat_ClusterFollowMessage struct {
-	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	IsUnfollow bool   `protobuf:"varint,2,opt,name=is_unfollow,json=isUnfollow" json:"is_unfollow,omitempty"`
+func (m *ShardMove) GetServerId() uint32 {
+	if m != nil {
+		return m.ServerId
+	}
+	return 0
 }
 
-func (m *ClientHeartbeat_ClusterFollowMessage) Reset()         { *m = ClientHeartbeat_ClusterFollowMessage{} }
-func (m *ClientHeartbeat_ClusterFollowMessage) String() string { return proto.CompactTextString(m) }
-func (*ClientHeartbeat_ClusterFollowMessage) ProtoMessage()    {}
-func (*ClientHeartbeat_ClusterFollowMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{3, 0}
+func (m *ShardMove) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
 }
 
-func (m *ClientHeartbeat_ClusterFollowMessage) GetKeyspace() string {
+func (m *ShardMove) GetToAddress() string {
 	if m != nil {
-		return m.Keyspace
+		return m.ToAddress
 	}
 	return ""
 }
 
-func (m *ClientHeartbeat_ClusterFollowMessage) GetIsUnfollow() bool {
+func (m *ShardMove) GetSizeBytes() uint64 {
 	if m != nil {
-		return m.IsUnfollow
+		return m.SizeBytes
 	}
-	return false
+	return 0
 }
 
-type ClientMessage struct {
-	Cluster *Cluster                           `protobuf:"bytes,1,opt,name=cluster" json:"cluster,omitempty"`
-	Updates *ClientMessage_StoreResourceUpdate `protobuf:"bytes,2,opt,name=updates" json:"updates,omitempty"`
-	Resize  *ClientMessage_Resize              `protobuf:"bytes,3,opt,name=resize" json:"resize,omitempty"`
+type StoreHeartbeat struct {
+	// only in the initial heartbeat
+	StoreResource *StoreResource `protobuf:"bytes,1,opt,name=store_resource,json=storeResource" json:"store_resource,omitempty"`
+	// sent to master one at a time, after the initial heartbeat
+	ShardInfo *ShardInfo `protobuf:"bytes,2,opt,name=ShardInfo" json:"ShardInfo,omitempty"`
+	// only in the initial heartbeat, so the master can restore the keyspace settings
+	KeyspaceSettings []*KeyspaceSettings `protobuf:"bytes,3,rep,name=keyspace_settings,json=keyspaceSettings" json:"keyspace_settings,omitempty"`
+	// sent periodically, with the lag of the shards following other data centers
+	ReplicationLags []*ReplicationLag `protobuf:"bytes,4,rep,name=replication_lags,json=replicationLags" json:"replication_lags,omitempty"`
+	// sent periodically, with the disk used by each shard
+	ShardDiskUsages []*ShardDiskUsage `protobuf:"bytes,5,rep,name=shard_disk_usages,json=shardDiskUsages" json:"shard_disk_usages,omitempty"`
+	// sent periodically, with the shards being bootstrapped
+	BootstrapProgresses []*BootstrapProgress `protobuf:"bytes,6,rep,name=bootstrap_progresses,json=bootstrapProgresses" json:"bootstrap_progresses,omitempty"`
+	// sent periodically, with how far each shard is behind the peers it follows
+	FollowLags []*FollowLag `protobuf:"bytes,7,rep,name=follow_lags,json=followLags" json:"follow_lags,omitempty"`
+	// only in the initial heartbeat, so the master can restore the index definitions
+	KeyspaceIndexes []*KeyspaceIndexes `protobuf:"bytes,8,rep,name=keyspace_indexes,json=keyspaceIndexes" json:"keyspace_indexes,omitempty"`
 }
 
-func (m *ClientMessage) Reset()                    { *m = ClientMessage{} }
-func (m *ClientMessage) String() string            { return proto.CompactTextString(m) }
-func (*ClientMessage) ProtoMessage()               {}
-func (*ClientMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (m *StoreHeartbeat) Reset()                    { *m = StoreHeartbeat{} }
+func (m *StoreHeartbeat) String() string            { return proto.CompactTextString(m) }
+func (*StoreHeartbeat) ProtoMessage()               {}
+func (*StoreHeartbeat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
-func (m *ClientMessage) GetCluster() *Cluster {
+func (m *StoreHeartbeat) GetStoreResource() *StoreResource {
 	if m != nil {
-		return m.Cluster
+		return m.StoreResource
 	}
 	return nil
 }
 
-func (m *ClientMessage) GetUpdates() *ClientMessage_StoreResourceUpdate {
+func (m *StoreHeartbeat) GetShardInfo() *ShardInfo {
 	if m != nil {
-		return m.Updates
+		return m.ShardInfo
 	}
 	return nil
 }
 
-func (m *ClientMessage) GetResize() *ClientMessage_Resize {
+func (m *StoreHeartbeat) GetKeyspaceSettings() []*KeyspaceSettings {
 	if m != nil {
-		return m.Resize
+		return m.KeyspaceSettings
 	}
 	return nil
 }
 
-type ClientMessage_StoreResourceUpdate struct {
-	Nodes       []*ClusterNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
-	IsDelete    bool           `protobuf:"varint,2,opt,name=is_delete,json=isDelete" json:"is_delete,omitempty"`
-	Keyspace    string         `protobuf:"bytes,3,opt,name=keyspace" json:"keyspace,omitempty"`
-	IsPromotion bool           `protobuf:"varint,4,opt,name=is_promotion,json=isPromotion" json:"is_promotion,omitempty"`
+func (m *StoreHeartbeat) GetReplicationLags() []*ReplicationLag {
+	if m != nil {
+		return m.ReplicationLags
+	}
+	return nil
 }
 
-func (m *ClientMessage_StoreResourceUpdate) Reset()         { *m = ClientMessage_StoreResourceUpdate{} }
-func (m *ClientMessage_StoreResourceUpdate) String() string { return proto.CompactTextString(m) }
-func (*ClientMessage_StoreResourceUpdate) ProtoMessage()    {}
-func (*ClientMessage_StoreResourceUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{4, 0}
+func (m *StoreHeartbeat) GetShardDiskUsages() []*ShardDiskUsage {
+	if m != nil {
+		return m.ShardDiskUsages
+	}
+	return nil
 }
 
-func (m *ClientMessage_StoreResourceUpdate) GetNodes() []*ClusterNode {
+func (m *StoreHeartbeat) GetBootstrapProgresses() []*BootstrapProgress {
 	if m != nil {
-		return m.Nodes
+		return m.BootstrapProgresses
 	}
 	return nil
 }
 
-func (m *ClientMessage_StoreResourceUpdate) GetIsDelete() bool {
+func (m *StoreHeartbeat) GetFollowLags() []*FollowLag {
 	if m != nil {
-		return m.IsDelete
+		return m.FollowLags
 	}
-	return false
+	return nil
 }
 
-func (m *ClientMessage_StoreResourceUpdate) GetKeyspace() string {
+func (m *StoreHeartbeat) GetKeyspaceIndexes() []*KeyspaceIndexes {
 	if m != nil {
-		return m.Keyspace
+		return m.KeyspaceIndexes
 	}
-	return ""
+	return nil
 }
 
-func (m *ClientMessage_StoreResourceUpdate) GetIsPromotion() bool {
+type StoreMessage struct {
+	// the clusters of the same keyspaces in other data centers, as the reply to the periodic heartbeat
+	RemoteClusters []*Cluster `protobuf:"bytes,1,rep,name=remote_clusters,json=remoteClusters" json:"remote_clusters,omitempty"`
+}
+
+func (m *StoreMessage) Reset()                    { *m = StoreMessage{} }
+func (m *StoreMessage) String() string            { return proto.CompactTextString(m) }
+func (*StoreMessage) ProtoMessage()               {}
+func (*StoreMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *StoreMessage) GetRemoteClusters() []*Cluster {
 	if m != nil {
-		return m.IsPromotion
+		return m.RemoteClusters
 	}
-	return false
+	return nil
 }
 
-type ClientMessage_Resize struct {
-	CurrentClusterSize uint32 `protobuf:"varint,1,opt,name=current_cluster_size,json=currentClusterSize" json:"current_cluster_size,omitempty"`
-	TargetClusterSize  uint32 `protobuf:"varint,2,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
-	Keyspace           string `protobuf:"bytes,3,opt,name=keyspace" json:"keyspace,omitempty"`
+// ShardDiskUsage is the live sst files size of one shard
+type ShardDiskUsage struct {
+	Keyspace  string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId  uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId   uint32 `protobuf:"varint,3,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes" json:"size_bytes,omitempty"`
 }
 
-func (m *ClientMessage_Resize) Reset()                    { *m = ClientMessage_Resize{} }
-func (m *ClientMessage_Resize) String() string            { return proto.CompactTextString(m) }
-func (*ClientMessage_Resize) ProtoMessage()               {}
-func (*ClientMessage_Resize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4, 1} }
+func (m *ShardDiskUsage) Reset()                    { *m = ShardDiskUsage{} }
+func (m *ShardDiskUsage) String() string            { return proto.CompactTextString(m) }
+func (*ShardDiskUsage) ProtoMessage()               {}
+func (*ShardDiskUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
-func (m *ClientMessage_Resize) GetCurrentClusterSize() uint32 {
+func (m *ShardDiskUsage) GetKeyspace() string {
 	if m != nil {
-		return m.CurrentClusterSize
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ShardDiskUsage) GetServerId() uint32 {
+	if m != nil {
+		return m.ServerId
 	}
 	return 0
 }
 
-func (m *ClientMessage_Resize) GetTargetClusterSize() uint32 {
+func (m *ShardDiskUsage) GetShardId() uint32 {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.ShardId
 	}
 	return 0
 }
 
-func (m *ClientMessage_Resize) GetKeyspace() string {
+func (m *ShardDiskUsage) GetSizeBytes() uint64 {
 	if m != nil {
-		return m.Keyspace
+		return m.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-type Cluster struct {
-	Keyspace            string         `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	Nodes               []*ClusterNode `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
-	ExpectedClusterSize uint32         `protobuf:"varint,4,opt,name=expected_cluster_size,json=expectedClusterSize" json:"expected_cluster_size,omitempty"`
-	CurrentClusterSize  uint32         `protobuf:"varint,5,opt,name=current_cluster_size,json=currentClusterSize" json:"current_cluster_size,omitempty"`
-	ReplicationFactor   uint32         `protobuf:"varint,6,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+// BootstrapProgress is how much one shard has copied from its bootstrap source
+type BootstrapProgress struct {
+	Keyspace      string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId      uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId       uint32 `protobuf:"varint,3,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Source        string `protobuf:"bytes,4,opt,name=source" json:"source,omitempty"`
+	CopiedEntries uint64 `protobuf:"varint,5,opt,name=copied_entries,json=copiedEntries" json:"copied_entries,omitempty"`
+	CopiedBytes   uint64 `protobuf:"varint,6,opt,name=copied_bytes,json=copiedBytes" json:"copied_bytes,omitempty"`
+	Retries       uint32 `protobuf:"varint,7,opt,name=retries" json:"retries,omitempty"`
 }
 
-func (m *Cluster) Reset()                    { *m = Cluster{} }
-func (m *Cluster) String() string            { return proto.CompactTextString(m) }
-func (*Cluster) ProtoMessage()               {}
-func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (m *BootstrapProgress) Reset()                    { *m = BootstrapProgress{} }
+func (m *BootstrapProgress) String() string            { return proto.CompactTextString(m) }
+func (*BootstrapProgress) ProtoMessage()               {}
+func (*BootstrapProgress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
-func (m *Cluster) GetKeyspace() string {
+func (m *BootstrapProgress) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *Cluster) GetNodes() []*ClusterNode {
+func (m *BootstrapProgress) GetServerId() uint32 {
 	if m != nil {
-		return m.Nodes
+		return m.ServerId
 	}
-	return nil
+	return 0
 }
 
-func (m *Cluster) GetExpectedClusterSize() uint32 {
+func (m *BootstrapProgress) GetShardId() uint32 {
 	if m != nil {
-		return m.ExpectedClusterSize
+		return m.ShardId
 	}
 	return 0
 }
 
-func (m *Cluster) GetCurrentClusterSize() uint32 {
+func (m *BootstrapProgress) GetSource() string {
 	if m != nil {
-		return m.CurrentClusterSize
+		return m.Source
 	}
-	return 0
+	return ""
 }
 
-func (m *Cluster) GetReplicationFactor() uint32 {
+func (m *BootstrapProgress) GetCopiedEntries() uint64 {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.CopiedEntries
 	}
 	return 0
 }
 
-// denormalized
-type ClusterNode struct {
-	StoreResource *StoreResource `protobuf:"bytes,1,opt,name=store_resource,json=storeResource" json:"store_resource,omitempty"`
-	ShardInfo     *ShardInfo     `protobuf:"bytes,2,opt,name=shard_info,json=shardInfo" json:"shard_info,omitempty"`
-}
-
-func (m *ClusterNode) Reset()                    { *m = ClusterNode{} }
-func (m *ClusterNode) String() string            { return proto.CompactTextString(m) }
-func (*ClusterNode) ProtoMessage()               {}
-func (*ClusterNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
-
-func (m *ClusterNode) GetStoreResource() *StoreResource {
+func (m *BootstrapProgress) GetCopiedBytes() uint64 {
 	if m != nil {
-		return m.StoreResource
+		return m.CopiedBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *ClusterNode) GetShardInfo() *ShardInfo {
+func (m *BootstrapProgress) GetRetries() uint32 {
 	if m != nil {
-		return m.ShardInfo
+		return m.Retries
 	}
-	return nil
+	return 0
 }
 
-type StoreResource struct {
-	Network         string   `protobuf:"bytes,2,opt,name=network" json:"network,omitempty"`
-	Address         string   `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
-	AdminAddress    string   `protobuf:"bytes,4,opt,name=admin_address,json=adminAddress" json:"admin_address,omitempty"`
-	Tags            []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
-	DiskSizeGb      uint32   `protobuf:"varint,8,opt,name=disk_size_gb,json=diskSizeGb" json:"disk_size_gb,omitempty"`
-	AllocatedSizeGb uint32   `protobuf:"varint,9,opt,name=allocated_size_gb,json=allocatedSizeGb" json:"allocated_size_gb,omitempty"`
+// FollowLag is how far one shard is behind the shards it follows in the same data center
+type FollowLag struct {
+	Keyspace       string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId       uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId        uint32 `protobuf:"varint,3,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	LagMillisecond int64  `protobuf:"varint,4,opt,name=lag_millisecond,json=lagMillisecond" json:"lag_millisecond,omitempty"`
 }
 
-func (m *StoreResource) Reset()                    { *m = StoreResource{} }
-func (m *StoreResource) String() string            { return proto.CompactTextString(m) }
-func (*StoreResource) ProtoMessage()               {}
-func (*StoreResource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (m *FollowLag) Reset()                    { *m = FollowLag{} }
+func (m *FollowLag) String() string            { return proto.CompactTextString(m) }
+func (*FollowLag) ProtoMessage()               {}
+func (*FollowLag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
-func (m *StoreResource) GetNetwork() string {
+func (m *FollowLag) GetKeyspace() string {
 	if m != nil {
-		return m.Network
+		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *StoreResource) GetAddress() string {
+func (m *FollowLag) GetServerId() uint32 {
 	if m != nil {
-		return m.Address
+		return m.ServerId
 	}
-	return ""
+	return 0
 }
 
-func (m *StoreResource) GetAdminAddress() string {
+func (m *FollowLag) GetShardId() uint32 {
 	if m != nil {
-		return m.AdminAddress
+		return m.ShardId
 	}
-	return ""
+	return 0
 }
 
-func (m *StoreResource) GetTags() []string {
+func (m *FollowLag) GetLagMillisecond() int64 {
 	if m != nil {
-		return m.Tags
+		return m.LagMillisecond
 	}
-	return nil
+	return 0
 }
 
-func (m *StoreResource) GetDiskSizeGb() uint32 {
-	if m != nil {
-		return m.DiskSizeGb
-	}
-	return 0
+// ReplicationLag is how far one shard is behind the same keyspace in another data center
+type ReplicationLag struct {
+	Keyspace         string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ShardId          uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	SourceDataCenter string `protobuf:"bytes,3,opt,name=source_data_center,json=sourceDataCenter" json:"source_data_center,omitempty"`
+	TargetDataCenter string `protobuf:"bytes,4,opt,name=target_data_center,json=targetDataCenter" json:"target_data_center,omitempty"`
+	LagMillisecond   int64  `protobuf:"varint,5,opt,name=lag_millisecond,json=lagMillisecond" json:"lag_millisecond,omitempty"`
 }
 
-func (m *StoreResource) GetAllocatedSizeGb() uint32 {
+func (m *ReplicationLag) Reset()                    { *m = ReplicationLag{} }
+func (m *ReplicationLag) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationLag) ProtoMessage()               {}
+func (*ReplicationLag) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ReplicationLag) GetKeyspace() string {
 	if m != nil {
-		return m.AllocatedSizeGb
+		return m.Keyspace
 	}
-	return 0
-}
-
-// LocalShardsInCluster is saved to and load from disk
-type LocalShardsInCluster struct {
-	Id       uint32                `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	ShardMap map[uint32]*ShardInfo `protobuf:"bytes,2,rep,name=shard_map,json=shardMap" json:"shard_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// duplicated info, need to validate on master when reconvene
-	ClusterSize uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	// duplicated info, need to validate on master when reconvene
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	return ""
 }
 
-func (m *LocalShardsInCluster) Reset()                    { *m = LocalShardsInCluster{} }
-func (m *LocalShardsInCluster) String() string            { return proto.CompactTextString(m) }
-func (*LocalShardsInCluster) ProtoMessage()               {}
-func (*LocalShardsInCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
-
-func (m *LocalShardsInCluster) GetId() uint32 {
+func (m *ReplicationLag) GetShardId() uint32 {
 	if m != nil {
-		return m.Id
+		return m.ShardId
 	}
 	return 0
 }
 
-func (m *LocalShardsInCluster) GetShardMap() map[uint32]*ShardInfo {
+func (m *ReplicationLag) GetSourceDataCenter() string {
 	if m != nil {
-		return m.ShardMap
+		return m.SourceDataCenter
 	}
-	return nil
+	return ""
 }
 
-func (m *LocalShardsInCluster) GetClusterSize() uint32 {
+func (m *ReplicationLag) GetTargetDataCenter() string {
 	if m != nil {
-		return m.ClusterSize
+		return m.TargetDataCenter
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalShardsInCluster) GetReplicationFactor() uint32 {
+func (m *ReplicationLag) GetLagMillisecond() int64 {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.LagMillisecond
 	}
 	return 0
 }
 
-type ShardInfo struct {
-	KeyspaceName      string           `protobuf:"bytes,1,opt,name=keyspace_name,json=keyspaceName" json:"keyspace_name,omitempty"`
-	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ShardId           uint32           `protobuf:"varint,3,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
-	ClusterSize       uint32           `protobuf:"varint,4,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32           `protobuf:"varint,5,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
-	Status            ShardInfo_Status `protobuf:"varint,6,opt,name=status,enum=pb.ShardInfo_Status" json:"status,omitempty"`
-	IsCandidate       bool             `protobuf:"varint,7,opt,name=is_candidate,json=isCandidate" json:"is_candidate,omitempty"`
-	IsPermanentDelete bool             `protobuf:"varint,8,opt,name=is_permanent_delete,json=isPermanentDelete" json:"is_permanent_delete,omitempty"`
+type ClientHeartbeat struct {
+	ClientName    string                                `protobuf:"bytes,2,opt,name=client_name,json=clientName" json:"client_name,omitempty"`
+	ClusterFollow *ClientHeartbeat_ClusterFollowMessage `protobuf:"bytes,3,opt,name=cluster_follow,json=clusterFollow" json:"cluster_follow,omitempty"`
 }
 
-func (m *ShardInfo) Reset()                    { *m = ShardInfo{} }
-func (m *ShardInfo) String() string            { return proto.CompactTextString(m) }
-func (*ShardInfo) ProtoMessage()               {}
-func (*ShardInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (m *ClientHeartbeat) Reset()                    { *m = ClientHeartbeat{} }
+func (m *ClientHeartbeat) String() string            { return proto.CompactTextString(m) }
+func (*ClientHeartbeat) ProtoMessage()               {}
+func (*ClientHeartbeat) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
-func (m *ShardInfo) GetKeyspaceName() string {
+func (m *ClientHeartbeat) GetClientName() string {
 	if m != nil {
-		return m.KeyspaceName
+		return m.ClientName
 	}
 	return ""
 }
 
-func (m *ShardInfo) GetServerId() uint32 {
+func (m *ClientHeartbeat) GetClusterFollow() *ClientHeartbeat_ClusterFollowMessage {
 	if m != nil {
-		return m.ServerId
+		return m.ClusterFollow
 	}
-	return 0
+	return nil
 }
 
-func (m *ShardInfo) GetShardId() uint32 {
-	if m != nil {
-		return m.ShardId
-	}
-	return 0
+type ClientHeartbeat_ClusterFollowMessage struct {
+	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	IsUnfollow bool   `protobuf:"varint,2,opt,name=is_unfollow,json=isUnfollow" json:"is_unfollow,omitempty"`
+	DataCenter string `protobuf:"bytes,3,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	// only follow the cluster in the data center, instead of falling back to other data centers
+	DisableDataCenterFallback bool `protobuf:"varint,4,opt,name=disable_data_center_fallback,json=disableDataCenterFallback" json:"disable_data_center_fallback,omitempty"`
 }
 
-func (m *ShardInfo) GetClusterSize() uint32 {
-	if m != nil {
-		return m.ClusterSize
-	}
-	return 0
+func (m *ClientHeartbeat_ClusterFollowMessage) Reset()         { *m = ClientHeartbeat_ClusterFollowMessage{} }
+func (m *ClientHeartbeat_ClusterFollowMessage) String() string { return proto.CompactTextString(m) }
+func (*ClientHeartbeat_ClusterFollowMessage) ProtoMessage()    {}
+func (*ClientHeartbeat_ClusterFollowMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{9, 0}
 }
 
-func (m *ShardInfo) GetReplicationFactor() uint32 {
+func (m *ClientHeartbeat_ClusterFollowMessage) GetKeyspace() string {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.Keyspace
 	}
-	return 0
+	return ""
 }
 
-func (m *ShardInfo) GetStatus() ShardInfo_Status {
+func (m *ClientHeartbeat_ClusterFollowMessage) GetIsUnfollow() bool {
 	if m != nil {
-		return m.Status
+		return m.IsUnfollow
 	}
-	return ShardInfo_EMPTY
+	return false
 }
 
-func (m *ShardInfo) GetIsCandidate() bool {
+func (m *ClientHeartbeat_ClusterFollowMessage) GetDataCenter() string {
 	if m != nil {
-		return m.IsCandidate
+		return m.DataCenter
 	}
-	return false
+	return ""
 }
 
-func (m *ShardInfo) GetIsPermanentDelete() bool {
+func (m *ClientHeartbeat_ClusterFollowMessage) GetDisableDataCenterFallback() bool {
 	if m != nil {
-		return m.IsPermanentDelete
+		return m.DisableDataCenterFallback
 	}
 	return false
 }
 
-type Empty struct {
-}
-
-func (m *Empty) Reset()                    { *m = Empty{} }
-func (m *Empty) String() string            { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()               {}
-func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
-
-type KeyTypeValue struct {
-	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
-	DataType      OpAndDataType `protobuf:"varint,3,opt,name=data_type,json=dataType,enum=pb.OpAndDataType" json:"data_type,omitempty"`
-	Value         []byte        `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+type ClientMessage struct {
+	Cluster                 *Cluster                               `protobuf:"bytes,1,opt,name=cluster" json:"cluster,omitempty"`
+	Updates                 *ClientMessage_StoreResourceUpdate     `protobuf:"bytes,2,opt,name=updates" json:"updates,omitempty"`
+	Resize                  *ClientMessage_Resize                  `protobuf:"bytes,3,opt,name=resize" json:"resize,omitempty"`
+	ReplicationFactorChange *ClientMessage_ReplicationFactorChange `protobuf:"bytes,4,opt,name=replication_factor_change,json=replicationFactorChange" json:"replication_factor_change,omitempty"`
 }
 
-func (m *KeyTypeValue) Reset()                    { *m = KeyTypeValue{} }
-func (m *KeyTypeValue) String() string            { return proto.CompactTextString(m) }
-func (*KeyTypeValue) ProtoMessage()               {}
-func (*KeyTypeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (m *ClientMessage) Reset()                    { *m = ClientMessage{} }
+func (m *ClientMessage) String() string            { return proto.CompactTextString(m) }
+func (*ClientMessage) ProtoMessage()               {}
+func (*ClientMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
-func (m *KeyTypeValue) GetKey() []byte {
+func (m *ClientMessage) GetCluster() *Cluster {
 	if m != nil {
-		return m.Key
+		return m.Cluster
 	}
 	return nil
 }
 
-func (m *KeyTypeValue) GetPartitionHash() uint64 {
+func (m *ClientMessage) GetUpdates() *ClientMessage_StoreResourceUpdate {
 	if m != nil {
-		return m.PartitionHash
+		return m.Updates
 	}
-	return 0
+	return nil
 }
 
-func (m *KeyTypeValue) GetDataType() OpAndDataType {
+func (m *ClientMessage) GetResize() *ClientMessage_Resize {
 	if m != nil {
-		return m.DataType
+		return m.Resize
 	}
-	return OpAndDataType_BYTES
+	return nil
 }
 
-func (m *KeyTypeValue) GetValue() []byte {
+func (m *ClientMessage) GetReplicationFactorChange() *ClientMessage_ReplicationFactorChange {
 	if m != nil {
-		return m.Value
+		return m.ReplicationFactorChange
 	}
 	return nil
 }
 
-// ////////////////////////////////////////////////
-// // data queries
-// ////////////////////////////////////////////////
-type Requests struct {
-	Keyspace string     `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	Requests []*Request `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+type ClientMessage_StoreResourceUpdate struct {
+	Nodes       []*ClusterNode `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+	IsDelete    bool           `protobuf:"varint,2,opt,name=is_delete,json=isDelete" json:"is_delete,omitempty"`
+	Keyspace    string         `protobuf:"bytes,3,opt,name=keyspace" json:"keyspace,omitempty"`
+	IsPromotion bool           `protobuf:"varint,4,opt,name=is_promotion,json=isPromotion" json:"is_promotion,omitempty"`
 }
 
-func (m *Requests) Reset()                    { *m = Requests{} }
-func (m *Requests) String() string            { return proto.CompactTextString(m) }
-func (*Requests) ProtoMessage()               {}
-func (*Requests) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (m *ClientMessage_StoreResourceUpdate) Reset()         { *m = ClientMessage_StoreResourceUpdate{} }
+func (m *ClientMessage_StoreResourceUpdate) String() string { return proto.CompactTextString(m) }
+func (*ClientMessage_StoreResourceUpdate) ProtoMessage()    {}
+func (*ClientMessage_StoreResourceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{10, 0}
+}
 
-func (m *Requests) GetKeyspace() string {
+func (m *ClientMessage_StoreResourceUpdate) GetNodes() []*ClusterNode {
 	if m != nil {
-		return m.Keyspace
+		return m.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (m *Requests) GetRequests() []*Request {
+func (m *ClientMessage_StoreResourceUpdate) GetIsDelete() bool {
 	if m != nil {
-		return m.Requests
+		return m.IsDelete
 	}
-	return nil
+	return false
 }
 
-type Responses struct {
-	Responses []*Response `protobuf:"bytes,1,rep,name=responses" json:"responses,omitempty"`
+func (m *ClientMessage_StoreResourceUpdate) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
 }
 
-func (m *Responses) Reset()                    { *m = Responses{} }
-func (m *Responses) String() string            { return proto.CompactTextString(m) }
-func (*Responses) ProtoMessage()               {}
-func (*Responses) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
-
-func (m *Responses) GetResponses() []*Response {
+func (m *ClientMessage_StoreResourceUpdate) GetIsPromotion() bool {
 	if m != nil {
-		return m.Responses
+		return m.IsPromotion
 	}
-	return nil
+	return false
 }
 
-type Request struct {
-	ShardId     uint32              `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
-	Put         *PutRequest         `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
-	Get         *GetRequest         `protobuf:"bytes,3,opt,name=get" json:"get,omitempty"`
-	GetByPrefix *GetByPrefixRequest `protobuf:"bytes,4,opt,name=get_by_prefix,json=getByPrefix" json:"get_by_prefix,omitempty"`
-	Delete      *DeleteRequest      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
-	Merge       *MergeRequest       `protobuf:"bytes,6,opt,name=merge" json:"merge,omitempty"`
+type ClientMessage_Resize struct {
+	CurrentClusterSize uint32 `protobuf:"varint,1,opt,name=current_cluster_size,json=currentClusterSize" json:"current_cluster_size,omitempty"`
+	TargetClusterSize  uint32 `protobuf:"varint,2,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	Keyspace           string `protobuf:"bytes,3,opt,name=keyspace" json:"keyspace,omitempty"`
 }
 
-func (m *Request) Reset()                    { *m = Request{} }
-func (m *Request) String() string            { return proto.CompactTextString(m) }
-func (*Request) ProtoMessage()               {}
-func (*Request) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (m *ClientMessage_Resize) Reset()                    { *m = ClientMessage_Resize{} }
+func (m *ClientMessage_Resize) String() string            { return proto.CompactTextString(m) }
+func (*ClientMessage_Resize) ProtoMessage()               {}
+func (*ClientMessage_Resize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10, 1} }
 
-func (m *Request) GetShardId() uint32 {
+func (m *ClientMessage_Resize) GetCurrentClusterSize() uint32 {
 	if m != nil {
-		return m.ShardId
+		return m.CurrentClusterSize
 	}
 	return 0
 }
 
-func (m *Request) GetPut() *PutRequest {
+func (m *ClientMessage_Resize) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.Put
+		return m.TargetClusterSize
 	}
-	return nil
+	return 0
 }
 
-func (m *Request) GetGet() *GetRequest {
+func (m *ClientMessage_Resize) GetKeyspace() string {
 	if m != nil {
-		return m.Get
+		return m.Keyspace
 	}
-	return nil
+	return ""
 }
 
-func (m *Request) GetGetByPrefix() *GetByPrefixRequest {
-	if m != nil {
-		return m.GetByPrefix
-	}
-	return nil
+type ClientMessage_ReplicationFactorChange struct {
+	ReplicationFactor uint32 `protobuf:"varint,1,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	Keyspace          string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
 }
 
-func (m *Request) GetDelete() *DeleteRequest {
+func (m *ClientMessage_ReplicationFactorChange) Reset()         { *m = ClientMessage_ReplicationFactorChange{} }
+func (m *ClientMessage_ReplicationFactorChange) String() string { return proto.CompactTextString(m) }
+func (*ClientMessage_ReplicationFactorChange) ProtoMessage()    {}
+func (*ClientMessage_ReplicationFactorChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{10, 2}
+}
+
+func (m *ClientMessage_ReplicationFactorChange) GetReplicationFactor() uint32 {
 	if m != nil {
-		return m.Delete
+		return m.ReplicationFactor
 	}
-	return nil
+	return 0
 }
 
-func (m *Request) GetMerge() *MergeRequest {
+func (m *ClientMessage_ReplicationFactorChange) GetKeyspace() string {
 	if m != nil {
-		return m.Merge
+		return m.Keyspace
 	}
-	return nil
+	return ""
 }
 
-type PutRequest struct {
-	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
-	UpdatedAtNs   uint64        `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
-	TtlSecond     uint32        `protobuf:"varint,4,opt,name=ttl_second,json=ttlSecond" json:"ttl_second,omitempty"`
-	OpAndDataType OpAndDataType `protobuf:"varint,5,opt,name=op_and_data_type,json=opAndDataType,enum=pb.OpAndDataType" json:"op_and_data_type,omitempty"`
-	Value         []byte        `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
-}
+type Cluster struct {
+	Keyspace            string         `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Nodes               []*ClusterNode `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+	ExpectedClusterSize uint32         `protobuf:"varint,4,opt,name=expected_cluster_size,json=expectedClusterSize" json:"expected_cluster_size,omitempty"`
+	CurrentClusterSize  uint32         `protobuf:"varint,5,opt,name=current_cluster_size,json=currentClusterSize" json:"current_cluster_size,omitempty"`
+	ReplicationFactor   uint32         `protobuf:"varint,6,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	DataCenter          string         `protobuf:"bytes,7,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	// the number of virtual shards, 0 means one shard per server
+	ShardCount uint32 `protobuf:"varint,8,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+}
 
-func (m *PutRequest) Reset()                    { *m = PutRequest{} }
-func (m *PutRequest) String() string            { return proto.CompactTextString(m) }
-func (*PutRequest) ProtoMessage()               {}
-func (*PutRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (m *Cluster) Reset()                    { *m = Cluster{} }
+func (m *Cluster) String() string            { return proto.CompactTextString(m) }
+func (*Cluster) ProtoMessage()               {}
+func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
-func (m *PutRequest) GetKey() []byte {
+func (m *Cluster) GetKeyspace() string {
 	if m != nil {
-		return m.Key
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *Cluster) GetNodes() []*ClusterNode {
+	if m != nil {
+		return m.Nodes
 	}
 	return nil
 }
 
-func (m *PutRequest) GetPartitionHash() uint64 {
+func (m *Cluster) GetExpectedClusterSize() uint32 {
 	if m != nil {
-		return m.PartitionHash
+		return m.ExpectedClusterSize
 	}
 	return 0
 }
 
-func (m *PutRequest) GetUpdatedAtNs() uint64 {
+func (m *Cluster) GetCurrentClusterSize() uint32 {
 	if m != nil {
-		return m.UpdatedAtNs
+		return m.CurrentClusterSize
 	}
 	return 0
 }
 
-func (m *PutRequest) GetTtlSecond() uint32 {
+func (m *Cluster) GetReplicationFactor() uint32 {
 	if m != nil {
-		return m.TtlSecond
+		return m.ReplicationFactor
 	}
 	return 0
 }
 
-func (m *PutRequest) GetOpAndDataType() OpAndDataType {
+func (m *Cluster) GetDataCenter() string {
 	if m != nil {
-		return m.OpAndDataType
+		return m.DataCenter
 	}
-	return OpAndDataType_BYTES
+	return ""
 }
 
-func (m *PutRequest) GetValue() []byte {
+func (m *Cluster) GetShardCount() uint32 {
 	if m != nil {
-		return m.Value
+		return m.ShardCount
 	}
-	return nil
+	return 0
 }
 
-type MergeRequest struct {
-	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
-	UpdatedAtNs   uint64        `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
-	OpAndDataType OpAndDataType `protobuf:"varint,4,opt,name=op_and_data_type,json=opAndDataType,enum=pb.OpAndDataType" json:"op_and_data_type,omitempty"`
-	Value         []byte        `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+// denormalized
+type ClusterNode struct {
+	StoreResource *StoreResource `protobuf:"bytes,1,opt,name=store_resource,json=storeResource" json:"store_resource,omitempty"`
+	ShardInfo     *ShardInfo     `protobuf:"bytes,2,opt,name=shard_info,json=shardInfo" json:"shard_info,omitempty"`
 }
 
-func (m *MergeRequest) Reset()                    { *m = MergeRequest{} }
-func (m *MergeRequest) String() string            { return proto.CompactTextString(m) }
-func (*MergeRequest) ProtoMessage()               {}
-func (*MergeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (m *ClusterNode) Reset()                    { *m = ClusterNode{} }
+func (m *ClusterNode) String() string            { return proto.CompactTextString(m) }
+func (*ClusterNode) ProtoMessage()               {}
+func (*ClusterNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
-func (m *MergeRequest) GetKey() []byte {
+func (m *ClusterNode) GetStoreResource() *StoreResource {
 	if m != nil {
-		return m.Key
+		return m.StoreResource
 	}
 	return nil
 }
 
-func (m *MergeRequest) GetPartitionHash() uint64 {
+func (m *ClusterNode) GetShardInfo() *ShardInfo {
 	if m != nil {
-		return m.PartitionHash
+		return m.ShardInfo
 	}
-	return 0
+	return nil
 }
 
-func (m *MergeRequest) GetUpdatedAtNs() uint64 {
+type StoreResource struct {
+	Network         string   `protobuf:"bytes,2,opt,name=network" json:"network,omitempty"`
+	Address         string   `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	AdminAddress    string   `protobuf:"bytes,4,opt,name=admin_address,json=adminAddress" json:"admin_address,omitempty"`
+	Tags            []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`
+	DiskSizeGb      uint32   `protobuf:"varint,8,opt,name=disk_size_gb,json=diskSizeGb" json:"disk_size_gb,omitempty"`
+	AllocatedSizeGb uint32   `protobuf:"varint,9,opt,name=allocated_size_gb,json=allocatedSizeGb" json:"allocated_size_gb,omitempty"`
+	DataCenter      string   `protobuf:"bytes,10,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	// failure domain labels, the copies of a shard are placed on distinct zones or racks
+	Zone string `protobuf:"bytes,11,opt,name=zone" json:"zone,omitempty"`
+	Rack string `protobuf:"bytes,12,opt,name=rack" json:"rack,omitempty"`
+}
+
+func (m *StoreResource) Reset()                    { *m = StoreResource{} }
+func (m *StoreResource) String() string            { return proto.CompactTextString(m) }
+func (*StoreResource) ProtoMessage()               {}
+func (*StoreResource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *StoreResource) GetNetwork() string {
 	if m != nil {
-		return m.UpdatedAtNs
+		return m.Network
 	}
-	return 0
+	return ""
 }
 
-func (m *MergeRequest) GetOpAndDataType() OpAndDataType {
+func (m *StoreResource) GetAddress() string {
 	if m != nil {
-		return m.OpAndDataType
+		return m.Address
 	}
-	return OpAndDataType_BYTES
+	return ""
 }
 
-func (m *MergeRequest) GetValue() []byte {
+func (m *StoreResource) GetAdminAddress() string {
 	if m != nil {
-		return m.Value
+		return m.AdminAddress
+	}
+	return ""
+}
+
+func (m *StoreResource) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
 	return nil
 }
 
-type WriteResponse struct {
-	Ok     bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
-	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+func (m *StoreResource) GetDiskSizeGb() uint32 {
+	if m != nil {
+		return m.DiskSizeGb
+	}
+	return 0
 }
 
-func (m *WriteResponse) Reset()                    { *m = WriteResponse{} }
-func (m *WriteResponse) String() string            { return proto.CompactTextString(m) }
-func (*WriteResponse) ProtoMessage()               {}
-func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (m *StoreResource) GetAllocatedSizeGb() uint32 {
+	if m != nil {
+		return m.AllocatedSizeGb
+	}
+	return 0
+}
 
-func (m *WriteResponse) GetOk() bool {
+func (m *StoreResource) GetDataCenter() string {
 	if m != nil {
-		return m.Ok
+		return m.DataCenter
 	}
-	return false
+	return ""
 }
 
-func (m *WriteResponse) GetStatus() string {
+func (m *StoreResource) GetZone() string {
 	if m != nil {
-		return m.Status
+		return m.Zone
 	}
 	return ""
 }
 
-type DeleteRequest struct {
-	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
-	UpdatedAtNs   uint64 `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+func (m *StoreResource) GetRack() string {
+	if m != nil {
+		return m.Rack
+	}
+	return ""
 }
 
-func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+// LocalShardsInCluster is saved to and load from disk
+type LocalShardsInCluster struct {
+	Id       uint32                `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ShardMap map[uint32]*ShardInfo `protobuf:"bytes,2,rep,name=shard_map,json=shardMap" json:"shard_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// duplicated info, need to validate on master when reconvene
+	ClusterSize uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	// duplicated info, need to validate on master when reconvene
+	ReplicationFactor uint32             `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	Indexes           []*IndexDefinition `protobuf:"bytes,5,rep,name=indexes" json:"indexes,omitempty"`
+	Settings          *KeyspaceSettings  `protobuf:"bytes,6,opt,name=settings" json:"settings,omitempty"`
+	// duplicated info, the number of virtual shards, 0 means one shard per server
+	ShardCount uint32 `protobuf:"varint,7,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+	// when the indexes are last defined by the master
+	IndexesUpdatedAtNs uint64 `protobuf:"varint,8,opt,name=indexes_updated_at_ns,json=indexesUpdatedAtNs" json:"indexes_updated_at_ns,omitempty"`
+}
 
-func (m *DeleteRequest) GetKey() []byte {
+func (m *LocalShardsInCluster) Reset()                    { *m = LocalShardsInCluster{} }
+func (m *LocalShardsInCluster) String() string            { return proto.CompactTextString(m) }
+func (*LocalShardsInCluster) ProtoMessage()               {}
+func (*LocalShardsInCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *LocalShardsInCluster) GetId() uint32 {
 	if m != nil {
-		return m.Key
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LocalShardsInCluster) GetShardMap() map[uint32]*ShardInfo {
+	if m != nil {
+		return m.ShardMap
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetPartitionHash() uint64 {
+func (m *LocalShardsInCluster) GetClusterSize() uint32 {
 	if m != nil {
-		return m.PartitionHash
+		return m.ClusterSize
 	}
 	return 0
 }
 
-func (m *DeleteRequest) GetUpdatedAtNs() uint64 {
+func (m *LocalShardsInCluster) GetReplicationFactor() uint32 {
 	if m != nil {
-		return m.UpdatedAtNs
+		return m.ReplicationFactor
 	}
 	return 0
 }
 
-type GetRequest struct {
-	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+func (m *LocalShardsInCluster) GetIndexes() []*IndexDefinition {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
 }
 
-func (m *GetRequest) Reset()                    { *m = GetRequest{} }
-func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
-
-func (m *GetRequest) GetKey() []byte {
+func (m *LocalShardsInCluster) GetSettings() *KeyspaceSettings {
 	if m != nil {
-		return m.Key
+		return m.Settings
 	}
 	return nil
 }
 
-func (m *GetRequest) GetPartitionHash() uint64 {
+func (m *LocalShardsInCluster) GetShardCount() uint32 {
 	if m != nil {
-		return m.PartitionHash
+		return m.ShardCount
 	}
 	return 0
 }
 
-type GetResponse struct {
-	Ok       bool          `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
-	Status   string        `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
-	KeyValue *KeyTypeValue `protobuf:"bytes,3,opt,name=key_value,json=keyValue" json:"key_value,omitempty"`
-}
-
-func (m *GetResponse) Reset()                    { *m = GetResponse{} }
-func (m *GetResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetResponse) ProtoMessage()               {}
-func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
-
-func (m *GetResponse) GetOk() bool {
+func (m *LocalShardsInCluster) GetIndexesUpdatedAtNs() uint64 {
 	if m != nil {
-		return m.Ok
+		return m.IndexesUpdatedAtNs
 	}
-	return false
+	return 0
 }
 
-func (m *GetResponse) GetStatus() string {
+// KeyspaceSettings applies to all entries of the keyspace
+type KeyspaceSettings struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	// ttl for the puts and merges without ttl, 0 means the max ttl, or no ttl if there is no max ttl
+	DefaultTtlSecond uint32 `protobuf:"varint,2,opt,name=default_ttl_second,json=defaultTtlSecond" json:"default_ttl_second,omitempty"`
+	// puts with larger ttl are rejected, 0 means no limit
+	MaxTtlSecond uint32 `protobuf:"varint,3,opt,name=max_ttl_second,json=maxTtlSecond" json:"max_ttl_second,omitempty"`
+	// entries not updated for this long are purged by compaction, 0 means no retention
+	RetentionSecond uint32 `protobuf:"varint,4,opt,name=retention_second,json=retentionSecond" json:"retention_second,omitempty"`
+	// the latest settings win when the master restores them from the stores
+	UpdatedAtNs uint64 `protobuf:"varint,5,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+}
+
+func (m *KeyspaceSettings) Reset()                    { *m = KeyspaceSettings{} }
+func (m *KeyspaceSettings) String() string            { return proto.CompactTextString(m) }
+func (*KeyspaceSettings) ProtoMessage()               {}
+func (*KeyspaceSettings) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *KeyspaceSettings) GetKeyspace() string {
 	if m != nil {
-		return m.Status
+		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *GetResponse) GetKeyValue() *KeyTypeValue {
+func (m *KeyspaceSettings) GetDefaultTtlSecond() uint32 {
 	if m != nil {
-		return m.KeyValue
+		return m.DefaultTtlSecond
 	}
-	return nil
-}
-
-type GetByPrefixRequest struct {
-	Prefix      []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Limit       uint32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
-	LastSeenKey []byte `protobuf:"bytes,3,opt,name=last_seen_key,json=lastSeenKey,proto3" json:"last_seen_key,omitempty"`
+	return 0
 }
 
-func (m *GetByPrefixRequest) Reset()                    { *m = GetByPrefixRequest{} }
-func (m *GetByPrefixRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetByPrefixRequest) ProtoMessage()               {}
-func (*GetByPrefixRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
-
-func (m *GetByPrefixRequest) GetPrefix() []byte {
+func (m *KeyspaceSettings) GetMaxTtlSecond() uint32 {
 	if m != nil {
-		return m.Prefix
+		return m.MaxTtlSecond
 	}
-	return nil
+	return 0
 }
 
-func (m *GetByPrefixRequest) GetLimit() uint32 {
+func (m *KeyspaceSettings) GetRetentionSecond() uint32 {
 	if m != nil {
-		return m.Limit
+		return m.RetentionSecond
 	}
 	return 0
 }
 
-func (m *GetByPrefixRequest) GetLastSeenKey() []byte {
+func (m *KeyspaceSettings) GetUpdatedAtNs() uint64 {
 	if m != nil {
-		return m.LastSeenKey
+		return m.UpdatedAtNs
 	}
-	return nil
+	return 0
 }
 
-type GetByPrefixResponse struct {
-	Ok        bool            `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
-	Status    string          `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
-	KeyValues []*KeyTypeValue `protobuf:"bytes,3,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
+// KeyspaceIndexes are the secondary indexes of all entries of the keyspace
+type KeyspaceIndexes struct {
+	Keyspace string             `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Indexes  []*IndexDefinition `protobuf:"bytes,2,rep,name=indexes" json:"indexes,omitempty"`
+	// the latest definitions win when the master restores them from the stores
+	UpdatedAtNs uint64 `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
 }
 
-func (m *GetByPrefixResponse) Reset()                    { *m = GetByPrefixResponse{} }
-func (m *GetByPrefixResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetByPrefixResponse) ProtoMessage()               {}
-func (*GetByPrefixResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *KeyspaceIndexes) Reset()                    { *m = KeyspaceIndexes{} }
+func (m *KeyspaceIndexes) String() string            { return proto.CompactTextString(m) }
+func (*KeyspaceIndexes) ProtoMessage()               {}
+func (*KeyspaceIndexes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
-func (m *GetByPrefixResponse) GetOk() bool {
+func (m *KeyspaceIndexes) GetKeyspace() string {
 	if m != nil {
-		return m.Ok
+		return m.Keyspace
 	}
-	return false
+	return ""
 }
 
-func (m *GetByPrefixResponse) GetStatus() string {
+func (m *KeyspaceIndexes) GetIndexes() []*IndexDefinition {
 	if m != nil {
-		return m.Status
+		return m.Indexes
 	}
-	return ""
+	return nil
 }
 
-func (m *GetByPrefixResponse) GetKeyValues() []*KeyTypeValue {
+func (m *KeyspaceIndexes) GetUpdatedAtNs() uint64 {
 	if m != nil {
-		return m.KeyValues
+		return m.UpdatedAtNs
 	}
-	return nil
+	return 0
 }
 
-type Response struct {
-	Write       *WriteResponse       `protobuf:"bytes,1,opt,name=write" json:"write,omitempty"`
-	Get         *GetResponse         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	GetByPrefix *GetByPrefixResponse `protobuf:"bytes,3,opt,name=get_by_prefix,json=getByPrefix" json:"get_by_prefix,omitempty"`
+// IndexDefinition describes how to extract the index term of a secondary index
+type IndexDefinition struct {
+	Name     string                 `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Source   IndexDefinition_Source `protobuf:"varint,2,opt,name=source,enum=pb.IndexDefinition_Source" json:"source,omitempty"`
+	Offset   uint32                 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	Length   uint32                 `protobuf:"varint,4,opt,name=length" json:"length,omitempty"`
+	JsonPath string                 `protobuf:"bytes,5,opt,name=json_path,json=jsonPath" json:"json_path,omitempty"`
 }
 
-func (m *Response) Reset()                    { *m = Response{} }
-func (m *Response) String() string            { return proto.CompactTextString(m) }
+func (m *IndexDefinition) Reset()                    { *m = IndexDefinition{} }
+func (m *IndexDefinition) String() string            { return proto.CompactTextString(m) }
+func (*IndexDefinition) ProtoMessage()               {}
+func (*IndexDefinition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *IndexDefinition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IndexDefinition) GetSource() IndexDefinition_Source {
+	if m != nil {
+		return m.Source
+	}
+	return IndexDefinition_VALUE_BYTES
+}
+
+func (m *IndexDefinition) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *IndexDefinition) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *IndexDefinition) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
+type ShardInfo struct {
+	KeyspaceName      string           `protobuf:"bytes,1,opt,name=keyspace_name,json=keyspaceName" json:"keyspace_name,omitempty"`
+	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId           uint32           `protobuf:"varint,3,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,4,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,5,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	Status            ShardInfo_Status `protobuf:"varint,6,opt,name=status,enum=pb.ShardInfo_Status" json:"status,omitempty"`
+	IsCandidate       bool             `protobuf:"varint,7,opt,name=is_candidate,json=isCandidate" json:"is_candidate,omitempty"`
+	IsPermanentDelete bool             `protobuf:"varint,8,opt,name=is_permanent_delete,json=isPermanentDelete" json:"is_permanent_delete,omitempty"`
+	// the number of virtual shards the keys are hashed into, placed onto cluster_size servers.
+	// 0 means one shard per server.
+	ShardCount uint32 `protobuf:"varint,9,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+}
+
+func (m *ShardInfo) Reset()                    { *m = ShardInfo{} }
+func (m *ShardInfo) String() string            { return proto.CompactTextString(m) }
+func (*ShardInfo) ProtoMessage()               {}
+func (*ShardInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *ShardInfo) GetKeyspaceName() string {
+	if m != nil {
+		return m.KeyspaceName
+	}
+	return ""
+}
+
+func (m *ShardInfo) GetServerId() uint32 {
+	if m != nil {
+		return m.ServerId
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetClusterSize() uint32 {
+	if m != nil {
+		return m.ClusterSize
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetReplicationFactor() uint32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *ShardInfo) GetStatus() ShardInfo_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ShardInfo_EMPTY
+}
+
+func (m *ShardInfo) GetIsCandidate() bool {
+	if m != nil {
+		return m.IsCandidate
+	}
+	return false
+}
+
+func (m *ShardInfo) GetIsPermanentDelete() bool {
+	if m != nil {
+		return m.IsPermanentDelete
+	}
+	return false
+}
+
+func (m *ShardInfo) GetShardCount() uint32 {
+	if m != nil {
+		return m.ShardCount
+	}
+	return 0
+}
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+type KeyTypeValue struct {
+	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	DataType      OpAndDataType `protobuf:"varint,3,opt,name=data_type,json=dataType,enum=pb.OpAndDataType" json:"data_type,omitempty"`
+	Value         []byte        `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *KeyTypeValue) Reset()                    { *m = KeyTypeValue{} }
+func (m *KeyTypeValue) String() string            { return proto.CompactTextString(m) }
+func (*KeyTypeValue) ProtoMessage()               {}
+func (*KeyTypeValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *KeyTypeValue) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KeyTypeValue) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *KeyTypeValue) GetDataType() OpAndDataType {
+	if m != nil {
+		return m.DataType
+	}
+	return OpAndDataType_BYTES
+}
+
+func (m *KeyTypeValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// ////////////////////////////////////////////////
+// // data queries
+// ////////////////////////////////////////////////
+type Requests struct {
+	Keyspace string     `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Requests []*Request `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+}
+
+func (m *Requests) Reset()                    { *m = Requests{} }
+func (m *Requests) String() string            { return proto.CompactTextString(m) }
+func (*Requests) ProtoMessage()               {}
+func (*Requests) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *Requests) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *Requests) GetRequests() []*Request {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type Responses struct {
+	Responses []*Response `protobuf:"bytes,1,rep,name=responses" json:"responses,omitempty"`
+}
+
+func (m *Responses) Reset()                    { *m = Responses{} }
+func (m *Responses) String() string            { return proto.CompactTextString(m) }
+func (*Responses) ProtoMessage()               {}
+func (*Responses) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *Responses) GetResponses() []*Response {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+type Request struct {
+	ShardId     uint32              `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Put         *PutRequest         `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
+	Get         *GetRequest         `protobuf:"bytes,3,opt,name=get" json:"get,omitempty"`
+	GetByPrefix *GetByPrefixRequest `protobuf:"bytes,4,opt,name=get_by_prefix,json=getByPrefix" json:"get_by_prefix,omitempty"`
+	Delete      *DeleteRequest      `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
+	Merge       *MergeRequest       `protobuf:"bytes,6,opt,name=merge" json:"merge,omitempty"`
+	Scan        *ScanRequest        `protobuf:"bytes,7,opt,name=scan" json:"scan,omitempty"`
+	Aggregate   *AggregateRequest   `protobuf:"bytes,8,opt,name=aggregate" json:"aggregate,omitempty"`
+	IndexLookup *IndexLookupRequest `protobuf:"bytes,9,opt,name=index_lookup,json=indexLookup" json:"index_lookup,omitempty"`
+	SortedSet   *SortedSetRequest   `protobuf:"bytes,10,opt,name=sorted_set,json=sortedSet" json:"sorted_set,omitempty"`
+	TimeSeries  *TimeSeriesRequest  `protobuf:"bytes,11,opt,name=time_series,json=timeSeries" json:"time_series,omitempty"`
+	Ttl         *TtlRequest         `protobuf:"bytes,12,opt,name=ttl" json:"ttl,omitempty"`
+}
+
+func (m *Request) Reset()                    { *m = Request{} }
+func (m *Request) String() string            { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()               {}
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *Request) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *Request) GetPut() *PutRequest {
+	if m != nil {
+		return m.Put
+	}
+	return nil
+}
+
+func (m *Request) GetGet() *GetRequest {
+	if m != nil {
+		return m.Get
+	}
+	return nil
+}
+
+func (m *Request) GetGetByPrefix() *GetByPrefixRequest {
+	if m != nil {
+		return m.GetByPrefix
+	}
+	return nil
+}
+
+func (m *Request) GetDelete() *DeleteRequest {
+	if m != nil {
+		return m.Delete
+	}
+	return nil
+}
+
+func (m *Request) GetMerge() *MergeRequest {
+	if m != nil {
+		return m.Merge
+	}
+	return nil
+}
+
+func (m *Request) GetScan() *ScanRequest {
+	if m != nil {
+		return m.Scan
+	}
+	return nil
+}
+
+func (m *Request) GetAggregate() *AggregateRequest {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
+func (m *Request) GetIndexLookup() *IndexLookupRequest {
+	if m != nil {
+		return m.IndexLookup
+	}
+	return nil
+}
+
+func (m *Request) GetSortedSet() *SortedSetRequest {
+	if m != nil {
+		return m.SortedSet
+	}
+	return nil
+}
+
+func (m *Request) GetTimeSeries() *TimeSeriesRequest {
+	if m != nil {
+		return m.TimeSeries
+	}
+	return nil
+}
+
+func (m *Request) GetTtl() *TtlRequest {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+type PutRequest struct {
+	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	UpdatedAtNs   uint64        `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+	TtlSecond     uint32        `protobuf:"varint,4,opt,name=ttl_second,json=ttlSecond" json:"ttl_second,omitempty"`
+	OpAndDataType OpAndDataType `protobuf:"varint,5,opt,name=op_and_data_type,json=opAndDataType,enum=pb.OpAndDataType" json:"op_and_data_type,omitempty"`
+	Value         []byte        `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *PutRequest) Reset()                    { *m = PutRequest{} }
+func (m *PutRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutRequest) ProtoMessage()               {}
+func (*PutRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *PutRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *PutRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *PutRequest) GetUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.UpdatedAtNs
+	}
+	return 0
+}
+
+func (m *PutRequest) GetTtlSecond() uint32 {
+	if m != nil {
+		return m.TtlSecond
+	}
+	return 0
+}
+
+func (m *PutRequest) GetOpAndDataType() OpAndDataType {
+	if m != nil {
+		return m.OpAndDataType
+	}
+	return OpAndDataType_BYTES
+}
+
+func (m *PutRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type MergeRequest struct {
+	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	UpdatedAtNs   uint64        `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+	OpAndDataType OpAndDataType `protobuf:"varint,4,opt,name=op_and_data_type,json=opAndDataType,enum=pb.OpAndDataType" json:"op_and_data_type,omitempty"`
+	Value         []byte        `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// name of the registered merge function, only for NAMED_MERGE
+	MergeFunction string `protobuf:"bytes,6,opt,name=merge_function,json=mergeFunction" json:"merge_function,omitempty"`
+	// ttl of the key created by the merge, set by the store from the keyspace settings
+	TtlSecond uint32 `protobuf:"varint,7,opt,name=ttl_second,json=ttlSecond" json:"ttl_second,omitempty"`
+}
+
+func (m *MergeRequest) Reset()                    { *m = MergeRequest{} }
+func (m *MergeRequest) String() string            { return proto.CompactTextString(m) }
+func (*MergeRequest) ProtoMessage()               {}
+func (*MergeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *MergeRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *MergeRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *MergeRequest) GetUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.UpdatedAtNs
+	}
+	return 0
+}
+
+func (m *MergeRequest) GetOpAndDataType() OpAndDataType {
+	if m != nil {
+		return m.OpAndDataType
+	}
+	return OpAndDataType_BYTES
+}
+
+func (m *MergeRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MergeRequest) GetMergeFunction() string {
+	if m != nil {
+		return m.MergeFunction
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetTtlSecond() uint32 {
+	if m != nil {
+		return m.TtlSecond
+	}
+	return 0
+}
+
+type WriteResponse struct {
+	Ok     bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	// the value after the merge, only for the int64 counter merges
+	KeyValue *KeyTypeValue `protobuf:"bytes,3,opt,name=key_value,json=keyValue" json:"key_value,omitempty"`
+}
+
+func (m *WriteResponse) Reset()                    { *m = WriteResponse{} }
+func (m *WriteResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteResponse) ProtoMessage()               {}
+func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *WriteResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *WriteResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *WriteResponse) GetKeyValue() *KeyTypeValue {
+	if m != nil {
+		return m.KeyValue
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	UpdatedAtNs   uint64 `protobuf:"varint,3,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+}
+
+func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()               {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *DeleteRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *DeleteRequest) GetUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.UpdatedAtNs
+	}
+	return 0
+}
+
+type GetRequest struct {
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *GetRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *GetRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+type GetResponse struct {
+	Ok       bool          `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status   string        `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	KeyValue *KeyTypeValue `protobuf:"bytes,3,opt,name=key_value,json=keyValue" json:"key_value,omitempty"`
+}
+
+func (m *GetResponse) Reset()                    { *m = GetResponse{} }
+func (m *GetResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetResponse) ProtoMessage()               {}
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GetResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *GetResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetResponse) GetKeyValue() *KeyTypeValue {
+	if m != nil {
+		return m.KeyValue
+	}
+	return nil
+}
+
+type GetByPrefixRequest struct {
+	Prefix      []byte      `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit       uint32      `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	LastSeenKey []byte      `protobuf:"bytes,3,opt,name=last_seen_key,json=lastSeenKey,proto3" json:"last_seen_key,omitempty"`
+	Filter      *ScanFilter `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *GetByPrefixRequest) Reset()                    { *m = GetByPrefixRequest{} }
+func (m *GetByPrefixRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetByPrefixRequest) ProtoMessage()               {}
+func (*GetByPrefixRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GetByPrefixRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *GetByPrefixRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetByPrefixRequest) GetLastSeenKey() []byte {
+	if m != nil {
+		return m.LastSeenKey
+	}
+	return nil
+}
+
+func (m *GetByPrefixRequest) GetFilter() *ScanFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// the store only returns the entries matching all the specified conditions
+type ScanFilter struct {
+	// match any of the data types. empty means any data type.
+	DataTypes      []OpAndDataType          `protobuf:"varint,1,rep,packed,name=data_types,json=dataTypes,enum=pb.OpAndDataType" json:"data_types,omitempty"`
+	Float64Range   *ScanFilter_Float64Range `protobuf:"bytes,2,opt,name=float64_range,json=float64Range" json:"float64_range,omitempty"`
+	UpdatedAfterNs uint64                   `protobuf:"varint,3,opt,name=updated_after_ns,json=updatedAfterNs" json:"updated_after_ns,omitempty"`
+	// glob pattern with * and ?
+	KeyGlob  string `protobuf:"bytes,4,opt,name=key_glob,json=keyGlob" json:"key_glob,omitempty"`
+	KeyRegex string `protobuf:"bytes,5,opt,name=key_regex,json=keyRegex" json:"key_regex,omitempty"`
+	// return only the keys without values
+	KeysOnly bool `protobuf:"varint,6,opt,name=keys_only,json=keysOnly" json:"keys_only,omitempty"`
+}
+
+func (m *ScanFilter) Reset()                    { *m = ScanFilter{} }
+func (m *ScanFilter) String() string            { return proto.CompactTextString(m) }
+func (*ScanFilter) ProtoMessage()               {}
+func (*ScanFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ScanFilter) GetDataTypes() []OpAndDataType {
+	if m != nil {
+		return m.DataTypes
+	}
+	return nil
+}
+
+func (m *ScanFilter) GetFloat64Range() *ScanFilter_Float64Range {
+	if m != nil {
+		return m.Float64Range
+	}
+	return nil
+}
+
+func (m *ScanFilter) GetUpdatedAfterNs() uint64 {
+	if m != nil {
+		return m.UpdatedAfterNs
+	}
+	return 0
+}
+
+func (m *ScanFilter) GetKeyGlob() string {
+	if m != nil {
+		return m.KeyGlob
+	}
+	return ""
+}
+
+func (m *ScanFilter) GetKeyRegex() string {
+	if m != nil {
+		return m.KeyRegex
+	}
+	return ""
+}
+
+func (m *ScanFilter) GetKeysOnly() bool {
+	if m != nil {
+		return m.KeysOnly
+	}
+	return false
+}
+
+// float64 values between min and max, both inclusive
+type ScanFilter_Float64Range struct {
+	Min float64 `protobuf:"fixed64,1,opt,name=min" json:"min,omitempty"`
+	Max float64 `protobuf:"fixed64,2,opt,name=max" json:"max,omitempty"`
+}
+
+func (m *ScanFilter_Float64Range) Reset()                    { *m = ScanFilter_Float64Range{} }
+func (m *ScanFilter_Float64Range) String() string            { return proto.CompactTextString(m) }
+func (*ScanFilter_Float64Range) ProtoMessage()               {}
+func (*ScanFilter_Float64Range) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31, 0} }
+
+func (m *ScanFilter_Float64Range) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *ScanFilter_Float64Range) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type GetByPrefixResponse struct {
+	Ok        bool            `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status    string          `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	KeyValues []*KeyTypeValue `protobuf:"bytes,3,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
+}
+
+func (m *GetByPrefixResponse) Reset()                    { *m = GetByPrefixResponse{} }
+func (m *GetByPrefixResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetByPrefixResponse) ProtoMessage()               {}
+func (*GetByPrefixResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GetByPrefixResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *GetByPrefixResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetByPrefixResponse) GetKeyValues() []*KeyTypeValue {
+	if m != nil {
+		return m.KeyValues
+	}
+	return nil
+}
+
+// scan the keys between start_key and end_key, in the forward or reverse order.
+// empty start_key means from the first key, and empty end_key means till the last key.
+type ScanRequest struct {
+	StartKey       []byte      `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	StartExclusive bool        `protobuf:"varint,2,opt,name=start_exclusive,json=startExclusive" json:"start_exclusive,omitempty"`
+	EndKey         []byte      `protobuf:"bytes,3,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	EndInclusive   bool        `protobuf:"varint,4,opt,name=end_inclusive,json=endInclusive" json:"end_inclusive,omitempty"`
+	Reverse        bool        `protobuf:"varint,5,opt,name=reverse" json:"reverse,omitempty"`
+	Limit          uint32      `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	Filter         *ScanFilter `protobuf:"bytes,7,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *ScanRequest) Reset()                    { *m = ScanRequest{} }
+func (m *ScanRequest) String() string            { return proto.CompactTextString(m) }
+func (*ScanRequest) ProtoMessage()               {}
+func (*ScanRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ScanRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetStartExclusive() bool {
+	if m != nil {
+		return m.StartExclusive
+	}
+	return false
+}
+
+func (m *ScanRequest) GetEndKey() []byte {
+	if m != nil {
+		return m.EndKey
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetEndInclusive() bool {
+	if m != nil {
+		return m.EndInclusive
+	}
+	return false
+}
+
+func (m *ScanRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *ScanRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetFilter() *ScanFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type ScanResponse struct {
+	Ok        bool            `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status    string          `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	KeyValues []*KeyTypeValue `protobuf:"bytes,3,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
+	HasMore   bool            `protobuf:"varint,4,opt,name=has_more,json=hasMore" json:"has_more,omitempty"`
+}
+
+func (m *ScanResponse) Reset()                    { *m = ScanResponse{} }
+func (m *ScanResponse) String() string            { return proto.CompactTextString(m) }
+func (*ScanResponse) ProtoMessage()               {}
+func (*ScanResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ScanResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *ScanResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ScanResponse) GetKeyValues() []*KeyTypeValue {
+	if m != nil {
+		return m.KeyValues
+	}
+	return nil
+}
+
+func (m *ScanResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+// aggregate the entries under the prefix on one shard
+type AggregateRequest struct {
+	Prefix []byte      `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Filter *ScanFilter `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+}
+
+func (m *AggregateRequest) Reset()                    { *m = AggregateRequest{} }
+func (m *AggregateRequest) String() string            { return proto.CompactTextString(m) }
+func (*AggregateRequest) ProtoMessage()               {}
+func (*AggregateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *AggregateRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *AggregateRequest) GetFilter() *ScanFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type AggregateResponse struct {
+	Ok     bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	// number of matching entries
+	Count uint64 `protobuf:"varint,3,opt,name=count" json:"count,omitempty"`
+	// number of matching entries with float64 values, which are used for sum, min and max
+	Float64Count uint64  `protobuf:"varint,4,opt,name=float64_count,json=float64Count" json:"float64_count,omitempty"`
+	Sum          float64 `protobuf:"fixed64,5,opt,name=sum" json:"sum,omitempty"`
+	Min          float64 `protobuf:"fixed64,6,opt,name=min" json:"min,omitempty"`
+	Max          float64 `protobuf:"fixed64,7,opt,name=max" json:"max,omitempty"`
+}
+
+func (m *AggregateResponse) Reset()                    { *m = AggregateResponse{} }
+func (m *AggregateResponse) String() string            { return proto.CompactTextString(m) }
+func (*AggregateResponse) ProtoMessage()               {}
+func (*AggregateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *AggregateResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *AggregateResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *AggregateResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AggregateResponse) GetFloat64Count() uint64 {
+	if m != nil {
+		return m.Float64Count
+	}
+	return 0
+}
+
+func (m *AggregateResponse) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *AggregateResponse) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *AggregateResponse) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+// look up the primary keys by the index term on one shard
+type IndexLookupRequest struct {
+	IndexName   string `protobuf:"bytes,1,opt,name=index_name,json=indexName" json:"index_name,omitempty"`
+	Term        []byte `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	Limit       uint32 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	LastSeenKey []byte `protobuf:"bytes,4,opt,name=last_seen_key,json=lastSeenKey,proto3" json:"last_seen_key,omitempty"`
+	// only the keys in this partition are returned
+	PartitionHash uint64 `protobuf:"varint,5,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+}
+
+func (m *IndexLookupRequest) Reset()                    { *m = IndexLookupRequest{} }
+func (m *IndexLookupRequest) String() string            { return proto.CompactTextString(m) }
+func (*IndexLookupRequest) ProtoMessage()               {}
+func (*IndexLookupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *IndexLookupRequest) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexLookupRequest) GetTerm() []byte {
+	if m != nil {
+		return m.Term
+	}
+	return nil
+}
+
+func (m *IndexLookupRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *IndexLookupRequest) GetLastSeenKey() []byte {
+	if m != nil {
+		return m.LastSeenKey
+	}
+	return nil
+}
+
+func (m *IndexLookupRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+type IndexLookupResponse struct {
+	Ok     bool     `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status string   `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Keys   [][]byte `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *IndexLookupResponse) Reset()                    { *m = IndexLookupResponse{} }
+func (m *IndexLookupResponse) String() string            { return proto.CompactTextString(m) }
+func (*IndexLookupResponse) ProtoMessage()               {}
+func (*IndexLookupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *IndexLookupResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *IndexLookupResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *IndexLookupResponse) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// operate on the sorted set stored under the key.
+// The members are ordered by the score, and then by the member bytes.
+type SortedSetRequest struct {
+	Key           []byte              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64              `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	Op            SortedSetRequest_Op `protobuf:"varint,3,opt,name=op,enum=pb.SortedSetRequest_Op" json:"op,omitempty"`
+	Members       []*SortedSetMember  `protobuf:"bytes,4,rep,name=members" json:"members,omitempty"`
+	MinScore      float64             `protobuf:"fixed64,5,opt,name=min_score,json=minScore" json:"min_score,omitempty"`
+	MaxScore      float64             `protobuf:"fixed64,6,opt,name=max_score,json=maxScore" json:"max_score,omitempty"`
+	Limit         uint32              `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+	Ascending     bool                `protobuf:"varint,8,opt,name=ascending" json:"ascending,omitempty"`
+}
+
+func (m *SortedSetRequest) Reset()                    { *m = SortedSetRequest{} }
+func (m *SortedSetRequest) String() string            { return proto.CompactTextString(m) }
+func (*SortedSetRequest) ProtoMessage()               {}
+func (*SortedSetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *SortedSetRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SortedSetRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *SortedSetRequest) GetOp() SortedSetRequest_Op {
+	if m != nil {
+		return m.Op
+	}
+	return SortedSetRequest_ADD
+}
+
+func (m *SortedSetRequest) GetMembers() []*SortedSetMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *SortedSetRequest) GetMinScore() float64 {
+	if m != nil {
+		return m.MinScore
+	}
+	return 0
+}
+
+func (m *SortedSetRequest) GetMaxScore() float64 {
+	if m != nil {
+		return m.MaxScore
+	}
+	return 0
+}
+
+func (m *SortedSetRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SortedSetRequest) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+type SortedSetMember struct {
+	Member []byte  `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Score  float64 `protobuf:"fixed64,2,opt,name=score" json:"score,omitempty"`
+}
+
+func (m *SortedSetMember) Reset()                    { *m = SortedSetMember{} }
+func (m *SortedSetMember) String() string            { return proto.CompactTextString(m) }
+func (*SortedSetMember) ProtoMessage()               {}
+func (*SortedSetMember) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *SortedSetMember) GetMember() []byte {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *SortedSetMember) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type SortedSetResponse struct {
+	Ok      bool               `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status  string             `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Members []*SortedSetMember `protobuf:"bytes,3,rep,name=members" json:"members,omitempty"`
+	// -1 if the member is not found
+	Rank int64 `protobuf:"varint,4,opt,name=rank" json:"rank,omitempty"`
+}
+
+func (m *SortedSetResponse) Reset()                    { *m = SortedSetResponse{} }
+func (m *SortedSetResponse) String() string            { return proto.CompactTextString(m) }
+func (*SortedSetResponse) ProtoMessage()               {}
+func (*SortedSetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *SortedSetResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *SortedSetResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SortedSetResponse) GetMembers() []*SortedSetMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *SortedSetResponse) GetRank() int64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+// query the points of the time series stored under the key
+type TimeSeriesRequest struct {
+	Key           []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	// inclusive
+	StartMs int64 `protobuf:"varint,3,opt,name=start_ms,json=startMs" json:"start_ms,omitempty"`
+	// exclusive, 0 means no end
+	EndMs int64 `protobuf:"varint,4,opt,name=end_ms,json=endMs" json:"end_ms,omitempty"`
+	// aggregate the points into buckets of this size. 0 returns the raw points.
+	BucketMs int64 `protobuf:"varint,5,opt,name=bucket_ms,json=bucketMs" json:"bucket_ms,omitempty"`
+}
+
+func (m *TimeSeriesRequest) Reset()                    { *m = TimeSeriesRequest{} }
+func (m *TimeSeriesRequest) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesRequest) ProtoMessage()               {}
+func (*TimeSeriesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *TimeSeriesRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *TimeSeriesRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *TimeSeriesRequest) GetStartMs() int64 {
+	if m != nil {
+		return m.StartMs
+	}
+	return 0
+}
+
+func (m *TimeSeriesRequest) GetEndMs() int64 {
+	if m != nil {
+		return m.EndMs
+	}
+	return 0
+}
+
+func (m *TimeSeriesRequest) GetBucketMs() int64 {
+	if m != nil {
+		return m.BucketMs
+	}
+	return 0
+}
+
+type TimeSeriesPoint struct {
+	TimestampMs int64   `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs" json:"timestamp_ms,omitempty"`
+	Value       float64 `protobuf:"fixed64,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *TimeSeriesPoint) Reset()                    { *m = TimeSeriesPoint{} }
+func (m *TimeSeriesPoint) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesPoint) ProtoMessage()               {}
+func (*TimeSeriesPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *TimeSeriesPoint) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *TimeSeriesPoint) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type TimeSeriesBucket struct {
+	StartMs int64   `protobuf:"varint,1,opt,name=start_ms,json=startMs" json:"start_ms,omitempty"`
+	Min     float64 `protobuf:"fixed64,2,opt,name=min" json:"min,omitempty"`
+	Max     float64 `protobuf:"fixed64,3,opt,name=max" json:"max,omitempty"`
+	Sum     float64 `protobuf:"fixed64,4,opt,name=sum" json:"sum,omitempty"`
+	Count   uint64  `protobuf:"varint,5,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *TimeSeriesBucket) Reset()                    { *m = TimeSeriesBucket{} }
+func (m *TimeSeriesBucket) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesBucket) ProtoMessage()               {}
+func (*TimeSeriesBucket) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *TimeSeriesBucket) GetStartMs() int64 {
+	if m != nil {
+		return m.StartMs
+	}
+	return 0
+}
+
+func (m *TimeSeriesBucket) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *TimeSeriesBucket) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *TimeSeriesBucket) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *TimeSeriesBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type TimeSeriesResponse struct {
+	Ok      bool                `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status  string              `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Points  []*TimeSeriesPoint  `protobuf:"bytes,3,rep,name=points" json:"points,omitempty"`
+	Buckets []*TimeSeriesBucket `protobuf:"bytes,4,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *TimeSeriesResponse) Reset()                    { *m = TimeSeriesResponse{} }
+func (m *TimeSeriesResponse) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesResponse) ProtoMessage()               {}
+func (*TimeSeriesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *TimeSeriesResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *TimeSeriesResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TimeSeriesResponse) GetPoints() []*TimeSeriesPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *TimeSeriesResponse) GetBuckets() []*TimeSeriesBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type TtlRequest struct {
+	Key           []byte        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash uint64        `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	Op            TtlRequest_Op `protobuf:"varint,3,opt,name=op,enum=pb.TtlRequest_Op" json:"op,omitempty"`
+	TtlSecond     uint32        `protobuf:"varint,4,opt,name=ttl_second,json=ttlSecond" json:"ttl_second,omitempty"`
+}
+
+func (m *TtlRequest) Reset()                    { *m = TtlRequest{} }
+func (m *TtlRequest) String() string            { return proto.CompactTextString(m) }
+func (*TtlRequest) ProtoMessage()               {}
+func (*TtlRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *TtlRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *TtlRequest) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *TtlRequest) GetOp() TtlRequest_Op {
+	if m != nil {
+		return m.Op
+	}
+	return TtlRequest_GET
+}
+
+func (m *TtlRequest) GetTtlSecond() uint32 {
+	if m != nil {
+		return m.TtlSecond
+	}
+	return 0
+}
+
+type TtlResponse struct {
+	Ok     bool   `protobuf:"varint,1,opt,name=ok" json:"ok,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Found  bool   `protobuf:"varint,3,opt,name=found" json:"found,omitempty"`
+	// seconds before the key expires, -1 if the key has no ttl
+	RemainingSecond int64 `protobuf:"varint,4,opt,name=remaining_second,json=remainingSecond" json:"remaining_second,omitempty"`
+}
+
+func (m *TtlResponse) Reset()                    { *m = TtlResponse{} }
+func (m *TtlResponse) String() string            { return proto.CompactTextString(m) }
+func (*TtlResponse) ProtoMessage()               {}
+func (*TtlResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *TtlResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *TtlResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TtlResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *TtlResponse) GetRemainingSecond() int64 {
+	if m != nil {
+		return m.RemainingSecond
+	}
+	return 0
+}
+
+type Response struct {
+	Write       *WriteResponse       `protobuf:"bytes,1,opt,name=write" json:"write,omitempty"`
+	Get         *GetResponse         `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	GetByPrefix *GetByPrefixResponse `protobuf:"bytes,3,opt,name=get_by_prefix,json=getByPrefix" json:"get_by_prefix,omitempty"`
+	Scan        *ScanResponse        `protobuf:"bytes,4,opt,name=scan" json:"scan,omitempty"`
+	Aggregate   *AggregateResponse   `protobuf:"bytes,5,opt,name=aggregate" json:"aggregate,omitempty"`
+	IndexLookup *IndexLookupResponse `protobuf:"bytes,6,opt,name=index_lookup,json=indexLookup" json:"index_lookup,omitempty"`
+	SortedSet   *SortedSetResponse   `protobuf:"bytes,7,opt,name=sorted_set,json=sortedSet" json:"sorted_set,omitempty"`
+	TimeSeries  *TimeSeriesResponse  `protobuf:"bytes,8,opt,name=time_series,json=timeSeries" json:"time_series,omitempty"`
+	Ttl         *TtlResponse         `protobuf:"bytes,9,opt,name=ttl" json:"ttl,omitempty"`
+}
+
+func (m *Response) Reset()                    { *m = Response{} }
+func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *Response) GetWrite() *WriteResponse {
+	if m != nil {
+		return m.Write
+	}
+	return nil
+}
+
+func (m *Response) GetGet() *GetResponse {
+	if m != nil {
+		return m.Get
+	}
+	return nil
+}
+
+func (m *Response) GetGetByPrefix() *GetByPrefixResponse {
+	if m != nil {
+		return m.GetByPrefix
+	}
+	return nil
+}
+
+func (m *Response) GetScan() *ScanResponse {
+	if m != nil {
+		return m.Scan
+	}
+	return nil
+}
+
+func (m *Response) GetAggregate() *AggregateResponse {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
+func (m *Response) GetIndexLookup() *IndexLookupResponse {
+	if m != nil {
+		return m.IndexLookup
+	}
+	return nil
+}
+
+func (m *Response) GetSortedSet() *SortedSetResponse {
+	if m != nil {
+		return m.SortedSet
+	}
+	return nil
+}
+
+func (m *Response) GetTimeSeries() *TimeSeriesResponse {
+	if m != nil {
+		return m.TimeSeries
+	}
+	return nil
+}
+
+func (m *Response) GetTtl() *TtlResponse {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+// a large value is split into chunks stored under the reserved chunk key prefix,
+// and the manifest is stored under the original key
+type ChunkManifest struct {
+	Version    uint64 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	ChunkCount uint32 `protobuf:"varint,2,opt,name=chunk_count,json=chunkCount" json:"chunk_count,omitempty"`
+	TotalSize  uint64 `protobuf:"varint,3,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
+	Checksum   uint32 `protobuf:"varint,4,opt,name=checksum" json:"checksum,omitempty"`
+}
+
+func (m *ChunkManifest) Reset()                    { *m = ChunkManifest{} }
+func (m *ChunkManifest) String() string            { return proto.CompactTextString(m) }
+func (*ChunkManifest) ProtoMessage()               {}
+func (*ChunkManifest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *ChunkManifest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChunkManifest) GetChunkCount() uint32 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *ChunkManifest) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ChunkManifest) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+type RawKeyValue struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *RawKeyValue) Reset()                    { *m = RawKeyValue{} }
+func (m *RawKeyValue) String() string            { return proto.CompactTextString(m) }
+func (*RawKeyValue) ProtoMessage()               {}
+func (*RawKeyValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *RawKeyValue) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *RawKeyValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type LogEntry struct {
+	UpdatedAtNs uint64         `protobuf:"varint,1,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+	Put         *PutRequest    `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
+	Delete      *DeleteRequest `protobuf:"bytes,3,opt,name=delete" json:"delete,omitempty"`
+	Merge       *MergeRequest  `protobuf:"bytes,4,opt,name=merge" json:"merge,omitempty"`
+	// the ttl change, applied relative to updated_at_ns
+	Ttl *TtlRequest `protobuf:"bytes,5,opt,name=ttl" json:"ttl,omitempty"`
+	// set if the entry is replicated from another data center, so it is not replicated back
+	OriginDataCenter string `protobuf:"bytes,6,opt,name=origin_data_center,json=originDataCenter" json:"origin_data_center,omitempty"`
+}
+
+func (m *LogEntry) Reset()                    { *m = LogEntry{} }
+func (m *LogEntry) String() string            { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()               {}
+func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *LogEntry) GetUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.UpdatedAtNs
+	}
+	return 0
+}
+
+func (m *LogEntry) GetPut() *PutRequest {
+	if m != nil {
+		return m.Put
+	}
+	return nil
+}
+
+func (m *LogEntry) GetDelete() *DeleteRequest {
+	if m != nil {
+		return m.Delete
+	}
+	return nil
+}
+
+func (m *LogEntry) GetMerge() *MergeRequest {
+	if m != nil {
+		return m.Merge
+	}
+	return nil
+}
+
+func (m *LogEntry) GetTtl() *TtlRequest {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *LogEntry) GetOriginDataCenter() string {
+	if m != nil {
+		return m.OriginDataCenter
+	}
+	return ""
+}
+
+// ////////////////////////////////////////////////
+// // data copying
+// ////////////////////////////////////////////////
+type CopyDoneMessge struct {
+	Shard           int32 `protobuf:"varint,1,opt,name=shard" json:"shard,omitempty"`
+	CopyStartTimeNs int64 `protobuf:"varint,2,opt,name=copy_start_time_ns,json=copyStartTimeNs" json:"copy_start_time_ns,omitempty"`
+}
+
+func (m *CopyDoneMessge) Reset()                    { *m = CopyDoneMessge{} }
+func (m *CopyDoneMessge) String() string            { return proto.CompactTextString(m) }
+func (*CopyDoneMessge) ProtoMessage()               {}
+func (*CopyDoneMessge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *CopyDoneMessge) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *CopyDoneMessge) GetCopyStartTimeNs() int64 {
+	if m != nil {
+		return m.CopyStartTimeNs
+	}
+	return 0
+}
+
+type BootstrapCopyRequest struct {
+	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ShardId           uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	TargetShardId     uint32 `protobuf:"varint,4,opt,name=target_shard_id,json=targetShardId" json:"target_shard_id,omitempty"`
+	TargetClusterSize uint32 `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	Origin            string `protobuf:"bytes,6,opt,name=origin" json:"origin,omitempty"`
+	Limit             uint64 `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+	// resume the copy after this key
+	StartAfterKey []byte `protobuf:"bytes,8,opt,name=start_after_key,json=startAfterKey,proto3" json:"start_after_key,omitempty"`
+}
+
+func (m *BootstrapCopyRequest) Reset()                    { *m = BootstrapCopyRequest{} }
+func (m *BootstrapCopyRequest) String() string            { return proto.CompactTextString(m) }
+func (*BootstrapCopyRequest) ProtoMessage()               {}
+func (*BootstrapCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *BootstrapCopyRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *BootstrapCopyRequest) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *BootstrapCopyRequest) GetClusterSize() uint32 {
+	if m != nil {
+		return m.ClusterSize
+	}
+	return 0
+}
+
+func (m *BootstrapCopyRequest) GetTargetShardId() uint32 {
+	if m != nil {
+		return m.TargetShardId
+	}
+	return 0
+}
+
+func (m *BootstrapCopyRequest) GetTargetClusterSize() uint32 {
+	if m != nil {
+		return m.TargetClusterSize
+	}
+	return 0
+}
+
+func (m *BootstrapCopyRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *BootstrapCopyRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *BootstrapCopyRequest) GetStartAfterKey() []byte {
+	if m != nil {
+		return m.StartAfterKey
+	}
+	return nil
+}
+
+type BootstrapCopyResponse struct {
+	KeyValues          []*RawKeyValue                            `protobuf:"bytes,1,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
+	BinlogTailProgress *BootstrapCopyResponse_BinlogTailProgress `protobuf:"bytes,2,opt,name=binlogTailProgress" json:"binlogTailProgress,omitempty"`
+}
+
+func (m *BootstrapCopyResponse) Reset()                    { *m = BootstrapCopyResponse{} }
+func (m *BootstrapCopyResponse) String() string            { return proto.CompactTextString(m) }
+func (*BootstrapCopyResponse) ProtoMessage()               {}
+func (*BootstrapCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *BootstrapCopyResponse) GetKeyValues() []*RawKeyValue {
+	if m != nil {
+		return m.KeyValues
+	}
+	return nil
+}
+
+func (m *BootstrapCopyResponse) GetBinlogTailProgress() *BootstrapCopyResponse_BinlogTailProgress {
+	if m != nil {
+		return m.BinlogTailProgress
+	}
+	return nil
+}
+
+// this message is only sent at the end of copying
+type BootstrapCopyResponse_BinlogTailProgress struct {
+	Segment uint32 `protobuf:"varint,1,opt,name=segment" json:"segment,omitempty"`
+	Offset  uint64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *BootstrapCopyResponse_BinlogTailProgress) Reset() {
+	*m = BootstrapCopyResponse_BinlogTailProgress{}
+}
+func (m *BootstrapCopyResponse_BinlogTailProgress) String() string { return proto.CompactTextString(m) }
+func (*BootstrapCopyResponse_BinlogTailProgress) ProtoMessage()    {}
+func (*BootstrapCopyResponse_BinlogTailProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{54, 0}
+}
+
+func (m *BootstrapCopyResponse_BinlogTailProgress) GetSegment() uint32 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *BootstrapCopyResponse_BinlogTailProgress) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type PullUpdateRequest struct {
+	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ShardId           uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Segment           uint32 `protobuf:"varint,3,opt,name=segment" json:"segment,omitempty"`
+	Offset            uint64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+	Limit             uint32 `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+	TargetShardId     uint32 `protobuf:"varint,6,opt,name=target_shard_id,json=targetShardId" json:"target_shard_id,omitempty"`
+	TargetClusterSize uint32 `protobuf:"varint,7,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	Origin            string `protobuf:"bytes,8,opt,name=origin" json:"origin,omitempty"`
+}
+
+func (m *PullUpdateRequest) Reset()                    { *m = PullUpdateRequest{} }
+func (m *PullUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PullUpdateRequest) ProtoMessage()               {}
+func (*PullUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *PullUpdateRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *PullUpdateRequest) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *PullUpdateRequest) GetSegment() uint32 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *PullUpdateRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PullUpdateRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *PullUpdateRequest) GetTargetShardId() uint32 {
+	if m != nil {
+		return m.TargetShardId
+	}
+	return 0
+}
+
+func (m *PullUpdateRequest) GetTargetClusterSize() uint32 {
+	if m != nil {
+		return m.TargetClusterSize
+	}
+	return 0
+}
+
+func (m *PullUpdateRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+type PullUpdateResponse struct {
+	NextSegment uint32      `protobuf:"varint,1,opt,name=next_segment,json=nextSegment" json:"next_segment,omitempty"`
+	NextOffset  uint64      `protobuf:"varint,2,opt,name=next_offset,json=nextOffset" json:"next_offset,omitempty"`
+	Entries     []*LogEntry `protobuf:"bytes,3,rep,name=entries" json:"entries,omitempty"`
+	OutOfSync   bool        `protobuf:"varint,4,opt,name=out_of_sync,json=outOfSync" json:"out_of_sync,omitempty"`
+}
+
+func (m *PullUpdateResponse) Reset()                    { *m = PullUpdateResponse{} }
+func (m *PullUpdateResponse) String() string            { return proto.CompactTextString(m) }
+func (*PullUpdateResponse) ProtoMessage()               {}
+func (*PullUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *PullUpdateResponse) GetNextSegment() uint32 {
+	if m != nil {
+		return m.NextSegment
+	}
+	return 0
+}
+
+func (m *PullUpdateResponse) GetNextOffset() uint64 {
+	if m != nil {
+		return m.NextOffset
+	}
+	return 0
+}
+
+func (m *PullUpdateResponse) GetEntries() []*LogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *PullUpdateResponse) GetOutOfSync() bool {
+	if m != nil {
+		return m.OutOfSync
+	}
+	return false
+}
+
+type CheckBinlogRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ShardId  uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+}
+
+func (m *CheckBinlogRequest) Reset()                    { *m = CheckBinlogRequest{} }
+func (m *CheckBinlogRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckBinlogRequest) ProtoMessage()               {}
+func (*CheckBinlogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *CheckBinlogRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CheckBinlogRequest) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+type CheckBinlogResponse struct {
+	ShardId         uint32 `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	EarliestSegment uint32 `protobuf:"varint,2,opt,name=earliest_segment,json=earliestSegment" json:"earliest_segment,omitempty"`
+	LatestSegment   uint32 `protobuf:"varint,3,opt,name=latest_segment,json=latestSegment" json:"latest_segment,omitempty"`
+}
+
+func (m *CheckBinlogResponse) Reset()                    { *m = CheckBinlogResponse{} }
+func (m *CheckBinlogResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckBinlogResponse) ProtoMessage()               {}
+func (*CheckBinlogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *CheckBinlogResponse) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *CheckBinlogResponse) GetEarliestSegment() uint32 {
+	if m != nil {
+		return m.EarliestSegment
+	}
+	return 0
+}
+
+func (m *CheckBinlogResponse) GetLatestSegment() uint32 {
+	if m != nil {
+		return m.LatestSegment
+	}
+	return 0
+}
+
+type SubscribeExpiryRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ShardId  uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Prefix   []byte `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *SubscribeExpiryRequest) Reset()                    { *m = SubscribeExpiryRequest{} }
+func (m *SubscribeExpiryRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeExpiryRequest) ProtoMessage()               {}
+func (*SubscribeExpiryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *SubscribeExpiryRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *SubscribeExpiryRequest) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *SubscribeExpiryRequest) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+type ExpiryEvent struct {
+	Key             []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PartitionHash   uint64 `protobuf:"varint,2,opt,name=partition_hash,json=partitionHash" json:"partition_hash,omitempty"`
+	ExpiredAtSecond int64  `protobuf:"varint,3,opt,name=expired_at_second,json=expiredAtSecond" json:"expired_at_second,omitempty"`
+}
+
+func (m *ExpiryEvent) Reset()                    { *m = ExpiryEvent{} }
+func (m *ExpiryEvent) String() string            { return proto.CompactTextString(m) }
+func (*ExpiryEvent) ProtoMessage()               {}
+func (*ExpiryEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *ExpiryEvent) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *ExpiryEvent) GetPartitionHash() uint64 {
+	if m != nil {
+		return m.PartitionHash
+	}
+	return 0
+}
+
+func (m *ExpiryEvent) GetExpiredAtSecond() int64 {
+	if m != nil {
+		return m.ExpiredAtSecond
+	}
+	return 0
+}
+
+// ////////////////////////////////////////////////
+// // admin
+// ////////////////////////////////////////////////
+type DescribeRequest struct {
+	DescDataCenters *DescribeRequest_DescDataCenters `protobuf:"bytes,1,opt,name=desc_data_centers,json=descDataCenters" json:"desc_data_centers,omitempty"`
+	DescKeyspaces   *DescribeRequest_DescKeyspaces   `protobuf:"bytes,2,opt,name=desc_keyspaces,json=descKeyspaces" json:"desc_keyspaces,omitempty"`
+	DescCluster     *DescribeRequest_DescCluster     `protobuf:"bytes,3,opt,name=desc_cluster,json=descCluster" json:"desc_cluster,omitempty"`
+	DescClients     *DescribeRequest_DescClients     `protobuf:"bytes,4,opt,name=desc_clients,json=descClients" json:"desc_clients,omitempty"`
+}
+
+func (m *DescribeRequest) Reset()                    { *m = DescribeRequest{} }
+func (m *DescribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*DescribeRequest) ProtoMessage()               {}
+func (*DescribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *DescribeRequest) GetDescDataCenters() *DescribeRequest_DescDataCenters {
+	if m != nil {
+		return m.DescDataCenters
+	}
+	return nil
+}
+
+func (m *DescribeRequest) GetDescKeyspaces() *DescribeRequest_DescKeyspaces {
+	if m != nil {
+		return m.DescKeyspaces
+	}
+	return nil
+}
+
+func (m *DescribeRequest) GetDescCluster() *DescribeRequest_DescCluster {
+	if m != nil {
+		return m.DescCluster
+	}
+	return nil
+}
+
+func (m *DescribeRequest) GetDescClients() *DescribeRequest_DescClients {
+	if m != nil {
+		return m.DescClients
+	}
+	return nil
+}
+
+type DescribeRequest_DescDataCenters struct {
+}
+
+func (m *DescribeRequest_DescDataCenters) Reset()         { *m = DescribeRequest_DescDataCenters{} }
+func (m *DescribeRequest_DescDataCenters) String() string { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescDataCenters) ProtoMessage()    {}
+func (*DescribeRequest_DescDataCenters) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{61, 0}
+}
+
+type DescribeRequest_DescKeyspaces struct {
+}
+
+func (m *DescribeRequest_DescKeyspaces) Reset()         { *m = DescribeRequest_DescKeyspaces{} }
+func (m *DescribeRequest_DescKeyspaces) String() string { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescKeyspaces) ProtoMessage()    {}
+func (*DescribeRequest_DescKeyspaces) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{61, 1}
+}
+
+type DescribeRequest_DescCluster struct {
+	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter string `protobuf:"bytes,2,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+}
+
+func (m *DescribeRequest_DescCluster) Reset()                    { *m = DescribeRequest_DescCluster{} }
+func (m *DescribeRequest_DescCluster) String() string            { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescCluster) ProtoMessage()               {}
+func (*DescribeRequest_DescCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61, 2} }
+
+func (m *DescribeRequest_DescCluster) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DescribeRequest_DescCluster) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+type DescribeRequest_DescClients struct {
+}
+
+func (m *DescribeRequest_DescClients) Reset()                    { *m = DescribeRequest_DescClients{} }
+func (m *DescribeRequest_DescClients) String() string            { return proto.CompactTextString(m) }
+func (*DescribeRequest_DescClients) ProtoMessage()               {}
+func (*DescribeRequest_DescClients) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61, 3} }
+
+type DescribeResponse struct {
+	DescDataCenter *DescribeResponse_DescDataCenter `protobuf:"bytes,1,opt,name=desc_data_center,json=descDataCenter" json:"desc_data_center,omitempty"`
+	DescKeyspaces  *DescribeResponse_DescKeyspaces  `protobuf:"bytes,2,opt,name=desc_keyspaces,json=descKeyspaces" json:"desc_keyspaces,omitempty"`
+	DescCluster    *DescribeResponse_DescCluster    `protobuf:"bytes,3,opt,name=desc_cluster,json=descCluster" json:"desc_cluster,omitempty"`
+	ClientCount    uint32                           `protobuf:"varint,4,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+}
+
+func (m *DescribeResponse) Reset()                    { *m = DescribeResponse{} }
+func (m *DescribeResponse) String() string            { return proto.CompactTextString(m) }
+func (*DescribeResponse) ProtoMessage()               {}
+func (*DescribeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *DescribeResponse) GetDescDataCenter() *DescribeResponse_DescDataCenter {
+	if m != nil {
+		return m.DescDataCenter
+	}
+	return nil
+}
+
+func (m *DescribeResponse) GetDescKeyspaces() *DescribeResponse_DescKeyspaces {
+	if m != nil {
+		return m.DescKeyspaces
+	}
+	return nil
+}
+
+func (m *DescribeResponse) GetDescCluster() *DescribeResponse_DescCluster {
+	if m != nil {
+		return m.DescCluster
+	}
+	return nil
+}
+
+func (m *DescribeResponse) GetClientCount() uint32 {
+	if m != nil {
+		return m.ClientCount
+	}
+	return 0
+}
+
+type DescribeResponse_DescDataCenter struct {
+	// all servers in all data centers
+	DataCenter  *DescribeResponse_DescDataCenter_DataCenter   `protobuf:"bytes,1,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	DataCenters []*DescribeResponse_DescDataCenter_DataCenter `protobuf:"bytes,2,rep,name=data_centers,json=dataCenters" json:"data_centers,omitempty"`
+}
+
+func (m *DescribeResponse_DescDataCenter) Reset()         { *m = DescribeResponse_DescDataCenter{} }
+func (m *DescribeResponse_DescDataCenter) String() string { return proto.CompactTextString(m) }
+func (*DescribeResponse_DescDataCenter) ProtoMessage()    {}
+func (*DescribeResponse_DescDataCenter) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62, 0}
+}
+
+func (m *DescribeResponse_DescDataCenter) GetDataCenter() *DescribeResponse_DescDataCenter_DataCenter {
+	if m != nil {
+		return m.DataCenter
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescDataCenter) GetDataCenters() []*DescribeResponse_DescDataCenter_DataCenter {
+	if m != nil {
+		return m.DataCenters
+	}
+	return nil
+}
+
+type DescribeResponse_DescDataCenter_DataCenter struct {
+	DataCenter        string           `protobuf:"bytes,1,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	StoreResources    []*StoreResource `protobuf:"bytes,2,rep,name=store_resources,json=storeResources" json:"store_resources,omitempty"`
+	DrainingAddresses []string         `protobuf:"bytes,3,rep,name=draining_addresses,json=drainingAddresses" json:"draining_addresses,omitempty"`
+}
+
+func (m *DescribeResponse_DescDataCenter_DataCenter) Reset() {
+	*m = DescribeResponse_DescDataCenter_DataCenter{}
+}
+func (m *DescribeResponse_DescDataCenter_DataCenter) String() string {
+	return proto.CompactTextString(m)
+}
+func (*DescribeResponse_DescDataCenter_DataCenter) ProtoMessage() {}
+func (*DescribeResponse_DescDataCenter_DataCenter) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62, 0, 0}
+}
+
+func (m *DescribeResponse_DescDataCenter_DataCenter) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *DescribeResponse_DescDataCenter_DataCenter) GetStoreResources() []*StoreResource {
+	if m != nil {
+		return m.StoreResources
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescDataCenter_DataCenter) GetDrainingAddresses() []string {
+	if m != nil {
+		return m.DrainingAddresses
+	}
+	return nil
+}
+
+type DescribeResponse_DescKeyspaces struct {
+	Keyspaces []*DescribeResponse_DescKeyspaces_Keyspace `protobuf:"bytes,1,rep,name=keyspaces" json:"keyspaces,omitempty"`
+}
+
+func (m *DescribeResponse_DescKeyspaces) Reset()         { *m = DescribeResponse_DescKeyspaces{} }
+func (m *DescribeResponse_DescKeyspaces) String() string { return proto.CompactTextString(m) }
+func (*DescribeResponse_DescKeyspaces) ProtoMessage()    {}
+func (*DescribeResponse_DescKeyspaces) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62, 1}
+}
+
+func (m *DescribeResponse_DescKeyspaces) GetKeyspaces() []*DescribeResponse_DescKeyspaces_Keyspace {
+	if m != nil {
+		return m.Keyspaces
+	}
+	return nil
+}
+
+type DescribeResponse_DescKeyspaces_Keyspace struct {
+	Keyspace    string     `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Clusters    []*Cluster `protobuf:"bytes,2,rep,name=clusters" json:"clusters,omitempty"`
+	ClientCount uint32     `protobuf:"varint,3,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+}
+
+func (m *DescribeResponse_DescKeyspaces_Keyspace) Reset() {
+	*m = DescribeResponse_DescKeyspaces_Keyspace{}
+}
+func (m *DescribeResponse_DescKeyspaces_Keyspace) String() string { return proto.CompactTextString(m) }
+func (*DescribeResponse_DescKeyspaces_Keyspace) ProtoMessage()    {}
+func (*DescribeResponse_DescKeyspaces_Keyspace) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62, 1, 0}
+}
+
+func (m *DescribeResponse_DescKeyspaces_Keyspace) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DescribeResponse_DescKeyspaces_Keyspace) GetClusters() []*Cluster {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescKeyspaces_Keyspace) GetClientCount() uint32 {
+	if m != nil {
+		return m.ClientCount
+	}
+	return 0
+}
+
+type DescribeResponse_DescCluster struct {
+	Cluster             *Cluster             `protobuf:"bytes,1,opt,name=cluster" json:"cluster,omitempty"`
+	NextCluster         *Cluster             `protobuf:"bytes,2,opt,name=next_cluster,json=nextCluster" json:"next_cluster,omitempty"`
+	ClientCount         uint32               `protobuf:"varint,3,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+	ReplicationLags     []*ReplicationLag    `protobuf:"bytes,4,rep,name=replication_lags,json=replicationLags" json:"replication_lags,omitempty"`
+	PlacementWarnings   []string             `protobuf:"bytes,5,rep,name=placement_warnings,json=placementWarnings" json:"placement_warnings,omitempty"`
+	BootstrapProgresses []*BootstrapProgress `protobuf:"bytes,6,rep,name=bootstrap_progresses,json=bootstrapProgresses" json:"bootstrap_progresses,omitempty"`
+}
+
+func (m *DescribeResponse_DescCluster) Reset()         { *m = DescribeResponse_DescCluster{} }
+func (m *DescribeResponse_DescCluster) String() string { return proto.CompactTextString(m) }
+func (*DescribeResponse_DescCluster) ProtoMessage()    {}
+func (*DescribeResponse_DescCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{62, 2}
+}
+
+func (m *DescribeResponse_DescCluster) GetCluster() *Cluster {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescCluster) GetNextCluster() *Cluster {
+	if m != nil {
+		return m.NextCluster
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescCluster) GetClientCount() uint32 {
+	if m != nil {
+		return m.ClientCount
+	}
+	return 0
+}
+
+func (m *DescribeResponse_DescCluster) GetReplicationLags() []*ReplicationLag {
+	if m != nil {
+		return m.ReplicationLags
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescCluster) GetPlacementWarnings() []string {
+	if m != nil {
+		return m.PlacementWarnings
+	}
+	return nil
+}
+
+func (m *DescribeResponse_DescCluster) GetBootstrapProgresses() []*BootstrapProgress {
+	if m != nil {
+		return m.BootstrapProgresses
+	}
+	return nil
+}
+
+type CreateClusterRequest struct {
+	Keyspace          string            `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	ClusterSize       uint32            `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32            `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	TotalDiskSizeGb   uint32            `protobuf:"varint,5,opt,name=total_disk_size_gb,json=totalDiskSizeGb" json:"total_disk_size_gb,omitempty"`
+	Tags              []string          `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+	Settings          *KeyspaceSettings `protobuf:"bytes,7,opt,name=settings" json:"settings,omitempty"`
+	DataCenter        string            `protobuf:"bytes,8,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	// the fixed number of virtual shards, placed onto cluster_size servers. 0 means one shard per server.
+	ShardCount uint32 `protobuf:"varint,9,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+	DryRun     bool   `protobuf:"varint,10,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+}
+
+func (m *CreateClusterRequest) Reset()                    { *m = CreateClusterRequest{} }
+func (m *CreateClusterRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateClusterRequest) ProtoMessage()               {}
+func (*CreateClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *CreateClusterRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CreateClusterRequest) GetClusterSize() uint32 {
+	if m != nil {
+		return m.ClusterSize
+	}
+	return 0
+}
+
+func (m *CreateClusterRequest) GetReplicationFactor() uint32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *CreateClusterRequest) GetTotalDiskSizeGb() uint32 {
+	if m != nil {
+		return m.TotalDiskSizeGb
+	}
+	return 0
+}
+
+func (m *CreateClusterRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CreateClusterRequest) GetSettings() *KeyspaceSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *CreateClusterRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *CreateClusterRequest) GetShardCount() uint32 {
+	if m != nil {
+		return m.ShardCount
+	}
+	return 0
+}
+
+func (m *CreateClusterRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type CreateClusterResponse struct {
+	Error   string              `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Cluster *Cluster            `protobuf:"bytes,2,opt,name=cluster" json:"cluster,omitempty"`
+	Plan    *TopologyChangePlan `protobuf:"bytes,3,opt,name=plan" json:"plan,omitempty"`
+	JobId   string              `protobuf:"bytes,4,opt,name=job_id,json=jobId" json:"job_id,omitempty"`
+}
+
+func (m *CreateClusterResponse) Reset()                    { *m = CreateClusterResponse{} }
+func (m *CreateClusterResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateClusterResponse) ProtoMessage()               {}
+func (*CreateClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *CreateClusterResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CreateClusterResponse) GetCluster() *Cluster {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+func (m *CreateClusterResponse) GetPlan() *TopologyChangePlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *CreateClusterResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type DeleteClusterRequest struct {
+	Keyspace   string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter string `protobuf:"bytes,3,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+}
+
+func (m *DeleteClusterRequest) Reset()                    { *m = DeleteClusterRequest{} }
+func (m *DeleteClusterRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteClusterRequest) ProtoMessage()               {}
+func (*DeleteClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *DeleteClusterRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DeleteClusterRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+type DeleteClusterResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId" json:"job_id,omitempty"`
+}
+
+func (m *DeleteClusterResponse) Reset()                    { *m = DeleteClusterResponse{} }
+func (m *DeleteClusterResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteClusterResponse) ProtoMessage()               {}
+func (*DeleteClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *DeleteClusterResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeleteClusterResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type CompactClusterRequest struct {
+	Keyspace string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+}
+
+func (m *CompactClusterRequest) Reset()                    { *m = CompactClusterRequest{} }
+func (m *CompactClusterRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompactClusterRequest) ProtoMessage()               {}
+func (*CompactClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *CompactClusterRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type CompactClusterResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId" json:"job_id,omitempty"`
+}
+
+func (m *CompactClusterResponse) Reset()                    { *m = CompactClusterResponse{} }
+func (m *CompactClusterResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactClusterResponse) ProtoMessage()               {}
+func (*CompactClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *CompactClusterResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CompactClusterResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type DefineIndexRequest struct {
+	Keyspace string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Index    *IndexDefinition `protobuf:"bytes,2,opt,name=index" json:"index,omitempty"`
+	// remove the index and its entries
+	IsDrop bool `protobuf:"varint,3,opt,name=is_drop,json=isDrop" json:"is_drop,omitempty"`
+	// set by the master when the index is defined
+	UpdatedAtNs uint64 `protobuf:"varint,4,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
+}
+
+func (m *DefineIndexRequest) Reset()                    { *m = DefineIndexRequest{} }
+func (m *DefineIndexRequest) String() string            { return proto.CompactTextString(m) }
+func (*DefineIndexRequest) ProtoMessage()               {}
+func (*DefineIndexRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *DefineIndexRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DefineIndexRequest) GetIndex() *IndexDefinition {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+func (m *DefineIndexRequest) GetIsDrop() bool {
+	if m != nil {
+		return m.IsDrop
+	}
+	return false
+}
+
+func (m *DefineIndexRequest) GetUpdatedAtNs() uint64 {
+	if m != nil {
+		return m.UpdatedAtNs
+	}
+	return 0
+}
+
+type DefineIndexResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *DefineIndexResponse) Reset()                    { *m = DefineIndexResponse{} }
+func (m *DefineIndexResponse) String() string            { return proto.CompactTextString(m) }
+func (*DefineIndexResponse) ProtoMessage()               {}
+func (*DefineIndexResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *DefineIndexResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type UpdateKeyspaceRequest struct {
+	Keyspace string            `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Settings *KeyspaceSettings `protobuf:"bytes,2,opt,name=settings" json:"settings,omitempty"`
+}
+
+func (m *UpdateKeyspaceRequest) Reset()                    { *m = UpdateKeyspaceRequest{} }
+func (m *UpdateKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateKeyspaceRequest) ProtoMessage()               {}
+func (*UpdateKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *UpdateKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *UpdateKeyspaceRequest) GetSettings() *KeyspaceSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type UpdateKeyspaceResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *UpdateKeyspaceResponse) Reset()                    { *m = UpdateKeyspaceResponse{} }
+func (m *UpdateKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateKeyspaceResponse) ProtoMessage()               {}
+func (*UpdateKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *UpdateKeyspaceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ReplaceNodeRequest struct {
+	Keyspace   string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	NodeId     uint32 `protobuf:"varint,3,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
+	NewAddress string `protobuf:"bytes,4,opt,name=new_address,json=newAddress" json:"new_address,omitempty"`
+	DataCenter string `protobuf:"bytes,5,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	DryRun     bool   `protobuf:"varint,6,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+}
+
+func (m *ReplaceNodeRequest) Reset()                    { *m = ReplaceNodeRequest{} }
+func (m *ReplaceNodeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplaceNodeRequest) ProtoMessage()               {}
+func (*ReplaceNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *ReplaceNodeRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReplaceNodeRequest) GetNodeId() uint32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *ReplaceNodeRequest) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+func (m *ReplaceNodeRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *ReplaceNodeRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ReplaceNodeResponse struct {
+	Error string              `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Plan  *TopologyChangePlan `protobuf:"bytes,2,opt,name=plan" json:"plan,omitempty"`
+	JobId string              `protobuf:"bytes,3,opt,name=job_id,json=jobId" json:"job_id,omitempty"`
+}
+
+func (m *ReplaceNodeResponse) Reset()                    { *m = ReplaceNodeResponse{} }
+func (m *ReplaceNodeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplaceNodeResponse) ProtoMessage()               {}
+func (*ReplaceNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *ReplaceNodeResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
 
-func (m *Response) GetWrite() *WriteResponse {
+func (m *ReplaceNodeResponse) GetPlan() *TopologyChangePlan {
 	if m != nil {
-		return m.Write
+		return m.Plan
 	}
 	return nil
 }
 
-func (m *Response) GetGet() *GetResponse {
+func (m *ReplaceNodeResponse) GetJobId() string {
 	if m != nil {
-		return m.Get
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *Response) GetGetByPrefix() *GetByPrefixResponse {
+type DrainStoreRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	DataCenter string `protobuf:"bytes,2,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	DryRun     bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	Cancel     bool   `protobuf:"varint,4,opt,name=cancel" json:"cancel,omitempty"`
+}
+
+func (m *DrainStoreRequest) Reset()                    { *m = DrainStoreRequest{} }
+func (m *DrainStoreRequest) String() string            { return proto.CompactTextString(m) }
+func (*DrainStoreRequest) ProtoMessage()               {}
+func (*DrainStoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *DrainStoreRequest) GetAddress() string {
 	if m != nil {
-		return m.GetByPrefix
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type RawKeyValue struct {
-	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *DrainStoreRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
 }
 
-func (m *RawKeyValue) Reset()                    { *m = RawKeyValue{} }
-func (m *RawKeyValue) String() string            { return proto.CompactTextString(m) }
-func (*RawKeyValue) ProtoMessage()               {}
-func (*RawKeyValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *DrainStoreRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
 
-func (m *RawKeyValue) GetKey() []byte {
+func (m *DrainStoreRequest) GetCancel() bool {
 	if m != nil {
-		return m.Key
+		return m.Cancel
 	}
-	return nil
+	return false
 }
 
-func (m *RawKeyValue) GetValue() []byte {
+type DrainStoreResponse struct {
+	Moves     []*ShardMove `protobuf:"bytes,1,rep,name=moves" json:"moves,omitempty"`
+	DoneMoves uint32       `protobuf:"varint,2,opt,name=done_moves,json=doneMoves" json:"done_moves,omitempty"`
+	IsEmpty   bool         `protobuf:"varint,3,opt,name=is_empty,json=isEmpty" json:"is_empty,omitempty"`
+	Error     string       `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *DrainStoreResponse) Reset()                    { *m = DrainStoreResponse{} }
+func (m *DrainStoreResponse) String() string            { return proto.CompactTextString(m) }
+func (*DrainStoreResponse) ProtoMessage()               {}
+func (*DrainStoreResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *DrainStoreResponse) GetMoves() []*ShardMove {
 	if m != nil {
-		return m.Value
+		return m.Moves
 	}
 	return nil
 }
 
-type LogEntry struct {
-	UpdatedAtNs uint64         `protobuf:"varint,1,opt,name=updated_at_ns,json=updatedAtNs" json:"updated_at_ns,omitempty"`
-	Put         *PutRequest    `protobuf:"bytes,2,opt,name=put" json:"put,omitempty"`
-	Delete      *DeleteRequest `protobuf:"bytes,3,opt,name=delete" json:"delete,omitempty"`
-	Merge       *MergeRequest  `protobuf:"bytes,4,opt,name=merge" json:"merge,omitempty"`
+func (m *DrainStoreResponse) GetDoneMoves() uint32 {
+	if m != nil {
+		return m.DoneMoves
+	}
+	return 0
 }
 
-func (m *LogEntry) Reset()                    { *m = LogEntry{} }
-func (m *LogEntry) String() string            { return proto.CompactTextString(m) }
-func (*LogEntry) ProtoMessage()               {}
-func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (m *DrainStoreResponse) GetIsEmpty() bool {
+	if m != nil {
+		return m.IsEmpty
+	}
+	return false
+}
 
-func (m *LogEntry) GetUpdatedAtNs() uint64 {
+func (m *DrainStoreResponse) GetError() string {
 	if m != nil {
-		return m.UpdatedAtNs
+		return m.Error
+	}
+	return ""
+}
+
+// SetBootstrapThrottleRequest sets the bandwidth limit of bootstrap copies sent by the store,
+// or by all stores in the data center if the address is empty.
+type SetBootstrapThrottleRequest struct {
+	DataCenter     string `protobuf:"bytes,1,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	BytesPerSecond uint64 `protobuf:"varint,3,opt,name=bytes_per_second,json=bytesPerSecond" json:"bytes_per_second,omitempty"`
+}
+
+func (m *SetBootstrapThrottleRequest) Reset()                    { *m = SetBootstrapThrottleRequest{} }
+func (m *SetBootstrapThrottleRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetBootstrapThrottleRequest) ProtoMessage()               {}
+func (*SetBootstrapThrottleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *SetBootstrapThrottleRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *SetBootstrapThrottleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SetBootstrapThrottleRequest) GetBytesPerSecond() uint64 {
+	if m != nil {
+		return m.BytesPerSecond
 	}
 	return 0
 }
 
-func (m *LogEntry) GetPut() *PutRequest {
+type SetBootstrapThrottleResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *SetBootstrapThrottleResponse) Reset()                    { *m = SetBootstrapThrottleResponse{} }
+func (m *SetBootstrapThrottleResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetBootstrapThrottleResponse) ProtoMessage()               {}
+func (*SetBootstrapThrottleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *SetBootstrapThrottleResponse) GetError() string {
 	if m != nil {
-		return m.Put
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *LogEntry) GetDelete() *DeleteRequest {
+// //////  request response with store
+type CreateShardRequest struct {
+	Keyspace          string            `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId          uint32            `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize       uint32            `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32            `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	ShardDiskSizeGb   uint32            `protobuf:"varint,5,opt,name=shard_disk_size_gb,json=shardDiskSizeGb" json:"shard_disk_size_gb,omitempty"`
+	Settings          *KeyspaceSettings `protobuf:"bytes,6,opt,name=settings" json:"settings,omitempty"`
+	ShardCount        uint32            `protobuf:"varint,7,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+	Indexes           *KeyspaceIndexes  `protobuf:"bytes,8,opt,name=indexes" json:"indexes,omitempty"`
+}
+
+func (m *CreateShardRequest) Reset()                    { *m = CreateShardRequest{} }
+func (m *CreateShardRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateShardRequest) ProtoMessage()               {}
+func (*CreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *CreateShardRequest) GetKeyspace() string {
 	if m != nil {
-		return m.Delete
+		return m.Keyspace
 	}
-	return nil
+	return ""
 }
 
-func (m *LogEntry) GetMerge() *MergeRequest {
+func (m *CreateShardRequest) GetServerId() uint32 {
 	if m != nil {
-		return m.Merge
+		return m.ServerId
 	}
-	return nil
+	return 0
 }
 
-// ////////////////////////////////////////////////
-// // data copying
-// ////////////////////////////////////////////////
-type CopyDoneMessge struct {
-	Shard           int32 `protobuf:"varint,1,opt,name=shard" json:"shard,omitempty"`
-	CopyStartTimeNs int64 `protobuf:"varint,2,opt,name=copy_start_time_ns,json=copyStartTimeNs" json:"copy_start_time_ns,omitempty"`
+func (m *CreateShardRequest) GetClusterSize() uint32 {
+	if m != nil {
+		return m.ClusterSize
+	}
+	return 0
 }
 
-func (m *CopyDoneMessge) Reset()                    { *m = CopyDoneMessge{} }
-func (m *CopyDoneMessge) String() string            { return proto.CompactTextString(m) }
-func (*CopyDoneMessge) ProtoMessage()               {}
-func (*CopyDoneMessge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *CreateShardRequest) GetReplicationFactor() uint32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
 
-func (m *CopyDoneMessge) GetShard() int32 {
+func (m *CreateShardRequest) GetShardDiskSizeGb() uint32 {
 	if m != nil {
-		return m.Shard
+		return m.ShardDiskSizeGb
 	}
 	return 0
 }
 
-func (m *CopyDoneMessge) GetCopyStartTimeNs() int64 {
+func (m *CreateShardRequest) GetSettings() *KeyspaceSettings {
 	if m != nil {
-		return m.CopyStartTimeNs
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *CreateShardRequest) GetShardCount() uint32 {
+	if m != nil {
+		return m.ShardCount
 	}
 	return 0
 }
 
-type BootstrapCopyRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ShardId           uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	TargetShardId     uint32 `protobuf:"varint,4,opt,name=target_shard_id,json=targetShardId" json:"target_shard_id,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
-	Origin            string `protobuf:"bytes,6,opt,name=origin" json:"origin,omitempty"`
-	Limit             uint64 `protobuf:"varint,7,opt,name=limit" json:"limit,omitempty"`
+func (m *CreateShardRequest) GetIndexes() *KeyspaceIndexes {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
 }
 
-func (m *BootstrapCopyRequest) Reset()                    { *m = BootstrapCopyRequest{} }
-func (m *BootstrapCopyRequest) String() string            { return proto.CompactTextString(m) }
-func (*BootstrapCopyRequest) ProtoMessage()               {}
-func (*BootstrapCopyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+type CreateShardResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
 
-func (m *BootstrapCopyRequest) GetKeyspace() string {
+func (m *CreateShardResponse) Reset()                    { *m = CreateShardResponse{} }
+func (m *CreateShardResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateShardResponse) ProtoMessage()               {}
+func (*CreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *CreateShardResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DeleteKeyspaceRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+}
+
+func (m *DeleteKeyspaceRequest) Reset()                    { *m = DeleteKeyspaceRequest{} }
+func (m *DeleteKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceRequest) ProtoMessage()               {}
+func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *DeleteKeyspaceRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *BootstrapCopyRequest) GetShardId() uint32 {
+type DeleteKeyspaceResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *DeleteKeyspaceResponse) Reset()                    { *m = DeleteKeyspaceResponse{} }
+func (m *DeleteKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceResponse) ProtoMessage()               {}
+func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *DeleteKeyspaceResponse) GetError() string {
 	if m != nil {
-		return m.ShardId
+		return m.Error
+	}
+	return ""
+}
+
+type CompactKeyspaceRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+}
+
+func (m *CompactKeyspaceRequest) Reset()                    { *m = CompactKeyspaceRequest{} }
+func (m *CompactKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompactKeyspaceRequest) ProtoMessage()               {}
+func (*CompactKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *CompactKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type CompactKeyspaceResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *CompactKeyspaceResponse) Reset()                    { *m = CompactKeyspaceResponse{} }
+func (m *CompactKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactKeyspaceResponse) ProtoMessage()               {}
+func (*CompactKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *CompactKeyspaceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ReplicateNodePrepareRequest struct {
+	Keyspace          string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	ShardCount        uint32           `protobuf:"varint,5,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+	Indexes           *KeyspaceIndexes `protobuf:"bytes,6,opt,name=indexes" json:"indexes,omitempty"`
+}
+
+func (m *ReplicateNodePrepareRequest) Reset()                    { *m = ReplicateNodePrepareRequest{} }
+func (m *ReplicateNodePrepareRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplicateNodePrepareRequest) ProtoMessage()               {}
+func (*ReplicateNodePrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *ReplicateNodePrepareRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ReplicateNodePrepareRequest) GetServerId() uint32 {
+	if m != nil {
+		return m.ServerId
 	}
 	return 0
 }
 
-func (m *BootstrapCopyRequest) GetClusterSize() uint32 {
+func (m *ReplicateNodePrepareRequest) GetClusterSize() uint32 {
 	if m != nil {
 		return m.ClusterSize
 	}
 	return 0
 }
 
-func (m *BootstrapCopyRequest) GetTargetShardId() uint32 {
+func (m *ReplicateNodePrepareRequest) GetReplicationFactor() uint32 {
 	if m != nil {
-		return m.TargetShardId
+		return m.ReplicationFactor
 	}
 	return 0
 }
 
-func (m *BootstrapCopyRequest) GetTargetClusterSize() uint32 {
+func (m *ReplicateNodePrepareRequest) GetShardCount() uint32 {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.ShardCount
 	}
 	return 0
 }
 
-func (m *BootstrapCopyRequest) GetOrigin() string {
+func (m *ReplicateNodePrepareRequest) GetIndexes() *KeyspaceIndexes {
 	if m != nil {
-		return m.Origin
+		return m.Indexes
 	}
-	return ""
+	return nil
+}
+
+type ReplicateNodePrepareResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *BootstrapCopyRequest) GetLimit() uint64 {
+func (m *ReplicateNodePrepareResponse) Reset()                    { *m = ReplicateNodePrepareResponse{} }
+func (m *ReplicateNodePrepareResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplicateNodePrepareResponse) ProtoMessage()               {}
+func (*ReplicateNodePrepareResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *ReplicateNodePrepareResponse) GetError() string {
 	if m != nil {
-		return m.Limit
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type BootstrapCopyResponse struct {
-	KeyValues          []*RawKeyValue                            `protobuf:"bytes,1,rep,name=key_values,json=keyValues" json:"key_values,omitempty"`
-	BinlogTailProgress *BootstrapCopyResponse_BinlogTailProgress `protobuf:"bytes,2,opt,name=binlogTailProgress" json:"binlogTailProgress,omitempty"`
+type ReplicateNodeCommitRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
 }
 
-func (m *BootstrapCopyResponse) Reset()                    { *m = BootstrapCopyResponse{} }
-func (m *BootstrapCopyResponse) String() string            { return proto.CompactTextString(m) }
-func (*BootstrapCopyResponse) ProtoMessage()               {}
-func (*BootstrapCopyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (m *ReplicateNodeCommitRequest) Reset()                    { *m = ReplicateNodeCommitRequest{} }
+func (m *ReplicateNodeCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplicateNodeCommitRequest) ProtoMessage()               {}
+func (*ReplicateNodeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
-func (m *BootstrapCopyResponse) GetKeyValues() []*RawKeyValue {
+func (m *ReplicateNodeCommitRequest) GetKeyspace() string {
 	if m != nil {
-		return m.KeyValues
+		return m.Keyspace
 	}
-	return nil
+	return ""
 }
 
-func (m *BootstrapCopyResponse) GetBinlogTailProgress() *BootstrapCopyResponse_BinlogTailProgress {
+type ReplicateNodeCommitResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ReplicateNodeCommitResponse) Reset()                    { *m = ReplicateNodeCommitResponse{} }
+func (m *ReplicateNodeCommitResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplicateNodeCommitResponse) ProtoMessage()               {}
+func (*ReplicateNodeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *ReplicateNodeCommitResponse) GetError() string {
 	if m != nil {
-		return m.BinlogTailProgress
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-// this message is only sent at the end of copying
-type BootstrapCopyResponse_BinlogTailProgress struct {
-	Segment uint32 `protobuf:"varint,1,opt,name=segment" json:"segment,omitempty"`
-	Offset  uint64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+type ReplicateNodeCleanupRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
 }
 
-func (m *BootstrapCopyResponse_BinlogTailProgress) Reset() {
-	*m = BootstrapCopyResponse_BinlogTailProgress{}
-}
-func (m *BootstrapCopyResponse_BinlogTailProgress) String() string { return proto.CompactTextString(m) }
-func (*BootstrapCopyResponse_BinlogTailProgress) ProtoMessage()    {}
-func (*BootstrapCopyResponse_BinlogTailProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{28, 0}
-}
+func (m *ReplicateNodeCleanupRequest) Reset()                    { *m = ReplicateNodeCleanupRequest{} }
+func (m *ReplicateNodeCleanupRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplicateNodeCleanupRequest) ProtoMessage()               {}
+func (*ReplicateNodeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
-func (m *BootstrapCopyResponse_BinlogTailProgress) GetSegment() uint32 {
+func (m *ReplicateNodeCleanupRequest) GetKeyspace() string {
 	if m != nil {
-		return m.Segment
+		return m.Keyspace
 	}
-	return 0
+	return ""
 }
 
-func (m *BootstrapCopyResponse_BinlogTailProgress) GetOffset() uint64 {
+type ReplicateNodeCleanupResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ReplicateNodeCleanupResponse) Reset()                    { *m = ReplicateNodeCleanupResponse{} }
+func (m *ReplicateNodeCleanupResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReplicateNodeCleanupResponse) ProtoMessage()               {}
+func (*ReplicateNodeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *ReplicateNodeCleanupResponse) GetError() string {
 	if m != nil {
-		return m.Offset
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type PullUpdateRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ShardId           uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
-	Segment           uint32 `protobuf:"varint,3,opt,name=segment" json:"segment,omitempty"`
-	Offset            uint64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
-	Limit             uint32 `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
-	TargetShardId     uint32 `protobuf:"varint,6,opt,name=target_shard_id,json=targetShardId" json:"target_shard_id,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,7,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
-	Origin            string `protobuf:"bytes,8,opt,name=origin" json:"origin,omitempty"`
+type ResizeCreateShardRequest struct {
+	Keyspace          string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId          uint32           `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor uint32           `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	TargetClusterSize uint32           `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	ShardCount        uint32           `protobuf:"varint,6,opt,name=shard_count,json=shardCount" json:"shard_count,omitempty"`
+	Indexes           *KeyspaceIndexes `protobuf:"bytes,7,opt,name=indexes" json:"indexes,omitempty"`
 }
 
-func (m *PullUpdateRequest) Reset()                    { *m = PullUpdateRequest{} }
-func (m *PullUpdateRequest) String() string            { return proto.CompactTextString(m) }
-func (*PullUpdateRequest) ProtoMessage()               {}
-func (*PullUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (m *ResizeCreateShardRequest) Reset()                    { *m = ResizeCreateShardRequest{} }
+func (m *ResizeCreateShardRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResizeCreateShardRequest) ProtoMessage()               {}
+func (*ResizeCreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
-func (m *PullUpdateRequest) GetKeyspace() string {
+func (m *ResizeCreateShardRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *PullUpdateRequest) GetShardId() uint32 {
+func (m *ResizeCreateShardRequest) GetServerId() uint32 {
 	if m != nil {
-		return m.ShardId
+		return m.ServerId
 	}
 	return 0
 }
 
-func (m *PullUpdateRequest) GetSegment() uint32 {
+func (m *ResizeCreateShardRequest) GetClusterSize() uint32 {
 	if m != nil {
-		return m.Segment
+		return m.ClusterSize
 	}
 	return 0
 }
 
-func (m *PullUpdateRequest) GetOffset() uint64 {
+func (m *ResizeCreateShardRequest) GetReplicationFactor() uint32 {
 	if m != nil {
-		return m.Offset
+		return m.ReplicationFactor
 	}
 	return 0
 }
 
-func (m *PullUpdateRequest) GetLimit() uint32 {
+func (m *ResizeCreateShardRequest) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.Limit
+		return m.TargetClusterSize
 	}
 	return 0
 }
 
-func (m *PullUpdateRequest) GetTargetShardId() uint32 {
+func (m *ResizeCreateShardRequest) GetShardCount() uint32 {
 	if m != nil {
-		return m.TargetShardId
+		return m.ShardCount
 	}
 	return 0
 }
 
-func (m *PullUpdateRequest) GetTargetClusterSize() uint32 {
+func (m *ResizeCreateShardRequest) GetIndexes() *KeyspaceIndexes {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.Indexes
 	}
-	return 0
+	return nil
 }
 
-func (m *PullUpdateRequest) GetOrigin() string {
+type ResizeCreateShardResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ResizeCreateShardResponse) Reset()                    { *m = ResizeCreateShardResponse{} }
+func (m *ResizeCreateShardResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResizeCreateShardResponse) ProtoMessage()               {}
+func (*ResizeCreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *ResizeCreateShardResponse) GetError() string {
 	if m != nil {
-		return m.Origin
+		return m.Error
 	}
 	return ""
 }
 
-type PullUpdateResponse struct {
-	NextSegment uint32      `protobuf:"varint,1,opt,name=next_segment,json=nextSegment" json:"next_segment,omitempty"`
-	NextOffset  uint64      `protobuf:"varint,2,opt,name=next_offset,json=nextOffset" json:"next_offset,omitempty"`
-	Entries     []*LogEntry `protobuf:"bytes,3,rep,name=entries" json:"entries,omitempty"`
-	OutOfSync   bool        `protobuf:"varint,4,opt,name=out_of_sync,json=outOfSync" json:"out_of_sync,omitempty"`
+type ResizeCommitRequest struct {
+	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	TargetClusterSize uint32 `protobuf:"varint,2,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
 }
 
-func (m *PullUpdateResponse) Reset()                    { *m = PullUpdateResponse{} }
-func (m *PullUpdateResponse) String() string            { return proto.CompactTextString(m) }
-func (*PullUpdateResponse) ProtoMessage()               {}
-func (*PullUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (m *ResizeCommitRequest) Reset()                    { *m = ResizeCommitRequest{} }
+func (m *ResizeCommitRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResizeCommitRequest) ProtoMessage()               {}
+func (*ResizeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
-func (m *PullUpdateResponse) GetNextSegment() uint32 {
+func (m *ResizeCommitRequest) GetKeyspace() string {
 	if m != nil {
-		return m.NextSegment
+		return m.Keyspace
 	}
-	return 0
+	return ""
 }
 
-func (m *PullUpdateResponse) GetNextOffset() uint64 {
+func (m *ResizeCommitRequest) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.NextOffset
+		return m.TargetClusterSize
 	}
 	return 0
 }
 
-func (m *PullUpdateResponse) GetEntries() []*LogEntry {
-	if m != nil {
-		return m.Entries
-	}
-	return nil
+type ResizeCommitResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *PullUpdateResponse) GetOutOfSync() bool {
+func (m *ResizeCommitResponse) Reset()                    { *m = ResizeCommitResponse{} }
+func (m *ResizeCommitResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResizeCommitResponse) ProtoMessage()               {}
+func (*ResizeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *ResizeCommitResponse) GetError() string {
 	if m != nil {
-		return m.OutOfSync
+		return m.Error
 	}
-	return false
+	return ""
 }
 
-type CheckBinlogRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ShardId  uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+type ResizeCleanupRequest struct {
+	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	TargetClusterSize uint32 `protobuf:"varint,2,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
 }
 
-func (m *CheckBinlogRequest) Reset()                    { *m = CheckBinlogRequest{} }
-func (m *CheckBinlogRequest) String() string            { return proto.CompactTextString(m) }
-func (*CheckBinlogRequest) ProtoMessage()               {}
-func (*CheckBinlogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (m *ResizeCleanupRequest) Reset()                    { *m = ResizeCleanupRequest{} }
+func (m *ResizeCleanupRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResizeCleanupRequest) ProtoMessage()               {}
+func (*ResizeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
-func (m *CheckBinlogRequest) GetKeyspace() string {
+func (m *ResizeCleanupRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *CheckBinlogRequest) GetShardId() uint32 {
+func (m *ResizeCleanupRequest) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.ShardId
+		return m.TargetClusterSize
 	}
 	return 0
 }
 
-type CheckBinlogResponse struct {
-	ShardId         uint32 `protobuf:"varint,1,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
-	EarliestSegment uint32 `protobuf:"varint,2,opt,name=earliest_segment,json=earliestSegment" json:"earliest_segment,omitempty"`
-	LatestSegment   uint32 `protobuf:"varint,3,opt,name=latest_segment,json=latestSegment" json:"latest_segment,omitempty"`
+type ResizeCleanupResponse struct {
+	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *CheckBinlogResponse) Reset()                    { *m = CheckBinlogResponse{} }
-func (m *CheckBinlogResponse) String() string            { return proto.CompactTextString(m) }
-func (*CheckBinlogResponse) ProtoMessage()               {}
-func (*CheckBinlogResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (m *ResizeCleanupResponse) Reset()                    { *m = ResizeCleanupResponse{} }
+func (m *ResizeCleanupResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResizeCleanupResponse) ProtoMessage()               {}
+func (*ResizeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
-func (m *CheckBinlogResponse) GetShardId() uint32 {
+func (m *ResizeCleanupResponse) GetError() string {
 	if m != nil {
-		return m.ShardId
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-func (m *CheckBinlogResponse) GetEarliestSegment() uint32 {
+type ResizeRequest struct {
+	Keyspace          string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	TargetClusterSize uint32 `protobuf:"varint,3,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	DataCenter        string `protobuf:"bytes,4,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	DryRun            bool   `protobuf:"varint,5,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+}
+
+func (m *ResizeRequest) Reset()                    { *m = ResizeRequest{} }
+func (m *ResizeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResizeRequest) ProtoMessage()               {}
+func (*ResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *ResizeRequest) GetKeyspace() string {
 	if m != nil {
-		return m.EarliestSegment
+		return m.Keyspace
 	}
-	return 0
+	return ""
 }
 
-func (m *CheckBinlogResponse) GetLatestSegment() uint32 {
+func (m *ResizeRequest) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.LatestSegment
+		return m.TargetClusterSize
 	}
 	return 0
 }
 
-// ////////////////////////////////////////////////
-// // admin
-// ////////////////////////////////////////////////
-type DescribeRequest struct {
-	DescDataCenters *DescribeRequest_DescDataCenters `protobuf:"bytes,1,opt,name=desc_data_centers,json=descDataCenters" json:"desc_data_centers,omitempty"`
-	DescKeyspaces   *DescribeRequest_DescKeyspaces   `protobuf:"bytes,2,opt,name=desc_keyspaces,json=descKeyspaces" json:"desc_keyspaces,omitempty"`
-	DescCluster     *DescribeRequest_DescCluster     `protobuf:"bytes,3,opt,name=desc_cluster,json=descCluster" json:"desc_cluster,omitempty"`
-	DescClients     *DescribeRequest_DescClients     `protobuf:"bytes,4,opt,name=desc_clients,json=descClients" json:"desc_clients,omitempty"`
+func (m *ResizeRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
 }
 
-func (m *DescribeRequest) Reset()                    { *m = DescribeRequest{} }
-func (m *DescribeRequest) String() string            { return proto.CompactTextString(m) }
-func (*DescribeRequest) ProtoMessage()               {}
-func (*DescribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
-
-func (m *DescribeRequest) GetDescDataCenters() *DescribeRequest_DescDataCenters {
+func (m *ResizeRequest) GetDryRun() bool {
 	if m != nil {
-		return m.DescDataCenters
+		return m.DryRun
 	}
-	return nil
+	return false
 }
 
-func (m *DescribeRequest) GetDescKeyspaces() *DescribeRequest_DescKeyspaces {
+type ResizeResponse struct {
+	Error string              `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	JobId string              `protobuf:"bytes,2,opt,name=job_id,json=jobId" json:"job_id,omitempty"`
+	Plan  *TopologyChangePlan `protobuf:"bytes,3,opt,name=plan" json:"plan,omitempty"`
+}
+
+func (m *ResizeResponse) Reset()                    { *m = ResizeResponse{} }
+func (m *ResizeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResizeResponse) ProtoMessage()               {}
+func (*ResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *ResizeResponse) GetError() string {
 	if m != nil {
-		return m.DescKeyspaces
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *DescribeRequest) GetDescCluster() *DescribeRequest_DescCluster {
+func (m *ResizeResponse) GetJobId() string {
 	if m != nil {
-		return m.DescCluster
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *DescribeRequest) GetDescClients() *DescribeRequest_DescClients {
+func (m *ResizeResponse) GetPlan() *TopologyChangePlan {
 	if m != nil {
-		return m.DescClients
+		return m.Plan
 	}
 	return nil
 }
 
-type DescribeRequest_DescDataCenters struct {
-}
-
-func (m *DescribeRequest_DescDataCenters) Reset()         { *m = DescribeRequest_DescDataCenters{} }
-func (m *DescribeRequest_DescDataCenters) String() string { return proto.CompactTextString(m) }
-func (*DescribeRequest_DescDataCenters) ProtoMessage()    {}
-func (*DescribeRequest_DescDataCenters) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{33, 0}
-}
-
-type DescribeRequest_DescKeyspaces struct {
-}
-
-func (m *DescribeRequest_DescKeyspaces) Reset()         { *m = DescribeRequest_DescKeyspaces{} }
-func (m *DescribeRequest_DescKeyspaces) String() string { return proto.CompactTextString(m) }
-func (*DescribeRequest_DescKeyspaces) ProtoMessage()    {}
-func (*DescribeRequest_DescKeyspaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{33, 1}
-}
-
-type DescribeRequest_DescCluster struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+// TopologyChangePlan is what creating, resizing, or replacing a node of a cluster would do,
+// planned without touching the stores
+type TopologyChangePlan struct {
+	Keyspace          string           `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ClusterSize       uint32           `protobuf:"varint,2,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	TargetClusterSize uint32           `protobuf:"varint,3,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	NewServers        []*PlannedServer `protobuf:"bytes,4,rep,name=new_servers,json=newServers" json:"new_servers,omitempty"`
+	// the shards to create or bootstrap
+	Shards []*ShardBootstrapPlan `protobuf:"bytes,5,rep,name=shards" json:"shards,omitempty"`
+	// estimated from the current shard sizes
+	EstimatedBytes uint64 `protobuf:"varint,6,opt,name=estimated_bytes,json=estimatedBytes" json:"estimated_bytes,omitempty"`
 }
 
-func (m *DescribeRequest_DescCluster) Reset()                    { *m = DescribeRequest_DescCluster{} }
-func (m *DescribeRequest_DescCluster) String() string            { return proto.CompactTextString(m) }
-func (*DescribeRequest_DescCluster) ProtoMessage()               {}
-func (*DescribeRequest_DescCluster) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33, 2} }
+func (m *TopologyChangePlan) Reset()                    { *m = TopologyChangePlan{} }
+func (m *TopologyChangePlan) String() string            { return proto.CompactTextString(m) }
+func (*TopologyChangePlan) ProtoMessage()               {}
+func (*TopologyChangePlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
-func (m *DescribeRequest_DescCluster) GetKeyspace() string {
+func (m *TopologyChangePlan) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-type DescribeRequest_DescClients struct {
-}
-
-func (m *DescribeRequest_DescClients) Reset()                    { *m = DescribeRequest_DescClients{} }
-func (m *DescribeRequest_DescClients) String() string            { return proto.CompactTextString(m) }
-func (*DescribeRequest_DescClients) ProtoMessage()               {}
-func (*DescribeRequest_DescClients) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33, 3} }
-
-type DescribeResponse struct {
-	DescDataCenter *DescribeResponse_DescDataCenter `protobuf:"bytes,1,opt,name=desc_data_center,json=descDataCenter" json:"desc_data_center,omitempty"`
-	DescKeyspaces  *DescribeResponse_DescKeyspaces  `protobuf:"bytes,2,opt,name=desc_keyspaces,json=descKeyspaces" json:"desc_keyspaces,omitempty"`
-	DescCluster    *DescribeResponse_DescCluster    `protobuf:"bytes,3,opt,name=desc_cluster,json=descCluster" json:"desc_cluster,omitempty"`
-	ClientCount    uint32                           `protobuf:"varint,4,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+func (m *TopologyChangePlan) GetClusterSize() uint32 {
+	if m != nil {
+		return m.ClusterSize
+	}
+	return 0
 }
 
-func (m *DescribeResponse) Reset()                    { *m = DescribeResponse{} }
-func (m *DescribeResponse) String() string            { return proto.CompactTextString(m) }
-func (*DescribeResponse) ProtoMessage()               {}
-func (*DescribeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
-
-func (m *DescribeResponse) GetDescDataCenter() *DescribeResponse_DescDataCenter {
+func (m *TopologyChangePlan) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.DescDataCenter
+		return m.TargetClusterSize
 	}
-	return nil
+	return 0
 }
 
-func (m *DescribeResponse) GetDescKeyspaces() *DescribeResponse_DescKeyspaces {
+func (m *TopologyChangePlan) GetNewServers() []*PlannedServer {
 	if m != nil {
-		return m.DescKeyspaces
+		return m.NewServers
 	}
 	return nil
 }
 
-func (m *DescribeResponse) GetDescCluster() *DescribeResponse_DescCluster {
+func (m *TopologyChangePlan) GetShards() []*ShardBootstrapPlan {
 	if m != nil {
-		return m.DescCluster
+		return m.Shards
 	}
 	return nil
 }
 
-func (m *DescribeResponse) GetClientCount() uint32 {
+func (m *TopologyChangePlan) GetEstimatedBytes() uint64 {
 	if m != nil {
-		return m.ClientCount
+		return m.EstimatedBytes
 	}
 	return 0
 }
 
-type DescribeResponse_DescDataCenter struct {
-	DataCenter *DescribeResponse_DescDataCenter_DataCenter `protobuf:"bytes,1,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+type PlannedServer struct {
+	ServerId uint32 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Zone     string `protobuf:"bytes,3,opt,name=zone" json:"zone,omitempty"`
+	Rack     string `protobuf:"bytes,4,opt,name=rack" json:"rack,omitempty"`
 }
 
-func (m *DescribeResponse_DescDataCenter) Reset()         { *m = DescribeResponse_DescDataCenter{} }
-func (m *DescribeResponse_DescDataCenter) String() string { return proto.CompactTextString(m) }
-func (*DescribeResponse_DescDataCenter) ProtoMessage()    {}
-func (*DescribeResponse_DescDataCenter) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 0}
-}
+func (m *PlannedServer) Reset()                    { *m = PlannedServer{} }
+func (m *PlannedServer) String() string            { return proto.CompactTextString(m) }
+func (*PlannedServer) ProtoMessage()               {}
+func (*PlannedServer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
-func (m *DescribeResponse_DescDataCenter) GetDataCenter() *DescribeResponse_DescDataCenter_DataCenter {
+func (m *PlannedServer) GetServerId() uint32 {
 	if m != nil {
-		return m.DataCenter
+		return m.ServerId
 	}
-	return nil
+	return 0
 }
 
-type DescribeResponse_DescDataCenter_DataCenter struct {
-	StoreResources []*StoreResource `protobuf:"bytes,2,rep,name=store_resources,json=storeResources" json:"store_resources,omitempty"`
+func (m *PlannedServer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *DescribeResponse_DescDataCenter_DataCenter) Reset() {
-	*m = DescribeResponse_DescDataCenter_DataCenter{}
-}
-func (m *DescribeResponse_DescDataCenter_DataCenter) String() string {
-	return proto.CompactTextString(m)
-}
-func (*DescribeResponse_DescDataCenter_DataCenter) ProtoMessage() {}
-func (*DescribeResponse_DescDataCenter_DataCenter) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 0, 0}
+func (m *PlannedServer) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
 }
 
-func (m *DescribeResponse_DescDataCenter_DataCenter) GetStoreResources() []*StoreResource {
+func (m *PlannedServer) GetRack() string {
 	if m != nil {
-		return m.StoreResources
+		return m.Rack
 	}
-	return nil
+	return ""
 }
 
-type DescribeResponse_DescKeyspaces struct {
-	Keyspaces []*DescribeResponse_DescKeyspaces_Keyspace `protobuf:"bytes,1,rep,name=keyspaces" json:"keyspaces,omitempty"`
+type ShardBootstrapPlan struct {
+	ServerId         uint32           `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId          uint32           `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Address          string           `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	BootstrapSources []*ShardLocation `protobuf:"bytes,4,rep,name=bootstrap_sources,json=bootstrapSources" json:"bootstrap_sources,omitempty"`
+	// copy from the best one of the bootstrap sources, instead of from all of them
+	PickBestBootstrapSource bool             `protobuf:"varint,5,opt,name=pick_best_bootstrap_source,json=pickBestBootstrapSource" json:"pick_best_bootstrap_source,omitempty"`
+	TransitionalFollows     []*ShardLocation `protobuf:"bytes,6,rep,name=transitional_follows,json=transitionalFollows" json:"transitional_follows,omitempty"`
+	EstimatedBytes          uint64           `protobuf:"varint,7,opt,name=estimated_bytes,json=estimatedBytes" json:"estimated_bytes,omitempty"`
 }
 
-func (m *DescribeResponse_DescKeyspaces) Reset()         { *m = DescribeResponse_DescKeyspaces{} }
-func (m *DescribeResponse_DescKeyspaces) String() string { return proto.CompactTextString(m) }
-func (*DescribeResponse_DescKeyspaces) ProtoMessage()    {}
-func (*DescribeResponse_DescKeyspaces) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 1}
-}
+func (m *ShardBootstrapPlan) Reset()                    { *m = ShardBootstrapPlan{} }
+func (m *ShardBootstrapPlan) String() string            { return proto.CompactTextString(m) }
+func (*ShardBootstrapPlan) ProtoMessage()               {}
+func (*ShardBootstrapPlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
-func (m *DescribeResponse_DescKeyspaces) GetKeyspaces() []*DescribeResponse_DescKeyspaces_Keyspace {
+func (m *ShardBootstrapPlan) GetServerId() uint32 {
 	if m != nil {
-		return m.Keyspaces
+		return m.ServerId
 	}
-	return nil
+	return 0
 }
 
-type DescribeResponse_DescKeyspaces_Keyspace struct {
-	Keyspace    string     `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	Clusters    []*Cluster `protobuf:"bytes,2,rep,name=clusters" json:"clusters,omitempty"`
-	ClientCount uint32     `protobuf:"varint,3,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+func (m *ShardBootstrapPlan) GetShardId() uint32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
 }
 
-func (m *DescribeResponse_DescKeyspaces_Keyspace) Reset() {
-	*m = DescribeResponse_DescKeyspaces_Keyspace{}
+func (m *ShardBootstrapPlan) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (m *DescribeResponse_DescKeyspaces_Keyspace) String() string { return proto.CompactTextString(m) }
-func (*DescribeResponse_DescKeyspaces_Keyspace) ProtoMessage()    {}
-func (*DescribeResponse_DescKeyspaces_Keyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 1, 0}
+
+func (m *ShardBootstrapPlan) GetBootstrapSources() []*ShardLocation {
+	if m != nil {
+		return m.BootstrapSources
+	}
+	return nil
 }
 
-func (m *DescribeResponse_DescKeyspaces_Keyspace) GetKeyspace() string {
+func (m *ShardBootstrapPlan) GetPickBestBootstrapSource() bool {
 	if m != nil {
-		return m.Keyspace
+		return m.PickBestBootstrapSource
 	}
-	return ""
+	return false
 }
 
-func (m *DescribeResponse_DescKeyspaces_Keyspace) GetClusters() []*Cluster {
+func (m *ShardBootstrapPlan) GetTransitionalFollows() []*ShardLocation {
 	if m != nil {
-		return m.Clusters
+		return m.TransitionalFollows
 	}
 	return nil
 }
 
-func (m *DescribeResponse_DescKeyspaces_Keyspace) GetClientCount() uint32 {
+func (m *ShardBootstrapPlan) GetEstimatedBytes() uint64 {
 	if m != nil {
-		return m.ClientCount
+		return m.EstimatedBytes
 	}
 	return 0
 }
 
-type DescribeResponse_DescCluster struct {
-	Cluster     *Cluster `protobuf:"bytes,1,opt,name=cluster" json:"cluster,omitempty"`
-	NextCluster *Cluster `protobuf:"bytes,2,opt,name=next_cluster,json=nextCluster" json:"next_cluster,omitempty"`
-	ClientCount uint32   `protobuf:"varint,3,opt,name=client_count,json=clientCount" json:"client_count,omitempty"`
+type ShardLocation struct {
+	ServerId uint32 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId  uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Address  string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
 }
 
-func (m *DescribeResponse_DescCluster) Reset()         { *m = DescribeResponse_DescCluster{} }
-func (m *DescribeResponse_DescCluster) String() string { return proto.CompactTextString(m) }
-func (*DescribeResponse_DescCluster) ProtoMessage()    {}
-func (*DescribeResponse_DescCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34, 2}
-}
+func (m *ShardLocation) Reset()                    { *m = ShardLocation{} }
+func (m *ShardLocation) String() string            { return proto.CompactTextString(m) }
+func (*ShardLocation) ProtoMessage()               {}
+func (*ShardLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
-func (m *DescribeResponse_DescCluster) GetCluster() *Cluster {
+func (m *ShardLocation) GetServerId() uint32 {
 	if m != nil {
-		return m.Cluster
+		return m.ServerId
 	}
-	return nil
+	return 0
 }
 
-func (m *DescribeResponse_DescCluster) GetNextCluster() *Cluster {
+func (m *ShardLocation) GetShardId() uint32 {
 	if m != nil {
-		return m.NextCluster
+		return m.ShardId
 	}
-	return nil
+	return 0
 }
 
-func (m *DescribeResponse_DescCluster) GetClientCount() uint32 {
+func (m *ShardLocation) GetAddress() string {
 	if m != nil {
-		return m.ClientCount
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-type CreateClusterRequest struct {
-	Keyspace          string   `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
-	ClusterSize       uint32   `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32   `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
-	TotalDiskSizeGb   uint32   `protobuf:"varint,5,opt,name=total_disk_size_gb,json=totalDiskSizeGb" json:"total_disk_size_gb,omitempty"`
-	Tags              []string `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+// ResizeJob is the progress of resizing one cluster, which runs in the background
+type ResizeJob struct {
+	Id                string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Keyspace          string          `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter        string          `protobuf:"bytes,3,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	ClusterSize       uint32          `protobuf:"varint,4,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	TargetClusterSize uint32          `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+	State             ResizeJob_State `protobuf:"varint,6,opt,name=state,enum=pb.ResizeJob_State" json:"state,omitempty"`
+	Step              string          `protobuf:"bytes,7,opt,name=step" json:"step,omitempty"`
+	Error             string          `protobuf:"bytes,8,opt,name=error" json:"error,omitempty"`
+	StartedAtNs       int64           `protobuf:"varint,9,opt,name=started_at_ns,json=startedAtNs" json:"started_at_ns,omitempty"`
+	FinishedAtNs      int64           `protobuf:"varint,10,opt,name=finished_at_ns,json=finishedAtNs" json:"finished_at_ns,omitempty"`
+	// the shards created for the new cluster size
+	Shards       []*ResizeShardProgress `protobuf:"bytes,11,rep,name=shards" json:"shards,omitempty"`
+	IsCancelling bool                   `protobuf:"varint,12,opt,name=is_cancelling,json=isCancelling" json:"is_cancelling,omitempty"`
 }
 
-func (m *CreateClusterRequest) Reset()                    { *m = CreateClusterRequest{} }
-func (m *CreateClusterRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateClusterRequest) ProtoMessage()               {}
-func (*CreateClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (m *ResizeJob) Reset()                    { *m = ResizeJob{} }
+func (m *ResizeJob) String() string            { return proto.CompactTextString(m) }
+func (*ResizeJob) ProtoMessage()               {}
+func (*ResizeJob) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
-func (m *CreateClusterRequest) GetKeyspace() string {
+func (m *ResizeJob) GetId() string {
 	if m != nil {
-		return m.Keyspace
+		return m.Id
 	}
 	return ""
 }
 
-func (m *CreateClusterRequest) GetClusterSize() uint32 {
+func (m *ResizeJob) GetKeyspace() string {
 	if m != nil {
-		return m.ClusterSize
+		return m.Keyspace
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateClusterRequest) GetReplicationFactor() uint32 {
+func (m *ResizeJob) GetDataCenter() string {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.DataCenter
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateClusterRequest) GetTotalDiskSizeGb() uint32 {
+func (m *ResizeJob) GetClusterSize() uint32 {
 	if m != nil {
-		return m.TotalDiskSizeGb
+		return m.ClusterSize
 	}
 	return 0
 }
 
-func (m *CreateClusterRequest) GetTags() []string {
-	if m != nil {
-		return m.Tags
-	}
-	return nil
-}
-
-type CreateClusterResponse struct {
-	Error   string   `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	Cluster *Cluster `protobuf:"bytes,2,opt,name=cluster" json:"cluster,omitempty"`
-}
-
-func (m *CreateClusterResponse) Reset()                    { *m = CreateClusterResponse{} }
-func (m *CreateClusterResponse) String() string            { return proto.CompactTextString(m) }
-func (*CreateClusterResponse) ProtoMessage()               {}
-func (*CreateClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
-
-func (m *CreateClusterResponse) GetError() string {
+func (m *ResizeJob) GetTargetClusterSize() uint32 {
 	if m != nil {
-		return m.Error
+		return m.TargetClusterSize
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateClusterResponse) GetCluster() *Cluster {
+func (m *ResizeJob) GetState() ResizeJob_State {
 	if m != nil {
-		return m.Cluster
+		return m.State
 	}
-	return nil
-}
-
-type DeleteClusterRequest struct {
-	Keyspace string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+	return ResizeJob_RUNNING
 }
 
-func (m *DeleteClusterRequest) Reset()                    { *m = DeleteClusterRequest{} }
-func (m *DeleteClusterRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteClusterRequest) ProtoMessage()               {}
-func (*DeleteClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
-
-func (m *DeleteClusterRequest) GetKeyspace() string {
+func (m *ResizeJob) GetStep() string {
 	if m != nil {
-		return m.Keyspace
+		return m.Step
 	}
 	return ""
 }
 
-type DeleteClusterResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-}
-
-func (m *DeleteClusterResponse) Reset()                    { *m = DeleteClusterResponse{} }
-func (m *DeleteClusterResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteClusterResponse) ProtoMessage()               {}
-func (*DeleteClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
-
-func (m *DeleteClusterResponse) GetError() string {
+func (m *ResizeJob) GetError() string {
 	if m != nil {
 		return m.Error
 	}
 	return ""
 }
 
-type CompactClusterRequest struct {
-	Keyspace string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
+func (m *ResizeJob) GetStartedAtNs() int64 {
+	if m != nil {
+		return m.StartedAtNs
+	}
+	return 0
 }
 
-func (m *CompactClusterRequest) Reset()                    { *m = CompactClusterRequest{} }
-func (m *CompactClusterRequest) String() string            { return proto.CompactTextString(m) }
-func (*CompactClusterRequest) ProtoMessage()               {}
-func (*CompactClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
-
-func (m *CompactClusterRequest) GetKeyspace() string {
+func (m *ResizeJob) GetFinishedAtNs() int64 {
 	if m != nil {
-		return m.Keyspace
+		return m.FinishedAtNs
 	}
-	return ""
+	return 0
 }
 
-type CompactClusterResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *ResizeJob) GetShards() []*ResizeShardProgress {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
 }
 
-func (m *CompactClusterResponse) Reset()                    { *m = CompactClusterResponse{} }
-func (m *CompactClusterResponse) String() string            { return proto.CompactTextString(m) }
-func (*CompactClusterResponse) ProtoMessage()               {}
-func (*CompactClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
-
-func (m *CompactClusterResponse) GetError() string {
+func (m *ResizeJob) GetIsCancelling() bool {
 	if m != nil {
-		return m.Error
+		return m.IsCancelling
 	}
-	return ""
+	return false
 }
 
-type ReplaceNodeRequest struct {
-	Keyspace   string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
-	NodeId     uint32 `protobuf:"varint,3,opt,name=node_id,json=nodeId" json:"node_id,omitempty"`
-	NewAddress string `protobuf:"bytes,4,opt,name=new_address,json=newAddress" json:"new_address,omitempty"`
+type ResizeShardProgress struct {
+	ServerId             uint32 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ShardId              uint32 `protobuf:"varint,2,opt,name=shard_id,json=shardId" json:"shard_id,omitempty"`
+	Address              string `protobuf:"bytes,3,opt,name=address" json:"address,omitempty"`
+	IsReady              bool   `protobuf:"varint,4,opt,name=is_ready,json=isReady" json:"is_ready,omitempty"`
+	BootstrapBytes       uint64 `protobuf:"varint,5,opt,name=bootstrap_bytes,json=bootstrapBytes" json:"bootstrap_bytes,omitempty"`
+	FollowLagMillisecond int64  `protobuf:"varint,6,opt,name=follow_lag_millisecond,json=followLagMillisecond" json:"follow_lag_millisecond,omitempty"`
 }
 
-func (m *ReplaceNodeRequest) Reset()                    { *m = ReplaceNodeRequest{} }
-func (m *ReplaceNodeRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReplaceNodeRequest) ProtoMessage()               {}
-func (*ReplaceNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (m *ResizeShardProgress) Reset()                    { *m = ResizeShardProgress{} }
+func (m *ResizeShardProgress) String() string            { return proto.CompactTextString(m) }
+func (*ResizeShardProgress) ProtoMessage()               {}
+func (*ResizeShardProgress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
-func (m *ReplaceNodeRequest) GetKeyspace() string {
+func (m *ResizeShardProgress) GetServerId() uint32 {
 	if m != nil {
-		return m.Keyspace
+		return m.ServerId
 	}
-	return ""
+	return 0
 }
 
-func (m *ReplaceNodeRequest) GetNodeId() uint32 {
+func (m *ResizeShardProgress) GetShardId() uint32 {
 	if m != nil {
-		return m.NodeId
+		return m.ShardId
 	}
 	return 0
 }
 
-func (m *ReplaceNodeRequest) GetNewAddress() string {
+func (m *ResizeShardProgress) GetAddress() string {
 	if m != nil {
-		return m.NewAddress
+		return m.Address
 	}
 	return ""
 }
 
-type ReplaceNodeResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *ResizeShardProgress) GetIsReady() bool {
+	if m != nil {
+		return m.IsReady
+	}
+	return false
 }
 
-func (m *ReplaceNodeResponse) Reset()                    { *m = ReplaceNodeResponse{} }
-func (m *ReplaceNodeResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReplaceNodeResponse) ProtoMessage()               {}
-func (*ReplaceNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (m *ResizeShardProgress) GetBootstrapBytes() uint64 {
+	if m != nil {
+		return m.BootstrapBytes
+	}
+	return 0
+}
 
-func (m *ReplaceNodeResponse) GetError() string {
+func (m *ResizeShardProgress) GetFollowLagMillisecond() int64 {
 	if m != nil {
-		return m.Error
+		return m.FollowLagMillisecond
 	}
-	return ""
+	return 0
 }
 
-// //////  request response with store
-type CreateShardRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ServerId          uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
-	ShardDiskSizeGb   uint32 `protobuf:"varint,5,opt,name=shard_disk_size_gb,json=shardDiskSizeGb" json:"shard_disk_size_gb,omitempty"`
+type ResizeStatusRequest struct {
+	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter string `protobuf:"bytes,2,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
 }
 
-func (m *CreateShardRequest) Reset()                    { *m = CreateShardRequest{} }
-func (m *CreateShardRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateShardRequest) ProtoMessage()               {}
-func (*CreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (m *ResizeStatusRequest) Reset()                    { *m = ResizeStatusRequest{} }
+func (m *ResizeStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResizeStatusRequest) ProtoMessage()               {}
+func (*ResizeStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
-func (m *CreateShardRequest) GetKeyspace() string {
+func (m *ResizeStatusRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *CreateShardRequest) GetServerId() uint32 {
+func (m *ResizeStatusRequest) GetDataCenter() string {
 	if m != nil {
-		return m.ServerId
+		return m.DataCenter
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateShardRequest) GetClusterSize() uint32 {
-	if m != nil {
-		return m.ClusterSize
-	}
-	return 0
+type ResizeStatusResponse struct {
+	Job   *ResizeJob `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	Error string     `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *CreateShardRequest) GetReplicationFactor() uint32 {
+func (m *ResizeStatusResponse) Reset()                    { *m = ResizeStatusResponse{} }
+func (m *ResizeStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResizeStatusResponse) ProtoMessage()               {}
+func (*ResizeStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *ResizeStatusResponse) GetJob() *ResizeJob {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.Job
 	}
-	return 0
+	return nil
 }
 
-func (m *CreateShardRequest) GetShardDiskSizeGb() uint32 {
+func (m *ResizeStatusResponse) GetError() string {
 	if m != nil {
-		return m.ShardDiskSizeGb
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type CreateShardResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+// CancelResizeRequest stops the resize before the new cluster size is committed,
+// deletes the new shards, and keeps the current cluster size.
+type CancelResizeRequest struct {
+	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter string `protobuf:"bytes,2,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
 }
 
-func (m *CreateShardResponse) Reset()                    { *m = CreateShardResponse{} }
-func (m *CreateShardResponse) String() string            { return proto.CompactTextString(m) }
-func (*CreateShardResponse) ProtoMessage()               {}
-func (*CreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (m *CancelResizeRequest) Reset()                    { *m = CancelResizeRequest{} }
+func (m *CancelResizeRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelResizeRequest) ProtoMessage()               {}
+func (*CancelResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
 
-func (m *CreateShardResponse) GetError() string {
+func (m *CancelResizeRequest) GetKeyspace() string {
 	if m != nil {
-		return m.Error
+		return m.Keyspace
 	}
 	return ""
 }
 
-type DeleteKeyspaceRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-}
-
-func (m *DeleteKeyspaceRequest) Reset()                    { *m = DeleteKeyspaceRequest{} }
-func (m *DeleteKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteKeyspaceRequest) ProtoMessage()               {}
-func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
-
-func (m *DeleteKeyspaceRequest) GetKeyspace() string {
+func (m *CancelResizeRequest) GetDataCenter() string {
 	if m != nil {
-		return m.Keyspace
+		return m.DataCenter
 	}
 	return ""
 }
 
-type DeleteKeyspaceResponse struct {
+type CancelResizeResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *DeleteKeyspaceResponse) Reset()                    { *m = DeleteKeyspaceResponse{} }
-func (m *DeleteKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
-func (*DeleteKeyspaceResponse) ProtoMessage()               {}
-func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (m *CancelResizeResponse) Reset()                    { *m = CancelResizeResponse{} }
+func (m *CancelResizeResponse) String() string            { return proto.CompactTextString(m) }
+func (*CancelResizeResponse) ProtoMessage()               {}
+func (*CancelResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
-func (m *DeleteKeyspaceResponse) GetError() string {
+func (m *CancelResizeResponse) GetError() string {
 	if m != nil {
 		return m.Error
 	}
 	return ""
 }
 
-type CompactKeyspaceRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+type ChangeReplicationFactorRequest struct {
+	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter        string `protobuf:"bytes,2,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	ReplicationFactor uint32 `protobuf:"varint,3,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
 }
 
-func (m *CompactKeyspaceRequest) Reset()                    { *m = CompactKeyspaceRequest{} }
-func (m *CompactKeyspaceRequest) String() string            { return proto.CompactTextString(m) }
-func (*CompactKeyspaceRequest) ProtoMessage()               {}
-func (*CompactKeyspaceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (m *ChangeReplicationFactorRequest) Reset()         { *m = ChangeReplicationFactorRequest{} }
+func (m *ChangeReplicationFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeReplicationFactorRequest) ProtoMessage()    {}
+func (*ChangeReplicationFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{109}
+}
 
-func (m *CompactKeyspaceRequest) GetKeyspace() string {
+func (m *ChangeReplicationFactorRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-type CompactKeyspaceResponse struct {
+func (m *ChangeReplicationFactorRequest) GetDataCenter() string {
+	if m != nil {
+		return m.DataCenter
+	}
+	return ""
+}
+
+func (m *ChangeReplicationFactorRequest) GetReplicationFactor() uint32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+type ChangeReplicationFactorResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *CompactKeyspaceResponse) Reset()                    { *m = CompactKeyspaceResponse{} }
-func (m *CompactKeyspaceResponse) String() string            { return proto.CompactTextString(m) }
-func (*CompactKeyspaceResponse) ProtoMessage()               {}
-func (*CompactKeyspaceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (m *ChangeReplicationFactorResponse) Reset()         { *m = ChangeReplicationFactorResponse{} }
+func (m *ChangeReplicationFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeReplicationFactorResponse) ProtoMessage()    {}
+func (*ChangeReplicationFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{110}
+}
 
-func (m *CompactKeyspaceResponse) GetError() string {
+func (m *ChangeReplicationFactorResponse) GetError() string {
 	if m != nil {
 		return m.Error
 	}
 	return ""
 }
 
-type ReplicateNodePrepareRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ServerId          uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+type ChangeReplicationFactorPrepareRequest struct {
+	Keyspace                string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	ServerId                uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ClusterSize             uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
+	ReplicationFactor       uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
+	TargetReplicationFactor uint32 `protobuf:"varint,5,opt,name=target_replication_factor,json=targetReplicationFactor" json:"target_replication_factor,omitempty"`
 }
 
-func (m *ReplicateNodePrepareRequest) Reset()                    { *m = ReplicateNodePrepareRequest{} }
-func (m *ReplicateNodePrepareRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReplicateNodePrepareRequest) ProtoMessage()               {}
-func (*ReplicateNodePrepareRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (m *ChangeReplicationFactorPrepareRequest) Reset()         { *m = ChangeReplicationFactorPrepareRequest{} }
+func (m *ChangeReplicationFactorPrepareRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeReplicationFactorPrepareRequest) ProtoMessage()    {}
+func (*ChangeReplicationFactorPrepareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{111}
+}
 
-func (m *ReplicateNodePrepareRequest) GetKeyspace() string {
+func (m *ChangeReplicationFactorPrepareRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *ReplicateNodePrepareRequest) GetServerId() uint32 {
+func (m *ChangeReplicationFactorPrepareRequest) GetServerId() uint32 {
 	if m != nil {
 		return m.ServerId
 	}
 	return 0
 }
 
-func (m *ReplicateNodePrepareRequest) GetClusterSize() uint32 {
+func (m *ChangeReplicationFactorPrepareRequest) GetClusterSize() uint32 {
 	if m != nil {
 		return m.ClusterSize
 	}
 	return 0
 }
 
-func (m *ReplicateNodePrepareRequest) GetReplicationFactor() uint32 {
+func (m *ChangeReplicationFactorPrepareRequest) GetReplicationFactor() uint32 {
 	if m != nil {
 		return m.ReplicationFactor
 	}
 	return 0
 }
 
-type ReplicateNodePrepareResponse struct {
+func (m *ChangeReplicationFactorPrepareRequest) GetTargetReplicationFactor() uint32 {
+	if m != nil {
+		return m.TargetReplicationFactor
+	}
+	return 0
+}
+
+type ChangeReplicationFactorPrepareResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *ReplicateNodePrepareResponse) Reset()                    { *m = ReplicateNodePrepareResponse{} }
-func (m *ReplicateNodePrepareResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReplicateNodePrepareResponse) ProtoMessage()               {}
-func (*ReplicateNodePrepareResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (m *ChangeReplicationFactorPrepareResponse) Reset() {
+	*m = ChangeReplicationFactorPrepareResponse{}
+}
+func (m *ChangeReplicationFactorPrepareResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeReplicationFactorPrepareResponse) ProtoMessage()    {}
+func (*ChangeReplicationFactorPrepareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{112}
+}
 
-func (m *ReplicateNodePrepareResponse) GetError() string {
+func (m *ChangeReplicationFactorPrepareResponse) GetError() string {
 	if m != nil {
 		return m.Error
 	}
 	return ""
 }
 
-type ReplicateNodeCommitRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+type ChangeReplicationFactorCommitRequest struct {
+	Keyspace                string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	TargetReplicationFactor uint32 `protobuf:"varint,2,opt,name=target_replication_factor,json=targetReplicationFactor" json:"target_replication_factor,omitempty"`
 }
 
-func (m *ReplicateNodeCommitRequest) Reset()                    { *m = ReplicateNodeCommitRequest{} }
-func (m *ReplicateNodeCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReplicateNodeCommitRequest) ProtoMessage()               {}
-func (*ReplicateNodeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (m *ChangeReplicationFactorCommitRequest) Reset()         { *m = ChangeReplicationFactorCommitRequest{} }
+func (m *ChangeReplicationFactorCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeReplicationFactorCommitRequest) ProtoMessage()    {}
+func (*ChangeReplicationFactorCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{113}
+}
 
-func (m *ReplicateNodeCommitRequest) GetKeyspace() string {
+func (m *ChangeReplicationFactorCommitRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-type ReplicateNodeCommitResponse struct {
+func (m *ChangeReplicationFactorCommitRequest) GetTargetReplicationFactor() uint32 {
+	if m != nil {
+		return m.TargetReplicationFactor
+	}
+	return 0
+}
+
+type ChangeReplicationFactorCommitResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *ReplicateNodeCommitResponse) Reset()                    { *m = ReplicateNodeCommitResponse{} }
-func (m *ReplicateNodeCommitResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReplicateNodeCommitResponse) ProtoMessage()               {}
-func (*ReplicateNodeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (m *ChangeReplicationFactorCommitResponse) Reset()         { *m = ChangeReplicationFactorCommitResponse{} }
+func (m *ChangeReplicationFactorCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeReplicationFactorCommitResponse) ProtoMessage()    {}
+func (*ChangeReplicationFactorCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{114}
+}
 
-func (m *ReplicateNodeCommitResponse) GetError() string {
+func (m *ChangeReplicationFactorCommitResponse) GetError() string {
 	if m != nil {
 		return m.Error
 	}
 	return ""
 }
 
-type ReplicateNodeCleanupRequest struct {
-	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+// AdminJob is one admin request run by the master.
+// The job keeps running if the request times out.
+type AdminJob struct {
+	Id           string          `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Kind         string          `protobuf:"bytes,2,opt,name=kind" json:"kind,omitempty"`
+	Keyspace     string          `protobuf:"bytes,3,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter   string          `protobuf:"bytes,4,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	Request      string          `protobuf:"bytes,5,opt,name=request" json:"request,omitempty"`
+	State        AdminJob_State  `protobuf:"varint,6,opt,name=state,enum=pb.AdminJob_State" json:"state,omitempty"`
+	Error        string          `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+	StartedAtNs  int64           `protobuf:"varint,8,opt,name=started_at_ns,json=startedAtNs" json:"started_at_ns,omitempty"`
+	FinishedAtNs int64           `protobuf:"varint,9,opt,name=finished_at_ns,json=finishedAtNs" json:"finished_at_ns,omitempty"`
+	Steps        []*AdminJobStep `protobuf:"bytes,10,rep,name=steps" json:"steps,omitempty"`
+	IsCancelling bool            `protobuf:"varint,11,opt,name=is_cancelling,json=isCancelling" json:"is_cancelling,omitempty"`
 }
 
-func (m *ReplicateNodeCleanupRequest) Reset()                    { *m = ReplicateNodeCleanupRequest{} }
-func (m *ReplicateNodeCleanupRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReplicateNodeCleanupRequest) ProtoMessage()               {}
-func (*ReplicateNodeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (m *AdminJob) Reset()                    { *m = AdminJob{} }
+func (m *AdminJob) String() string            { return proto.CompactTextString(m) }
+func (*AdminJob) ProtoMessage()               {}
+func (*AdminJob) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
 
-func (m *ReplicateNodeCleanupRequest) GetKeyspace() string {
+func (m *AdminJob) GetId() string {
 	if m != nil {
-		return m.Keyspace
+		return m.Id
 	}
 	return ""
 }
 
-type ReplicateNodeCleanupResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+func (m *AdminJob) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
 
-func (m *ReplicateNodeCleanupResponse) Reset()                    { *m = ReplicateNodeCleanupResponse{} }
-func (m *ReplicateNodeCleanupResponse) String() string            { return proto.CompactTextString(m) }
-func (*ReplicateNodeCleanupResponse) ProtoMessage()               {}
-func (*ReplicateNodeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (m *AdminJob) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
 
-func (m *ReplicateNodeCleanupResponse) GetError() string {
+func (m *AdminJob) GetDataCenter() string {
 	if m != nil {
-		return m.Error
+		return m.DataCenter
 	}
 	return ""
 }
 
-type ResizeCreateShardRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	ServerId          uint32 `protobuf:"varint,2,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ClusterSize       uint32 `protobuf:"varint,3,opt,name=cluster_size,json=clusterSize" json:"cluster_size,omitempty"`
-	ReplicationFactor uint32 `protobuf:"varint,4,opt,name=replication_factor,json=replicationFactor" json:"replication_factor,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,5,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+func (m *AdminJob) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
 }
 
-func (m *ResizeCreateShardRequest) Reset()                    { *m = ResizeCreateShardRequest{} }
-func (m *ResizeCreateShardRequest) String() string            { return proto.CompactTextString(m) }
-func (*ResizeCreateShardRequest) ProtoMessage()               {}
-func (*ResizeCreateShardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (m *AdminJob) GetState() AdminJob_State {
+	if m != nil {
+		return m.State
+	}
+	return AdminJob_RUNNING
+}
 
-func (m *ResizeCreateShardRequest) GetKeyspace() string {
+func (m *AdminJob) GetError() string {
 	if m != nil {
-		return m.Keyspace
+		return m.Error
 	}
 	return ""
 }
 
-func (m *ResizeCreateShardRequest) GetServerId() uint32 {
+func (m *AdminJob) GetStartedAtNs() int64 {
 	if m != nil {
-		return m.ServerId
+		return m.StartedAtNs
 	}
 	return 0
 }
 
-func (m *ResizeCreateShardRequest) GetClusterSize() uint32 {
+func (m *AdminJob) GetFinishedAtNs() int64 {
 	if m != nil {
-		return m.ClusterSize
+		return m.FinishedAtNs
 	}
 	return 0
 }
 
-func (m *ResizeCreateShardRequest) GetReplicationFactor() uint32 {
+func (m *AdminJob) GetSteps() []*AdminJobStep {
 	if m != nil {
-		return m.ReplicationFactor
+		return m.Steps
 	}
-	return 0
+	return nil
 }
 
-func (m *ResizeCreateShardRequest) GetTargetClusterSize() uint32 {
+func (m *AdminJob) GetIsCancelling() bool {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.IsCancelling
 	}
-	return 0
+	return false
 }
 
-type ResizeCreateShardResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+type AdminJobStep struct {
+	AtNs    int64  `protobuf:"varint,1,opt,name=at_ns,json=atNs" json:"at_ns,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
 }
 
-func (m *ResizeCreateShardResponse) Reset()                    { *m = ResizeCreateShardResponse{} }
-func (m *ResizeCreateShardResponse) String() string            { return proto.CompactTextString(m) }
-func (*ResizeCreateShardResponse) ProtoMessage()               {}
-func (*ResizeCreateShardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (m *AdminJobStep) Reset()                    { *m = AdminJobStep{} }
+func (m *AdminJobStep) String() string            { return proto.CompactTextString(m) }
+func (*AdminJobStep) ProtoMessage()               {}
+func (*AdminJobStep) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
 
-func (m *ResizeCreateShardResponse) GetError() string {
+func (m *AdminJobStep) GetAtNs() int64 {
 	if m != nil {
-		return m.Error
+		return m.AtNs
+	}
+	return 0
+}
+
+func (m *AdminJobStep) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
 	return ""
 }
 
-type ResizeCommitRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,2,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+// ListJobsRequest lists the latest jobs first, filtered by the keyspace and data center if not empty
+type ListJobsRequest struct {
+	Keyspace   string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	DataCenter string `protobuf:"bytes,2,opt,name=data_center,json=dataCenter" json:"data_center,omitempty"`
+	Limit      uint32 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
 }
 
-func (m *ResizeCommitRequest) Reset()                    { *m = ResizeCommitRequest{} }
-func (m *ResizeCommitRequest) String() string            { return proto.CompactTextString(m) }
-func (*ResizeCommitRequest) ProtoMessage()               {}
-func (*ResizeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (m *ListJobsRequest) Reset()                    { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()               {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
 
-func (m *ResizeCommitRequest) GetKeyspace() string {
+func (m *ListJobsRequest) GetKeyspace() string {
 	if m != nil {
 		return m.Keyspace
 	}
 	return ""
 }
 
-func (m *ResizeCommitRequest) GetTargetClusterSize() uint32 {
+func (m *ListJobsRequest) GetDataCenter() string {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.DataCenter
 	}
-	return 0
-}
-
-type ResizeCommitResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	return ""
 }
 
-func (m *ResizeCommitResponse) Reset()                    { *m = ResizeCommitResponse{} }
-func (m *ResizeCommitResponse) String() string            { return proto.CompactTextString(m) }
-func (*ResizeCommitResponse) ProtoMessage()               {}
-func (*ResizeCommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
-
-func (m *ResizeCommitResponse) GetError() string {
+func (m *ListJobsRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.Error
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-type ResizeCleanupRequest struct {
-	Keyspace          string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,2,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+type ListJobsResponse struct {
+	Jobs  []*AdminJob `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty"`
+	Error string      `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *ResizeCleanupRequest) Reset()                    { *m = ResizeCleanupRequest{} }
-func (m *ResizeCleanupRequest) String() string            { return proto.CompactTextString(m) }
-func (*ResizeCleanupRequest) ProtoMessage()               {}
-func (*ResizeCleanupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (m *ListJobsResponse) Reset()                    { *m = ListJobsResponse{} }
+func (m *ListJobsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListJobsResponse) ProtoMessage()               {}
+func (*ListJobsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
 
-func (m *ResizeCleanupRequest) GetKeyspace() string {
+func (m *ListJobsResponse) GetJobs() []*AdminJob {
 	if m != nil {
-		return m.Keyspace
+		return m.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (m *ResizeCleanupRequest) GetTargetClusterSize() uint32 {
+func (m *ListJobsResponse) GetError() string {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-type ResizeCleanupResponse struct {
-	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+type GetJobRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *ResizeCleanupResponse) Reset()                    { *m = ResizeCleanupResponse{} }
-func (m *ResizeCleanupResponse) String() string            { return proto.CompactTextString(m) }
-func (*ResizeCleanupResponse) ProtoMessage()               {}
-func (*ResizeCleanupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (m *GetJobRequest) Reset()                    { *m = GetJobRequest{} }
+func (m *GetJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetJobRequest) ProtoMessage()               {}
+func (*GetJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
 
-func (m *ResizeCleanupResponse) GetError() string {
+func (m *GetJobRequest) GetId() string {
 	if m != nil {
-		return m.Error
+		return m.Id
 	}
 	return ""
 }
 
-type ResizeRequest struct {
-	Keyspace          string `protobuf:"bytes,2,opt,name=keyspace" json:"keyspace,omitempty"`
-	TargetClusterSize uint32 `protobuf:"varint,3,opt,name=target_cluster_size,json=targetClusterSize" json:"target_cluster_size,omitempty"`
+type GetJobResponse struct {
+	Job   *AdminJob `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	Error string    `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *ResizeRequest) Reset()                    { *m = ResizeRequest{} }
-func (m *ResizeRequest) String() string            { return proto.CompactTextString(m) }
-func (*ResizeRequest) ProtoMessage()               {}
-func (*ResizeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (m *GetJobResponse) Reset()                    { *m = GetJobResponse{} }
+func (m *GetJobResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetJobResponse) ProtoMessage()               {}
+func (*GetJobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
 
-func (m *ResizeRequest) GetKeyspace() string {
+func (m *GetJobResponse) GetJob() *AdminJob {
 	if m != nil {
-		return m.Keyspace
+		return m.Job
+	}
+	return nil
+}
+
+func (m *GetJobResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
 
-func (m *ResizeRequest) GetTargetClusterSize() uint32 {
+type CancelJobRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CancelJobRequest) Reset()                    { *m = CancelJobRequest{} }
+func (m *CancelJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelJobRequest) ProtoMessage()               {}
+func (*CancelJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+func (m *CancelJobRequest) GetId() string {
 	if m != nil {
-		return m.TargetClusterSize
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-type ResizeResponse struct {
+type CancelJobResponse struct {
 	Error string `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
 }
 
-func (m *ResizeResponse) Reset()                    { *m = ResizeResponse{} }
-func (m *ResizeResponse) String() string            { return proto.CompactTextString(m) }
-func (*ResizeResponse) ProtoMessage()               {}
-func (*ResizeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (m *CancelJobResponse) Reset()                    { *m = CancelJobResponse{} }
+func (m *CancelJobResponse) String() string            { return proto.CompactTextString(m) }
+func (*CancelJobResponse) ProtoMessage()               {}
+func (*CancelJobResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
 
-func (m *ResizeResponse) GetError() string {
+func (m *CancelJobResponse) GetError() string {
 	if m != nil {
 		return m.Error
 	}
@@ -2318,17 +5553,27 @@ func (m *ResizeResponse) GetError() string {
 
 func init() {
 	proto.RegisterType((*BalanceRequest)(nil), "pb.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "pb.BalanceResponse")
+	proto.RegisterType((*ShardMove)(nil), "pb.ShardMove")
 	proto.RegisterType((*StoreHeartbeat)(nil), "pb.StoreHeartbeat")
 	proto.RegisterType((*StoreMessage)(nil), "pb.StoreMessage")
+	proto.RegisterType((*ShardDiskUsage)(nil), "pb.ShardDiskUsage")
+	proto.RegisterType((*BootstrapProgress)(nil), "pb.BootstrapProgress")
+	proto.RegisterType((*FollowLag)(nil), "pb.FollowLag")
+	proto.RegisterType((*ReplicationLag)(nil), "pb.ReplicationLag")
 	proto.RegisterType((*ClientHeartbeat)(nil), "pb.ClientHeartbeat")
 	proto.RegisterType((*ClientHeartbeat_ClusterFollowMessage)(nil), "pb.ClientHeartbeat.ClusterFollowMessage")
 	proto.RegisterType((*ClientMessage)(nil), "pb.ClientMessage")
 	proto.RegisterType((*ClientMessage_StoreResourceUpdate)(nil), "pb.ClientMessage.StoreResourceUpdate")
 	proto.RegisterType((*ClientMessage_Resize)(nil), "pb.ClientMessage.Resize")
+	proto.RegisterType((*ClientMessage_ReplicationFactorChange)(nil), "pb.ClientMessage.ReplicationFactorChange")
 	proto.RegisterType((*Cluster)(nil), "pb.Cluster")
 	proto.RegisterType((*ClusterNode)(nil), "pb.ClusterNode")
 	proto.RegisterType((*StoreResource)(nil), "pb.StoreResource")
 	proto.RegisterType((*LocalShardsInCluster)(nil), "pb.LocalShardsInCluster")
+	proto.RegisterType((*KeyspaceSettings)(nil), "pb.KeyspaceSettings")
+	proto.RegisterType((*KeyspaceIndexes)(nil), "pb.KeyspaceIndexes")
+	proto.RegisterType((*IndexDefinition)(nil), "pb.IndexDefinition")
 	proto.RegisterType((*ShardInfo)(nil), "pb.ShardInfo")
 	proto.RegisterType((*Empty)(nil), "pb.Empty")
 	proto.RegisterType((*KeyTypeValue)(nil), "pb.KeyTypeValue")
@@ -2342,8 +5587,26 @@ func init() {
 	proto.RegisterType((*GetRequest)(nil), "pb.GetRequest")
 	proto.RegisterType((*GetResponse)(nil), "pb.GetResponse")
 	proto.RegisterType((*GetByPrefixRequest)(nil), "pb.GetByPrefixRequest")
+	proto.RegisterType((*ScanFilter)(nil), "pb.ScanFilter")
+	proto.RegisterType((*ScanFilter_Float64Range)(nil), "pb.ScanFilter.Float64Range")
 	proto.RegisterType((*GetByPrefixResponse)(nil), "pb.GetByPrefixResponse")
+	proto.RegisterType((*ScanRequest)(nil), "pb.ScanRequest")
+	proto.RegisterType((*ScanResponse)(nil), "pb.ScanResponse")
+	proto.RegisterType((*AggregateRequest)(nil), "pb.AggregateRequest")
+	proto.RegisterType((*AggregateResponse)(nil), "pb.AggregateResponse")
+	proto.RegisterType((*IndexLookupRequest)(nil), "pb.IndexLookupRequest")
+	proto.RegisterType((*IndexLookupResponse)(nil), "pb.IndexLookupResponse")
+	proto.RegisterType((*SortedSetRequest)(nil), "pb.SortedSetRequest")
+	proto.RegisterType((*SortedSetMember)(nil), "pb.SortedSetMember")
+	proto.RegisterType((*SortedSetResponse)(nil), "pb.SortedSetResponse")
+	proto.RegisterType((*TimeSeriesRequest)(nil), "pb.TimeSeriesRequest")
+	proto.RegisterType((*TimeSeriesPoint)(nil), "pb.TimeSeriesPoint")
+	proto.RegisterType((*TimeSeriesBucket)(nil), "pb.TimeSeriesBucket")
+	proto.RegisterType((*TimeSeriesResponse)(nil), "pb.TimeSeriesResponse")
+	proto.RegisterType((*TtlRequest)(nil), "pb.TtlRequest")
+	proto.RegisterType((*TtlResponse)(nil), "pb.TtlResponse")
 	proto.RegisterType((*Response)(nil), "pb.Response")
+	proto.RegisterType((*ChunkManifest)(nil), "pb.ChunkManifest")
 	proto.RegisterType((*RawKeyValue)(nil), "pb.RawKeyValue")
 	proto.RegisterType((*LogEntry)(nil), "pb.LogEntry")
 	proto.RegisterType((*CopyDoneMessge)(nil), "pb.CopyDoneMessge")
@@ -2354,6 +5617,8 @@ func init() {
 	proto.RegisterType((*PullUpdateResponse)(nil), "pb.PullUpdateResponse")
 	proto.RegisterType((*CheckBinlogRequest)(nil), "pb.CheckBinlogRequest")
 	proto.RegisterType((*CheckBinlogResponse)(nil), "pb.CheckBinlogResponse")
+	proto.RegisterType((*SubscribeExpiryRequest)(nil), "pb.SubscribeExpiryRequest")
+	proto.RegisterType((*ExpiryEvent)(nil), "pb.ExpiryEvent")
 	proto.RegisterType((*DescribeRequest)(nil), "pb.DescribeRequest")
 	proto.RegisterType((*DescribeRequest_DescDataCenters)(nil), "pb.DescribeRequest.DescDataCenters")
 	proto.RegisterType((*DescribeRequest_DescKeyspaces)(nil), "pb.DescribeRequest.DescKeyspaces")
@@ -2371,8 +5636,16 @@ func init() {
 	proto.RegisterType((*DeleteClusterResponse)(nil), "pb.DeleteClusterResponse")
 	proto.RegisterType((*CompactClusterRequest)(nil), "pb.CompactClusterRequest")
 	proto.RegisterType((*CompactClusterResponse)(nil), "pb.CompactClusterResponse")
+	proto.RegisterType((*DefineIndexRequest)(nil), "pb.DefineIndexRequest")
+	proto.RegisterType((*DefineIndexResponse)(nil), "pb.DefineIndexResponse")
+	proto.RegisterType((*UpdateKeyspaceRequest)(nil), "pb.UpdateKeyspaceRequest")
+	proto.RegisterType((*UpdateKeyspaceResponse)(nil), "pb.UpdateKeyspaceResponse")
 	proto.RegisterType((*ReplaceNodeRequest)(nil), "pb.ReplaceNodeRequest")
 	proto.RegisterType((*ReplaceNodeResponse)(nil), "pb.ReplaceNodeResponse")
+	proto.RegisterType((*DrainStoreRequest)(nil), "pb.DrainStoreRequest")
+	proto.RegisterType((*DrainStoreResponse)(nil), "pb.DrainStoreResponse")
+	proto.RegisterType((*SetBootstrapThrottleRequest)(nil), "pb.SetBootstrapThrottleRequest")
+	proto.RegisterType((*SetBootstrapThrottleResponse)(nil), "pb.SetBootstrapThrottleResponse")
 	proto.RegisterType((*CreateShardRequest)(nil), "pb.CreateShardRequest")
 	proto.RegisterType((*CreateShardResponse)(nil), "pb.CreateShardResponse")
 	proto.RegisterType((*DeleteKeyspaceRequest)(nil), "pb.DeleteKeyspaceRequest")
@@ -2393,8 +5666,37 @@ func init() {
 	proto.RegisterType((*ResizeCleanupResponse)(nil), "pb.ResizeCleanupResponse")
 	proto.RegisterType((*ResizeRequest)(nil), "pb.ResizeRequest")
 	proto.RegisterType((*ResizeResponse)(nil), "pb.ResizeResponse")
+	proto.RegisterType((*TopologyChangePlan)(nil), "pb.TopologyChangePlan")
+	proto.RegisterType((*PlannedServer)(nil), "pb.PlannedServer")
+	proto.RegisterType((*ShardBootstrapPlan)(nil), "pb.ShardBootstrapPlan")
+	proto.RegisterType((*ShardLocation)(nil), "pb.ShardLocation")
+	proto.RegisterType((*ResizeJob)(nil), "pb.ResizeJob")
+	proto.RegisterType((*ResizeShardProgress)(nil), "pb.ResizeShardProgress")
+	proto.RegisterType((*ResizeStatusRequest)(nil), "pb.ResizeStatusRequest")
+	proto.RegisterType((*ResizeStatusResponse)(nil), "pb.ResizeStatusResponse")
+	proto.RegisterType((*CancelResizeRequest)(nil), "pb.CancelResizeRequest")
+	proto.RegisterType((*CancelResizeResponse)(nil), "pb.CancelResizeResponse")
+	proto.RegisterType((*ChangeReplicationFactorRequest)(nil), "pb.ChangeReplicationFactorRequest")
+	proto.RegisterType((*ChangeReplicationFactorResponse)(nil), "pb.ChangeReplicationFactorResponse")
+	proto.RegisterType((*ChangeReplicationFactorPrepareRequest)(nil), "pb.ChangeReplicationFactorPrepareRequest")
+	proto.RegisterType((*ChangeReplicationFactorPrepareResponse)(nil), "pb.ChangeReplicationFactorPrepareResponse")
+	proto.RegisterType((*ChangeReplicationFactorCommitRequest)(nil), "pb.ChangeReplicationFactorCommitRequest")
+	proto.RegisterType((*ChangeReplicationFactorCommitResponse)(nil), "pb.ChangeReplicationFactorCommitResponse")
+	proto.RegisterType((*AdminJob)(nil), "pb.AdminJob")
+	proto.RegisterType((*AdminJobStep)(nil), "pb.AdminJobStep")
+	proto.RegisterType((*ListJobsRequest)(nil), "pb.ListJobsRequest")
+	proto.RegisterType((*ListJobsResponse)(nil), "pb.ListJobsResponse")
+	proto.RegisterType((*GetJobRequest)(nil), "pb.GetJobRequest")
+	proto.RegisterType((*GetJobResponse)(nil), "pb.GetJobResponse")
+	proto.RegisterType((*CancelJobRequest)(nil), "pb.CancelJobRequest")
+	proto.RegisterType((*CancelJobResponse)(nil), "pb.CancelJobResponse")
 	proto.RegisterEnum("pb.OpAndDataType", OpAndDataType_name, OpAndDataType_value)
+	proto.RegisterEnum("pb.IndexDefinition_Source", IndexDefinition_Source_name, IndexDefinition_Source_value)
 	proto.RegisterEnum("pb.ShardInfo_Status", ShardInfo_Status_name, ShardInfo_Status_value)
+	proto.RegisterEnum("pb.SortedSetRequest_Op", SortedSetRequest_Op_name, SortedSetRequest_Op_value)
+	proto.RegisterEnum("pb.TtlRequest_Op", TtlRequest_Op_name, TtlRequest_Op_value)
+	proto.RegisterEnum("pb.ResizeJob_State", ResizeJob_State_name, ResizeJob_State_value)
+	proto.RegisterEnum("pb.AdminJob_State", AdminJob_State_name, AdminJob_State_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2415,7 +5717,18 @@ type VastoMasterClient interface {
 	DeleteCluster(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*DeleteClusterResponse, error)
 	CompactCluster(ctx context.Context, in *CompactClusterRequest, opts ...grpc.CallOption) (*CompactClusterResponse, error)
 	ResizeCluster(ctx context.Context, in *ResizeRequest, opts ...grpc.CallOption) (*ResizeResponse, error)
+	GetResizeStatus(ctx context.Context, in *ResizeStatusRequest, opts ...grpc.CallOption) (*ResizeStatusResponse, error)
+	CancelResize(ctx context.Context, in *CancelResizeRequest, opts ...grpc.CallOption) (*CancelResizeResponse, error)
 	ReplaceNode(ctx context.Context, in *ReplaceNodeRequest, opts ...grpc.CallOption) (*ReplaceNodeResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	DrainStore(ctx context.Context, in *DrainStoreRequest, opts ...grpc.CallOption) (*DrainStoreResponse, error)
+	ChangeReplicationFactor(ctx context.Context, in *ChangeReplicationFactorRequest, opts ...grpc.CallOption) (*ChangeReplicationFactorResponse, error)
+	SetBootstrapThrottle(ctx context.Context, in *SetBootstrapThrottleRequest, opts ...grpc.CallOption) (*SetBootstrapThrottleResponse, error)
+	DefineIndex(ctx context.Context, in *DefineIndexRequest, opts ...grpc.CallOption) (*DefineIndexResponse, error)
+	UpdateKeyspace(ctx context.Context, in *UpdateKeyspaceRequest, opts ...grpc.CallOption) (*UpdateKeyspaceResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
 	DebugMaster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -2534,6 +5847,24 @@ func (c *vastoMasterClient) ResizeCluster(ctx context.Context, in *ResizeRequest
 	return out, nil
 }
 
+func (c *vastoMasterClient) GetResizeStatus(ctx context.Context, in *ResizeStatusRequest, opts ...grpc.CallOption) (*ResizeStatusResponse, error) {
+	out := new(ResizeStatusResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/GetResizeStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) CancelResize(ctx context.Context, in *CancelResizeRequest, opts ...grpc.CallOption) (*CancelResizeResponse, error) {
+	out := new(CancelResizeResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/CancelResize", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vastoMasterClient) ReplaceNode(ctx context.Context, in *ReplaceNodeRequest, opts ...grpc.CallOption) (*ReplaceNodeResponse, error) {
 	out := new(ReplaceNodeResponse)
 	err := grpc.Invoke(ctx, "/pb.VastoMaster/ReplaceNode", in, out, c.cc, opts...)
@@ -2543,6 +5874,87 @@ func (c *vastoMasterClient) ReplaceNode(ctx context.Context, in *ReplaceNodeRequ
 	return out, nil
 }
 
+func (c *vastoMasterClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/Balance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) DrainStore(ctx context.Context, in *DrainStoreRequest, opts ...grpc.CallOption) (*DrainStoreResponse, error) {
+	out := new(DrainStoreResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/DrainStore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) ChangeReplicationFactor(ctx context.Context, in *ChangeReplicationFactorRequest, opts ...grpc.CallOption) (*ChangeReplicationFactorResponse, error) {
+	out := new(ChangeReplicationFactorResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/ChangeReplicationFactor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) SetBootstrapThrottle(ctx context.Context, in *SetBootstrapThrottleRequest, opts ...grpc.CallOption) (*SetBootstrapThrottleResponse, error) {
+	out := new(SetBootstrapThrottleResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/SetBootstrapThrottle", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) DefineIndex(ctx context.Context, in *DefineIndexRequest, opts ...grpc.CallOption) (*DefineIndexResponse, error) {
+	out := new(DefineIndexResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/DefineIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) UpdateKeyspace(ctx context.Context, in *UpdateKeyspaceRequest, opts ...grpc.CallOption) (*UpdateKeyspaceResponse, error) {
+	out := new(UpdateKeyspaceResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/UpdateKeyspace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/ListJobs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/GetJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoMasterClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoMaster/CancelJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vastoMasterClient) DebugMaster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := grpc.Invoke(ctx, "/pb.VastoMaster/DebugMaster", in, out, c.cc, opts...)
@@ -2562,7 +5974,18 @@ type VastoMasterServer interface {
 	DeleteCluster(context.Context, *DeleteClusterRequest) (*DeleteClusterResponse, error)
 	CompactCluster(context.Context, *CompactClusterRequest) (*CompactClusterResponse, error)
 	ResizeCluster(context.Context, *ResizeRequest) (*ResizeResponse, error)
+	GetResizeStatus(context.Context, *ResizeStatusRequest) (*ResizeStatusResponse, error)
+	CancelResize(context.Context, *CancelResizeRequest) (*CancelResizeResponse, error)
 	ReplaceNode(context.Context, *ReplaceNodeRequest) (*ReplaceNodeResponse, error)
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	DrainStore(context.Context, *DrainStoreRequest) (*DrainStoreResponse, error)
+	ChangeReplicationFactor(context.Context, *ChangeReplicationFactorRequest) (*ChangeReplicationFactorResponse, error)
+	SetBootstrapThrottle(context.Context, *SetBootstrapThrottleRequest) (*SetBootstrapThrottleResponse, error)
+	DefineIndex(context.Context, *DefineIndexRequest) (*DefineIndexResponse, error)
+	UpdateKeyspace(context.Context, *UpdateKeyspaceRequest) (*UpdateKeyspaceResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
 	DebugMaster(context.Context, *Empty) (*Empty, error)
 }
 
@@ -2588,144 +6011,342 @@ func (x *vastoMasterRegisterStoreServer) Send(m *StoreMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *vastoMasterRegisterStoreServer) Recv() (*StoreHeartbeat, error) {
-	m := new(StoreHeartbeat)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *vastoMasterRegisterStoreServer) Recv() (*StoreHeartbeat, error) {
+	m := new(StoreHeartbeat)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VastoMaster_RegisterClient_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VastoMasterServer).RegisterClient(&vastoMasterRegisterClientServer{stream})
+}
+
+type VastoMaster_RegisterClientServer interface {
+	Send(*ClientMessage) error
+	Recv() (*ClientHeartbeat, error)
+	grpc.ServerStream
+}
+
+type vastoMasterRegisterClientServer struct {
+	grpc.ServerStream
+}
+
+func (x *vastoMasterRegisterClientServer) Send(m *ClientMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *vastoMasterRegisterClientServer) Recv() (*ClientHeartbeat, error) {
+	m := new(ClientHeartbeat)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VastoMaster_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).CreateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/CreateCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).CreateCluster(ctx, req.(*CreateClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).DeleteCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/DeleteCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).DeleteCluster(ctx, req.(*DeleteClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_CompactCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).CompactCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/CompactCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).CompactCluster(ctx, req.(*CompactClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_ResizeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).ResizeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/ResizeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).ResizeCluster(ctx, req.(*ResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_GetResizeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).GetResizeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/GetResizeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).GetResizeStatus(ctx, req.(*ResizeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_CancelResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelResizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).CancelResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/CancelResize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).CancelResize(ctx, req.(*CancelResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_ReplaceNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).ReplaceNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/ReplaceNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).ReplaceNode(ctx, req.(*ReplaceNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoMaster_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _VastoMaster_RegisterClient_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(VastoMasterServer).RegisterClient(&vastoMasterRegisterClientServer{stream})
-}
-
-type VastoMaster_RegisterClientServer interface {
-	Send(*ClientMessage) error
-	Recv() (*ClientHeartbeat, error)
-	grpc.ServerStream
-}
-
-type vastoMasterRegisterClientServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(VastoMasterServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *vastoMasterRegisterClientServer) Send(m *ClientMessage) error {
-	return x.ServerStream.SendMsg(m)
+func _VastoMaster_DrainStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoMasterServer).DrainStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/DrainStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).DrainStore(ctx, req.(*DrainStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *vastoMasterRegisterClientServer) Recv() (*ClientHeartbeat, error) {
-	m := new(ClientHeartbeat)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _VastoMaster_ChangeReplicationFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReplicationFactorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(VastoMasterServer).ChangeReplicationFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoMaster/ChangeReplicationFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoMasterServer).ChangeReplicationFactor(ctx, req.(*ChangeReplicationFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _VastoMaster_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeRequest)
+func _VastoMaster_SetBootstrapThrottle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBootstrapThrottleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VastoMasterServer).Describe(ctx, in)
+		return srv.(VastoMasterServer).SetBootstrapThrottle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.VastoMaster/Describe",
+		FullMethod: "/pb.VastoMaster/SetBootstrapThrottle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VastoMasterServer).Describe(ctx, req.(*DescribeRequest))
+		return srv.(VastoMasterServer).SetBootstrapThrottle(ctx, req.(*SetBootstrapThrottleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VastoMaster_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateClusterRequest)
+func _VastoMaster_DefineIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VastoMasterServer).CreateCluster(ctx, in)
+		return srv.(VastoMasterServer).DefineIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.VastoMaster/CreateCluster",
+		FullMethod: "/pb.VastoMaster/DefineIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VastoMasterServer).CreateCluster(ctx, req.(*CreateClusterRequest))
+		return srv.(VastoMasterServer).DefineIndex(ctx, req.(*DefineIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VastoMaster_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteClusterRequest)
+func _VastoMaster_UpdateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKeyspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VastoMasterServer).DeleteCluster(ctx, in)
+		return srv.(VastoMasterServer).UpdateKeyspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.VastoMaster/DeleteCluster",
+		FullMethod: "/pb.VastoMaster/UpdateKeyspace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VastoMasterServer).DeleteCluster(ctx, req.(*DeleteClusterRequest))
+		return srv.(VastoMasterServer).UpdateKeyspace(ctx, req.(*UpdateKeyspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VastoMaster_CompactCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompactClusterRequest)
+func _VastoMaster_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VastoMasterServer).CompactCluster(ctx, in)
+		return srv.(VastoMasterServer).ListJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.VastoMaster/CompactCluster",
+		FullMethod: "/pb.VastoMaster/ListJobs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VastoMasterServer).CompactCluster(ctx, req.(*CompactClusterRequest))
+		return srv.(VastoMasterServer).ListJobs(ctx, req.(*ListJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VastoMaster_ResizeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResizeRequest)
+func _VastoMaster_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VastoMasterServer).ResizeCluster(ctx, in)
+		return srv.(VastoMasterServer).GetJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.VastoMaster/ResizeCluster",
+		FullMethod: "/pb.VastoMaster/GetJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VastoMasterServer).ResizeCluster(ctx, req.(*ResizeRequest))
+		return srv.(VastoMasterServer).GetJob(ctx, req.(*GetJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VastoMaster_ReplaceNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReplaceNodeRequest)
+func _VastoMaster_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VastoMasterServer).ReplaceNode(ctx, in)
+		return srv.(VastoMasterServer).CancelJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.VastoMaster/ReplaceNode",
+		FullMethod: "/pb.VastoMaster/CancelJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VastoMasterServer).ReplaceNode(ctx, req.(*ReplaceNodeRequest))
+		return srv.(VastoMasterServer).CancelJob(ctx, req.(*CancelJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2772,10 +6393,54 @@ var _VastoMaster_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResizeCluster",
 			Handler:    _VastoMaster_ResizeCluster_Handler,
 		},
+		{
+			MethodName: "GetResizeStatus",
+			Handler:    _VastoMaster_GetResizeStatus_Handler,
+		},
+		{
+			MethodName: "CancelResize",
+			Handler:    _VastoMaster_CancelResize_Handler,
+		},
 		{
 			MethodName: "ReplaceNode",
 			Handler:    _VastoMaster_ReplaceNode_Handler,
 		},
+		{
+			MethodName: "Balance",
+			Handler:    _VastoMaster_Balance_Handler,
+		},
+		{
+			MethodName: "DrainStore",
+			Handler:    _VastoMaster_DrainStore_Handler,
+		},
+		{
+			MethodName: "ChangeReplicationFactor",
+			Handler:    _VastoMaster_ChangeReplicationFactor_Handler,
+		},
+		{
+			MethodName: "SetBootstrapThrottle",
+			Handler:    _VastoMaster_SetBootstrapThrottle_Handler,
+		},
+		{
+			MethodName: "DefineIndex",
+			Handler:    _VastoMaster_DefineIndex_Handler,
+		},
+		{
+			MethodName: "UpdateKeyspace",
+			Handler:    _VastoMaster_UpdateKeyspace_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _VastoMaster_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _VastoMaster_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _VastoMaster_CancelJob_Handler,
+		},
 		{
 			MethodName: "DebugMaster",
 			Handler:    _VastoMaster_DebugMaster_Handler,
@@ -2804,15 +6469,21 @@ type VastoStoreClient interface {
 	BootstrapCopy(ctx context.Context, in *BootstrapCopyRequest, opts ...grpc.CallOption) (VastoStore_BootstrapCopyClient, error)
 	TailBinlog(ctx context.Context, in *PullUpdateRequest, opts ...grpc.CallOption) (VastoStore_TailBinlogClient, error)
 	CheckBinlog(ctx context.Context, in *CheckBinlogRequest, opts ...grpc.CallOption) (*CheckBinlogResponse, error)
+	SubscribeExpiry(ctx context.Context, in *SubscribeExpiryRequest, opts ...grpc.CallOption) (VastoStore_SubscribeExpiryClient, error)
 	CreateShard(ctx context.Context, in *CreateShardRequest, opts ...grpc.CallOption) (*CreateShardResponse, error)
 	DeleteKeyspace(ctx context.Context, in *DeleteKeyspaceRequest, opts ...grpc.CallOption) (*DeleteKeyspaceResponse, error)
 	CompactKeyspace(ctx context.Context, in *CompactKeyspaceRequest, opts ...grpc.CallOption) (*CompactKeyspaceResponse, error)
+	DefineKeyspaceIndex(ctx context.Context, in *DefineIndexRequest, opts ...grpc.CallOption) (*DefineIndexResponse, error)
+	UpdateKeyspaceSettings(ctx context.Context, in *UpdateKeyspaceRequest, opts ...grpc.CallOption) (*UpdateKeyspaceResponse, error)
 	ReplicateNodePrepare(ctx context.Context, in *ReplicateNodePrepareRequest, opts ...grpc.CallOption) (*ReplicateNodePrepareResponse, error)
 	ReplicateNodeCommit(ctx context.Context, in *ReplicateNodeCommitRequest, opts ...grpc.CallOption) (*ReplicateNodeCommitResponse, error)
 	ReplicateNodeCleanup(ctx context.Context, in *ReplicateNodeCleanupRequest, opts ...grpc.CallOption) (*ReplicateNodeCleanupResponse, error)
 	ResizePrepare(ctx context.Context, in *ResizeCreateShardRequest, opts ...grpc.CallOption) (*ResizeCreateShardResponse, error)
 	ResizeCommit(ctx context.Context, in *ResizeCommitRequest, opts ...grpc.CallOption) (*ResizeCommitResponse, error)
 	ResizeCleanup(ctx context.Context, in *ResizeCleanupRequest, opts ...grpc.CallOption) (*ResizeCleanupResponse, error)
+	ChangeReplicationFactorPrepare(ctx context.Context, in *ChangeReplicationFactorPrepareRequest, opts ...grpc.CallOption) (*ChangeReplicationFactorPrepareResponse, error)
+	ChangeReplicationFactorCommit(ctx context.Context, in *ChangeReplicationFactorCommitRequest, opts ...grpc.CallOption) (*ChangeReplicationFactorCommitResponse, error)
+	SetBootstrapThrottle(ctx context.Context, in *SetBootstrapThrottleRequest, opts ...grpc.CallOption) (*SetBootstrapThrottleResponse, error)
 	DebugStore(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -2897,6 +6568,38 @@ func (c *vastoStoreClient) CheckBinlog(ctx context.Context, in *CheckBinlogReque
 	return out, nil
 }
 
+func (c *vastoStoreClient) SubscribeExpiry(ctx context.Context, in *SubscribeExpiryRequest, opts ...grpc.CallOption) (VastoStore_SubscribeExpiryClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VastoStore_serviceDesc.Streams[2], c.cc, "/pb.VastoStore/SubscribeExpiry", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vastoStoreSubscribeExpiryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VastoStore_SubscribeExpiryClient interface {
+	Recv() (*ExpiryEvent, error)
+	grpc.ClientStream
+}
+
+type vastoStoreSubscribeExpiryClient struct {
+	grpc.ClientStream
+}
+
+func (x *vastoStoreSubscribeExpiryClient) Recv() (*ExpiryEvent, error) {
+	m := new(ExpiryEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *vastoStoreClient) CreateShard(ctx context.Context, in *CreateShardRequest, opts ...grpc.CallOption) (*CreateShardResponse, error) {
 	out := new(CreateShardResponse)
 	err := grpc.Invoke(ctx, "/pb.VastoStore/CreateShard", in, out, c.cc, opts...)
@@ -2924,6 +6627,24 @@ func (c *vastoStoreClient) CompactKeyspace(ctx context.Context, in *CompactKeysp
 	return out, nil
 }
 
+func (c *vastoStoreClient) DefineKeyspaceIndex(ctx context.Context, in *DefineIndexRequest, opts ...grpc.CallOption) (*DefineIndexResponse, error) {
+	out := new(DefineIndexResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/DefineKeyspaceIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoStoreClient) UpdateKeyspaceSettings(ctx context.Context, in *UpdateKeyspaceRequest, opts ...grpc.CallOption) (*UpdateKeyspaceResponse, error) {
+	out := new(UpdateKeyspaceResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/UpdateKeyspaceSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vastoStoreClient) ReplicateNodePrepare(ctx context.Context, in *ReplicateNodePrepareRequest, opts ...grpc.CallOption) (*ReplicateNodePrepareResponse, error) {
 	out := new(ReplicateNodePrepareResponse)
 	err := grpc.Invoke(ctx, "/pb.VastoStore/ReplicateNodePrepare", in, out, c.cc, opts...)
@@ -2978,6 +6699,33 @@ func (c *vastoStoreClient) ResizeCleanup(ctx context.Context, in *ResizeCleanupR
 	return out, nil
 }
 
+func (c *vastoStoreClient) ChangeReplicationFactorPrepare(ctx context.Context, in *ChangeReplicationFactorPrepareRequest, opts ...grpc.CallOption) (*ChangeReplicationFactorPrepareResponse, error) {
+	out := new(ChangeReplicationFactorPrepareResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/ChangeReplicationFactorPrepare", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoStoreClient) ChangeReplicationFactorCommit(ctx context.Context, in *ChangeReplicationFactorCommitRequest, opts ...grpc.CallOption) (*ChangeReplicationFactorCommitResponse, error) {
+	out := new(ChangeReplicationFactorCommitResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/ChangeReplicationFactorCommit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vastoStoreClient) SetBootstrapThrottle(ctx context.Context, in *SetBootstrapThrottleRequest, opts ...grpc.CallOption) (*SetBootstrapThrottleResponse, error) {
+	out := new(SetBootstrapThrottleResponse)
+	err := grpc.Invoke(ctx, "/pb.VastoStore/SetBootstrapThrottle", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vastoStoreClient) DebugStore(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := grpc.Invoke(ctx, "/pb.VastoStore/DebugStore", in, out, c.cc, opts...)
@@ -2993,15 +6741,21 @@ type VastoStoreServer interface {
 	BootstrapCopy(*BootstrapCopyRequest, VastoStore_BootstrapCopyServer) error
 	TailBinlog(*PullUpdateRequest, VastoStore_TailBinlogServer) error
 	CheckBinlog(context.Context, *CheckBinlogRequest) (*CheckBinlogResponse, error)
+	SubscribeExpiry(*SubscribeExpiryRequest, VastoStore_SubscribeExpiryServer) error
 	CreateShard(context.Context, *CreateShardRequest) (*CreateShardResponse, error)
 	DeleteKeyspace(context.Context, *DeleteKeyspaceRequest) (*DeleteKeyspaceResponse, error)
 	CompactKeyspace(context.Context, *CompactKeyspaceRequest) (*CompactKeyspaceResponse, error)
+	DefineKeyspaceIndex(context.Context, *DefineIndexRequest) (*DefineIndexResponse, error)
+	UpdateKeyspaceSettings(context.Context, *UpdateKeyspaceRequest) (*UpdateKeyspaceResponse, error)
 	ReplicateNodePrepare(context.Context, *ReplicateNodePrepareRequest) (*ReplicateNodePrepareResponse, error)
 	ReplicateNodeCommit(context.Context, *ReplicateNodeCommitRequest) (*ReplicateNodeCommitResponse, error)
 	ReplicateNodeCleanup(context.Context, *ReplicateNodeCleanupRequest) (*ReplicateNodeCleanupResponse, error)
 	ResizePrepare(context.Context, *ResizeCreateShardRequest) (*ResizeCreateShardResponse, error)
 	ResizeCommit(context.Context, *ResizeCommitRequest) (*ResizeCommitResponse, error)
 	ResizeCleanup(context.Context, *ResizeCleanupRequest) (*ResizeCleanupResponse, error)
+	ChangeReplicationFactorPrepare(context.Context, *ChangeReplicationFactorPrepareRequest) (*ChangeReplicationFactorPrepareResponse, error)
+	ChangeReplicationFactorCommit(context.Context, *ChangeReplicationFactorCommitRequest) (*ChangeReplicationFactorCommitResponse, error)
+	SetBootstrapThrottle(context.Context, *SetBootstrapThrottleRequest) (*SetBootstrapThrottleResponse, error)
 	DebugStore(context.Context, *Empty) (*Empty, error)
 }
 
@@ -3069,6 +6823,27 @@ func _VastoStore_CheckBinlog_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VastoStore_SubscribeExpiry_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeExpiryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VastoStoreServer).SubscribeExpiry(m, &vastoStoreSubscribeExpiryServer{stream})
+}
+
+type VastoStore_SubscribeExpiryServer interface {
+	Send(*ExpiryEvent) error
+	grpc.ServerStream
+}
+
+type vastoStoreSubscribeExpiryServer struct {
+	grpc.ServerStream
+}
+
+func (x *vastoStoreSubscribeExpiryServer) Send(m *ExpiryEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _VastoStore_CreateShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShardRequest)
 	if err := dec(in); err != nil {
@@ -3123,6 +6898,42 @@ func _VastoStore_CompactKeyspace_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VastoStore_DefineKeyspaceIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).DefineKeyspaceIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/DefineKeyspaceIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).DefineKeyspaceIndex(ctx, req.(*DefineIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoStore_UpdateKeyspaceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).UpdateKeyspaceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/UpdateKeyspaceSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).UpdateKeyspaceSettings(ctx, req.(*UpdateKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VastoStore_ReplicateNodePrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReplicateNodePrepareRequest)
 	if err := dec(in); err != nil {
@@ -3231,6 +7042,60 @@ func _VastoStore_ResizeCleanup_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VastoStore_ChangeReplicationFactorPrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReplicationFactorPrepareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).ChangeReplicationFactorPrepare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/ChangeReplicationFactorPrepare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).ChangeReplicationFactorPrepare(ctx, req.(*ChangeReplicationFactorPrepareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoStore_ChangeReplicationFactorCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReplicationFactorCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).ChangeReplicationFactorCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/ChangeReplicationFactorCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).ChangeReplicationFactorCommit(ctx, req.(*ChangeReplicationFactorCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VastoStore_SetBootstrapThrottle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBootstrapThrottleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VastoStoreServer).SetBootstrapThrottle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.VastoStore/SetBootstrapThrottle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VastoStoreServer).SetBootstrapThrottle(ctx, req.(*SetBootstrapThrottleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VastoStore_DebugStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -3269,6 +7134,14 @@ var _VastoStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CompactKeyspace",
 			Handler:    _VastoStore_CompactKeyspace_Handler,
 		},
+		{
+			MethodName: "DefineKeyspaceIndex",
+			Handler:    _VastoStore_DefineKeyspaceIndex_Handler,
+		},
+		{
+			MethodName: "UpdateKeyspaceSettings",
+			Handler:    _VastoStore_UpdateKeyspaceSettings_Handler,
+		},
 		{
 			MethodName: "ReplicateNodePrepare",
 			Handler:    _VastoStore_ReplicateNodePrepare_Handler,
@@ -3293,6 +7166,18 @@ var _VastoStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResizeCleanup",
 			Handler:    _VastoStore_ResizeCleanup_Handler,
 		},
+		{
+			MethodName: "ChangeReplicationFactorPrepare",
+			Handler:    _VastoStore_ChangeReplicationFactorPrepare_Handler,
+		},
+		{
+			MethodName: "ChangeReplicationFactorCommit",
+			Handler:    _VastoStore_ChangeReplicationFactorCommit_Handler,
+		},
+		{
+			MethodName: "SetBootstrapThrottle",
+			Handler:    _VastoStore_SetBootstrapThrottle_Handler,
+		},
 		{
 			MethodName: "DebugStore",
 			Handler:    _VastoStore_DebugStore_Handler,
@@ -3309,6 +7194,11 @@ var _VastoStore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _VastoStore_TailBinlog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeExpiry",
+			Handler:       _VastoStore_SubscribeExpiry_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "vasto.proto",
 }
@@ -3316,191 +7206,414 @@ var _VastoStore_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("vasto.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2970 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
-	0xf1, 0xe7, 0xe2, 0x41, 0x00, 0xbd, 0x04, 0x40, 0x0e, 0x49, 0x89, 0x5a, 0xd9, 0x16, 0xb5, 0xfe,
-	0x4b, 0xa6, 0x2d, 0x09, 0xd6, 0x9f, 0x76, 0x62, 0x45, 0xae, 0x8a, 0x4d, 0x82, 0x94, 0xc5, 0x48,
-	0x14, 0x59, 0x0b, 0xda, 0xb1, 0xcb, 0xa9, 0xda, 0x5a, 0x62, 0x87, 0xd0, 0x86, 0xc0, 0xee, 0x66,
-	0x67, 0x60, 0x1a, 0x39, 0xfa, 0x90, 0x54, 0x0e, 0xb9, 0x24, 0x97, 0x5c, 0x52, 0x95, 0x4a, 0x2e,
-	0xa9, 0xca, 0x67, 0xc8, 0x21, 0x87, 0x5c, 0xf2, 0xba, 0xa5, 0x2a, 0x95, 0x5b, 0x3e, 0x40, 0xae,
-	0xf1, 0x35, 0x35, 0xaf, 0x7d, 0x00, 0x0b, 0x90, 0xb4, 0xe3, 0x2a, 0xdf, 0x30, 0xdd, 0x3d, 0x3d,
-	0xfd, 0xf8, 0x4d, 0x4f, 0xcf, 0x60, 0x41, 0xff, 0xc4, 0x21, 0x34, 0x68, 0x85, 0x51, 0x40, 0x03,
-	0x54, 0x08, 0x8f, 0x4d, 0x0b, 0x1a, 0xdb, 0x4e, 0xdf, 0xf1, 0xbb, 0xd8, 0xc2, 0x3f, 0x18, 0x62,
-	0x42, 0xd1, 0x0d, 0xd0, 0x09, 0x0d, 0x22, 0x6c, 0xf7, 0xa2, 0x60, 0x18, 0xae, 0x15, 0xd6, 0xb5,
-	0x8d, 0x9a, 0x05, 0x9c, 0xf4, 0x1e, 0xa3, 0x24, 0x02, 0xdd, 0x60, 0xe8, 0xd3, 0xb5, 0xe2, 0xba,
-	0xb6, 0x51, 0x97, 0x02, 0x6d, 0x46, 0x31, 0xcf, 0xa0, 0xd1, 0x61, 0xa3, 0xc7, 0xd8, 0x89, 0xe8,
-	0x31, 0x76, 0x28, 0x7a, 0x00, 0x0d, 0x31, 0x25, 0xc2, 0x24, 0x18, 0x46, 0x5d, 0xbc, 0xa6, 0xad,
-	0x6b, 0x1b, 0xfa, 0xe6, 0x52, 0x2b, 0x3c, 0x6e, 0x71, 0x59, 0x4b, 0x32, 0xac, 0x3a, 0x49, 0x0f,
-	0xd1, 0x1d, 0xa8, 0x75, 0x9e, 0x3b, 0x91, 0xbb, 0xe7, 0x9f, 0x04, 0xdc, 0x16, 0x7d, 0xb3, 0xce,
-	0x27, 0x29, 0xa2, 0x95, 0xf0, 0xcd, 0x06, 0x2c, 0x70, 0x65, 0xfb, 0x98, 0x10, 0xa7, 0x87, 0xcd,
-	0x7f, 0x68, 0xd0, 0x6c, 0xf7, 0x3d, 0xec, 0xd3, 0xc4, 0x94, 0x1b, 0xa0, 0x77, 0x39, 0xc9, 0xf6,
-	0x9d, 0x01, 0x56, 0xee, 0x09, 0xd2, 0x33, 0x67, 0x80, 0xd1, 0x01, 0x34, 0xba, 0xfd, 0x21, 0xa1,
-	0x38, 0xb2, 0x4f, 0x82, 0x7e, 0x3f, 0x38, 0xe3, 0x1e, 0xea, 0x9b, 0x1b, 0x6c, 0xd9, 0x31, 0x6d,
-	0xad, 0xb6, 0x90, 0x7c, 0xc4, 0x05, 0xe5, 0xb2, 0x56, 0xbd, 0x9b, 0xa6, 0x1a, 0x1d, 0x58, 0xc9,
-	0x13, 0x43, 0x06, 0x54, 0x4f, 0xf1, 0x88, 0x84, 0x8e, 0x0c, 0x47, 0xcd, 0x8a, 0xc7, 0xcc, 0x4a,
-	0x8f, 0xd8, 0x43, 0x5f, 0x5a, 0xc0, 0xac, 0xac, 0x5a, 0xe0, 0x91, 0xf7, 0x25, 0xc5, 0xfc, 0x6b,
-	0x11, 0xea, 0xc2, 0x18, 0xa5, 0xee, 0x16, 0x54, 0xe4, 0xba, 0x32, 0xb8, 0xba, 0x30, 0x98, 0x93,
-	0x2c, 0xc5, 0x43, 0xef, 0x40, 0x65, 0x18, 0xba, 0x0e, 0xc5, 0x44, 0x86, 0xf3, 0x56, 0xe2, 0x97,
-	0x54, 0x95, 0xcd, 0xc8, 0xfb, 0x5c, 0xda, 0x52, 0xb3, 0xd0, 0x7d, 0x98, 0x8f, 0x30, 0xf1, 0x7e,
-	0x88, 0x65, 0x5c, 0xd6, 0x26, 0xe7, 0x5b, 0x9c, 0x6f, 0x49, 0x39, 0xe3, 0x17, 0x1a, 0x2c, 0xe7,
-	0xa8, 0x44, 0xb7, 0xa0, 0xec, 0x07, 0x2e, 0x26, 0x6b, 0xda, 0x7a, 0x71, 0x43, 0xdf, 0x6c, 0xa6,
-	0xec, 0x7d, 0x16, 0xb8, 0xd8, 0x12, 0x5c, 0x74, 0x1d, 0x6a, 0x1e, 0xb1, 0x5d, 0xdc, 0xc7, 0x14,
-	0xcb, 0x48, 0x54, 0x3d, 0xb2, 0xc3, 0xc7, 0x99, 0x20, 0x16, 0xc7, 0x82, 0x78, 0x13, 0x16, 0x3c,
-	0x62, 0x87, 0x51, 0x30, 0x08, 0xa8, 0x17, 0xf8, 0x6b, 0x25, 0x3e, 0x57, 0xf7, 0xc8, 0xa1, 0x22,
-	0x19, 0x3f, 0xd2, 0x60, 0x5e, 0x58, 0x8b, 0xee, 0xc3, 0x4a, 0x77, 0x18, 0x45, 0x0c, 0x19, 0x2a,
-	0xff, 0xdc, 0x4b, 0x8d, 0xe3, 0x1b, 0x49, 0x9e, 0xb4, 0xaf, 0xc3, 0x66, 0xb4, 0x60, 0x99, 0x3a,
-	0x51, 0x0f, 0x8f, 0x4d, 0x28, 0xf0, 0x09, 0x4b, 0x82, 0x95, 0x96, 0x9f, 0x61, 0xab, 0xf9, 0x2f,
-	0x0d, 0x2a, 0x52, 0x76, 0x26, 0x30, 0xe2, 0x98, 0x15, 0x67, 0xc6, 0x6c, 0x13, 0x56, 0xf1, 0xa7,
-	0x21, 0xee, 0x52, 0xec, 0x66, 0x8d, 0x2b, 0x71, 0xe3, 0x96, 0x15, 0x33, 0x6d, 0xde, 0xb4, 0x00,
-	0x94, 0xa7, 0x06, 0xe0, 0x1e, 0xa0, 0x08, 0x87, 0x7d, 0xaf, 0xeb, 0xb0, 0x60, 0xda, 0x27, 0x4e,
-	0x97, 0x06, 0xd1, 0xda, 0xbc, 0xf0, 0x3f, 0xc5, 0x79, 0xc4, 0x19, 0xe6, 0x10, 0xf4, 0x94, 0xa9,
-	0x5f, 0xa2, 0x28, 0xdc, 0x05, 0x20, 0x6c, 0xd3, 0xdb, 0xde, 0xf4, 0xaa, 0x40, 0xe2, 0xaa, 0xf0,
-	0x27, 0x0d, 0xea, 0x19, 0x75, 0x68, 0x0d, 0x2a, 0x3e, 0xa6, 0x67, 0x41, 0x74, 0x2a, 0xf7, 0xbf,
-	0x1a, 0x32, 0x8e, 0xe3, 0xba, 0x11, 0x26, 0x44, 0x66, 0x48, 0x0d, 0xd1, 0xcb, 0x50, 0x77, 0xdc,
-	0x81, 0xe7, 0xdb, 0x8a, 0x5f, 0xe2, 0xfc, 0x05, 0x4e, 0xdc, 0x92, 0x42, 0x08, 0x4a, 0xd4, 0xe9,
-	0x91, 0xb5, 0xca, 0x7a, 0x71, 0xa3, 0x66, 0xf1, 0xdf, 0x68, 0x1d, 0x16, 0x5c, 0x8f, 0x9c, 0xf2,
-	0x58, 0xda, 0xbd, 0xe3, 0xb5, 0xaa, 0xa8, 0x97, 0x8c, 0xc6, 0x82, 0xf8, 0xde, 0x31, 0x7a, 0x0d,
-	0x96, 0x9c, 0x7e, 0x3f, 0xe8, 0x3a, 0x2c, 0x5b, 0x4a, 0xac, 0xc6, 0xc5, 0x9a, 0x31, 0x43, 0xc8,
-	0x9a, 0x3f, 0x29, 0xc0, 0xca, 0xd3, 0xa0, 0xeb, 0xf4, 0xb9, 0xab, 0x64, 0xcf, 0x57, 0xa0, 0x69,
-	0x40, 0xc1, 0x73, 0x25, 0x58, 0x0b, 0x9e, 0x8b, 0xda, 0x20, 0x42, 0x60, 0x0f, 0x1c, 0x56, 0xc4,
-	0x19, 0x58, 0x6e, 0xb3, 0x10, 0xe5, 0x4d, 0x16, 0x71, 0xdb, 0x77, 0xc2, 0x5d, 0x9f, 0x46, 0x23,
-	0xab, 0x4a, 0xe4, 0x90, 0xed, 0xa0, 0x0c, 0x14, 0x44, 0xad, 0xd7, 0xbb, 0xe7, 0x62, 0xa0, 0x34,
-	0x05, 0x03, 0xc6, 0x77, 0xa0, 0x9e, 0x59, 0x0c, 0x2d, 0x42, 0xf1, 0x14, 0x8f, 0xa4, 0xe1, 0xec,
-	0x27, 0x7a, 0x19, 0xca, 0x9f, 0x38, 0xfd, 0x21, 0xce, 0x4f, 0xac, 0xe0, 0x3d, 0x2c, 0x3c, 0xd0,
-	0xcc, 0xcf, 0x0b, 0xa9, 0xc3, 0x81, 0x25, 0x48, 0xed, 0x12, 0x51, 0xda, 0xc5, 0xd6, 0x59, 0x50,
-	0x44, 0x5e, 0xdc, 0xaf, 0x43, 0x8d, 0xe0, 0xe8, 0x13, 0x1c, 0xd9, 0x9e, 0x2b, 0x37, 0x6a, 0x55,
-	0x10, 0xf6, 0x5c, 0x74, 0x0d, 0xaa, 0x12, 0x56, 0xae, 0xf4, 0xb4, 0x22, 0x50, 0xe4, 0x4e, 0x04,
-	0xa2, 0x74, 0xd1, 0x40, 0x94, 0xa7, 0x04, 0x02, 0xdd, 0x85, 0x79, 0x42, 0x1d, 0x3a, 0x24, 0x7c,
-	0xbf, 0x34, 0x36, 0x57, 0x32, 0x6e, 0xb6, 0x3a, 0x9c, 0x67, 0x49, 0x19, 0x59, 0xca, 0xba, 0x8e,
-	0xef, 0x7a, 0xac, 0x74, 0xae, 0x55, 0x54, 0x29, 0x6b, 0x2b, 0x12, 0xab, 0x46, 0xac, 0xda, 0xe1,
-	0x68, 0xe0, 0xf8, 0x6c, 0x0f, 0xcb, 0x82, 0x59, 0xe5, 0x92, 0x4b, 0x1e, 0x39, 0x54, 0x1c, 0x51,
-	0x39, 0xcd, 0x87, 0x30, 0x2f, 0x16, 0x41, 0x35, 0x28, 0xef, 0xee, 0x1f, 0x1e, 0x7d, 0xb4, 0x38,
-	0x87, 0xea, 0x50, 0xdb, 0x3e, 0x38, 0x38, 0xea, 0x1c, 0x59, 0x5b, 0x87, 0x8b, 0x1a, 0xe3, 0x58,
-	0xbb, 0x5b, 0x3b, 0x1f, 0x2d, 0x16, 0x90, 0x0e, 0x95, 0x9d, 0xdd, 0xa7, 0xbb, 0x47, 0xbb, 0x3b,
-	0x8b, 0x45, 0xb3, 0x02, 0xe5, 0xdd, 0x41, 0x48, 0x47, 0xe6, 0x4f, 0x35, 0x58, 0x78, 0x82, 0x47,
-	0x47, 0xa3, 0x10, 0x7f, 0xc0, 0xf2, 0x92, 0x4e, 0xe7, 0x82, 0x48, 0xe7, 0x2d, 0x68, 0x84, 0x4e,
-	0x44, 0x3d, 0x1e, 0x95, 0xe7, 0x0e, 0x79, 0xce, 0xe3, 0x5e, 0xb2, 0xea, 0x31, 0xf5, 0xb1, 0x43,
-	0x9e, 0xa3, 0x16, 0xd4, 0x5c, 0x87, 0x3a, 0x36, 0x1d, 0x85, 0x02, 0x67, 0x0d, 0x51, 0x08, 0x0e,
-	0xc2, 0x2d, 0xdf, 0xdd, 0x71, 0xa8, 0xc3, 0xd6, 0xb0, 0xaa, 0xae, 0xfc, 0x85, 0x56, 0x14, 0x4a,
-	0x4a, 0x7c, 0x29, 0x31, 0x30, 0x0f, 0xa0, 0x2a, 0xfb, 0x18, 0x32, 0xb3, 0x8c, 0xbe, 0x02, 0xd5,
-	0x48, 0xca, 0xc9, 0xcd, 0xc1, 0x4f, 0x4b, 0x39, 0xd7, 0x8a, 0x99, 0xe6, 0x5b, 0x50, 0xb3, 0x30,
-	0x09, 0x03, 0x9f, 0x60, 0x82, 0x5e, 0x83, 0x5a, 0xa4, 0x06, 0xf2, 0xd0, 0x5a, 0x10, 0xd3, 0x04,
-	0xd1, 0x4a, 0xd8, 0xe6, 0xe7, 0x1a, 0x54, 0x54, 0x4b, 0x95, 0x06, 0x96, 0x96, 0x05, 0xd6, 0x3a,
-	0x14, 0xc3, 0x21, 0x95, 0x50, 0x6f, 0x30, 0x65, 0x87, 0x43, 0xaa, 0xcc, 0x60, 0x2c, 0x26, 0xd1,
-	0xc3, 0x54, 0x1e, 0xb6, 0x5c, 0xe2, 0x3d, 0x9c, 0x48, 0xf4, 0x30, 0x45, 0x0f, 0xa1, 0xce, 0x0e,
-	0xa1, 0xe3, 0x91, 0x1d, 0x46, 0xf8, 0xc4, 0xfb, 0x94, 0x87, 0x44, 0xdf, 0xbc, 0x22, 0x65, 0xb7,
-	0x47, 0x87, 0x9c, 0xac, 0xe6, 0xe8, 0xbd, 0x84, 0x86, 0x5e, 0x85, 0x79, 0x09, 0x94, 0x72, 0x52,
-	0x7c, 0x05, 0x42, 0x94, 0xbc, 0x14, 0x40, 0xb7, 0xa1, 0x3c, 0xc0, 0x51, 0x0f, 0x73, 0xc0, 0xea,
-	0x9b, 0x8b, 0x4c, 0x72, 0x9f, 0x11, 0x94, 0xa0, 0x60, 0x9b, 0xff, 0xd4, 0x00, 0x12, 0x27, 0xbe,
-	0x38, 0x22, 0x4c, 0xa8, 0x8b, 0x9e, 0xc3, 0xb5, 0x1d, 0x6a, 0xfb, 0xa2, 0x22, 0x97, 0x2c, 0x5d,
-	0x12, 0xb7, 0xe8, 0x33, 0x82, 0x5e, 0x04, 0xa0, 0xb4, 0x6f, 0x13, 0xdc, 0x0d, 0x7c, 0x57, 0xee,
-	0xca, 0x1a, 0xa5, 0xfd, 0x0e, 0x27, 0xa0, 0x87, 0xb0, 0x18, 0x84, 0xb6, 0xe3, 0xbb, 0x76, 0x82,
-	0xad, 0xf2, 0x34, 0x6c, 0xd5, 0x83, 0xf4, 0x30, 0x01, 0xd8, 0x7c, 0x1a, 0x60, 0xbf, 0xd7, 0x60,
-	0x21, 0xed, 0xf4, 0x57, 0xeb, 0x5e, 0x9e, 0xfd, 0xa5, 0xcb, 0xda, 0x5f, 0x4e, 0xdb, 0xff, 0x16,
-	0xd4, 0xbf, 0x1b, 0x79, 0x2c, 0xb9, 0x02, 0xa8, 0xec, 0xdc, 0x08, 0x4e, 0xb9, 0xf9, 0x55, 0xab,
-	0x10, 0x9c, 0xa2, 0x2b, 0x71, 0x5d, 0x12, 0x47, 0xa3, 0x1c, 0x99, 0x7d, 0xa8, 0x67, 0x60, 0xf1,
-	0x95, 0x3a, 0x6e, 0xee, 0x02, 0x24, 0x28, 0xff, 0xc2, 0x4b, 0x99, 0x2e, 0xe8, 0x5c, 0xcd, 0xe5,
-	0x7c, 0x45, 0xf7, 0xa0, 0x76, 0x8a, 0x47, 0xb6, 0x08, 0x5f, 0x31, 0x41, 0x7b, 0xba, 0xd2, 0xf1,
-	0x62, 0xc2, 0x7f, 0x99, 0x27, 0x80, 0x26, 0xb7, 0x19, 0x53, 0x2e, 0xb7, 0xa3, 0xb0, 0x5b, 0x8e,
-	0x58, 0x5e, 0xfa, 0xde, 0xc0, 0xa3, 0xf2, 0xf8, 0x11, 0x03, 0x16, 0x94, 0xbe, 0x43, 0xa8, 0x4d,
-	0x30, 0xf6, 0x6d, 0xe6, 0x6c, 0x91, 0x4f, 0xd2, 0x19, 0xb1, 0x83, 0xb1, 0xff, 0x04, 0x8f, 0x4c,
-	0x1f, 0x96, 0x33, 0xeb, 0x5c, 0xd2, 0xab, 0xd7, 0x01, 0x62, 0xaf, 0x54, 0xff, 0x38, 0xe9, 0x56,
-	0x4d, 0xb9, 0x45, 0xcc, 0x9f, 0x6b, 0xac, 0x9a, 0xca, 0x55, 0x5e, 0x81, 0xf2, 0x19, 0x03, 0x4e,
-	0xba, 0x49, 0xcb, 0x20, 0xc9, 0x12, 0x7c, 0x74, 0x53, 0xd4, 0x2b, 0x51, 0xd1, 0x9a, 0x71, 0xbd,
-	0x92, 0x42, 0xbc, 0x60, 0xbd, 0x3d, 0x5e, 0xb0, 0x44, 0x8c, 0xaf, 0x4e, 0x14, 0x2c, 0x39, 0x29,
-	0x5d, 0xb1, 0xcc, 0x6f, 0x80, 0x6e, 0x39, 0x67, 0x4f, 0xa4, 0x95, 0x39, 0xd8, 0x58, 0x49, 0xf7,
-	0x0f, 0x31, 0xf0, 0x7f, 0xa3, 0x41, 0xf5, 0x69, 0xd0, 0x13, 0x4d, 0xc7, 0x04, 0x04, 0xb5, 0xc9,
-	0xbd, 0x77, 0x7e, 0x65, 0x4e, 0x6a, 0x67, 0xf1, 0xc2, 0xb5, 0xb3, 0x34, 0xbb, 0x76, 0x76, 0xa0,
-	0xd1, 0x0e, 0xc2, 0xd1, 0x4e, 0xe0, 0xf3, 0x4b, 0x6c, 0x8f, 0x6f, 0x63, 0x7e, 0x56, 0x70, 0x13,
-	0xcb, 0x96, 0x18, 0xa0, 0x3b, 0x80, 0xba, 0x41, 0x38, 0xb2, 0x09, 0x75, 0x22, 0x6a, 0x53, 0x6f,
-	0x80, 0x99, 0x17, 0xcc, 0xd6, 0xa2, 0xd5, 0x64, 0x9c, 0x0e, 0x63, 0x1c, 0x79, 0x03, 0xfc, 0x8c,
-	0x98, 0xff, 0xd1, 0x60, 0x65, 0x3b, 0x08, 0x28, 0xa1, 0x91, 0x13, 0x32, 0xf5, 0x0a, 0xa2, 0xb3,
-	0x4e, 0xc8, 0xf4, 0x99, 0x55, 0x98, 0xdd, 0x0c, 0xe5, 0x74, 0x85, 0xb7, 0xa1, 0x29, 0xaf, 0x46,
-	0xb1, 0x12, 0x51, 0x9c, 0xeb, 0x82, 0xdc, 0x91, 0xaa, 0xa6, 0x5c, 0xa1, 0xca, 0xd3, 0xae, 0x50,
-	0x57, 0x60, 0x3e, 0x88, 0xbc, 0x9e, 0xe7, 0xf3, 0xaa, 0x5c, 0xb3, 0xe4, 0x28, 0xd9, 0x54, 0x15,
-	0x9e, 0x48, 0x31, 0x30, 0xff, 0xad, 0xc1, 0xea, 0x98, 0xe3, 0x12, 0xcd, 0xad, 0xcc, 0x5e, 0x48,
-	0xdd, 0x3f, 0x53, 0xd0, 0x4a, 0x6d, 0x05, 0xf4, 0x3d, 0x40, 0xc7, 0x9e, 0xdf, 0x0f, 0x7a, 0x47,
-	0x8e, 0xd7, 0x3f, 0x8c, 0x82, 0x1e, 0xbf, 0x02, 0x08, 0x6c, 0xdc, 0x65, 0xf3, 0x72, 0x97, 0x69,
-	0x6d, 0x4f, 0xcc, 0xb1, 0x72, 0xf4, 0x18, 0x8f, 0x00, 0x4d, 0x4a, 0xb2, 0xbb, 0x08, 0xc1, 0xbd,
-	0x01, 0xf6, 0x69, 0xdc, 0x34, 0x88, 0x21, 0x8f, 0xc2, 0xc9, 0x09, 0x91, 0xbb, 0xac, 0x64, 0xc9,
-	0x91, 0xf9, 0x59, 0x01, 0x96, 0x0e, 0x87, 0xfd, 0xbe, 0xbc, 0xb2, 0x7f, 0xb9, 0x2c, 0xa7, 0x96,
-	0x2f, 0x4e, 0x5b, 0xbe, 0x94, 0x5e, 0x3e, 0x49, 0x42, 0x39, 0x5d, 0xd9, 0x72, 0xa0, 0x30, 0x7f,
-	0x09, 0x28, 0x54, 0xce, 0x87, 0x42, 0x35, 0x0d, 0x05, 0xf3, 0x57, 0x1a, 0xa0, 0x74, 0x10, 0x64,
-	0xc6, 0x6f, 0xc2, 0x82, 0x8f, 0x3f, 0x65, 0x05, 0x36, 0x1d, 0x52, 0x9d, 0xd1, 0x3a, 0xd2, 0xaf,
-	0x1b, 0xc0, 0x87, 0x76, 0x26, 0xb6, 0xc0, 0x48, 0x07, 0xc2, 0xc1, 0xdb, 0x50, 0xc1, 0x3e, 0x8d,
-	0xbc, 0xb8, 0x7c, 0x2e, 0x88, 0x1b, 0x95, 0xa8, 0x2a, 0x96, 0x62, 0xa2, 0x97, 0x40, 0x0f, 0x86,
-	0x4c, 0x8f, 0x4d, 0x46, 0x7e, 0x57, 0xbe, 0x3b, 0xd4, 0x82, 0x21, 0x3d, 0x38, 0xe9, 0x8c, 0xfc,
-	0xae, 0xf9, 0x04, 0x50, 0xfb, 0x39, 0xee, 0x9e, 0x8a, 0xa4, 0x7f, 0xb9, 0x3c, 0x99, 0x9f, 0x69,
-	0xb0, 0x9c, 0xd1, 0x26, 0x1d, 0x9e, 0xd1, 0x74, 0xbe, 0x0a, 0x8b, 0xd8, 0x89, 0xfa, 0x1e, 0x26,
-	0x49, 0x3c, 0x84, 0xd6, 0xa6, 0xa2, 0xab, 0x98, 0xdc, 0x82, 0x46, 0xdf, 0xa1, 0x69, 0x41, 0x01,
-	0x86, 0xba, 0xa0, 0x4a, 0x31, 0xf3, 0x67, 0x45, 0x68, 0xee, 0x60, 0xd2, 0x8d, 0xbc, 0xe3, 0x18,
-	0x77, 0x07, 0xb0, 0xe4, 0x62, 0xd2, 0x15, 0xad, 0x4b, 0x17, 0xfb, 0x14, 0x47, 0x44, 0x9e, 0x1e,
-	0x2f, 0x8b, 0x4a, 0x99, 0x91, 0xe7, 0x63, 0xd6, 0xbd, 0xb4, 0x85, 0xa8, 0xd5, 0x74, 0xb3, 0x04,
-	0xf4, 0x18, 0x1a, 0x5c, 0xa1, 0x8a, 0x8a, 0xda, 0x80, 0x37, 0xa7, 0x69, 0x7b, 0xa2, 0x04, 0xad,
-	0xba, 0x9b, 0x1e, 0xa2, 0x6d, 0x58, 0xe0, 0x9a, 0xd4, 0x83, 0x99, 0xa8, 0xdf, 0x37, 0xa6, 0xe9,
-	0x51, 0x8f, 0x68, 0xba, 0x9b, 0x0c, 0x52, 0x3a, 0x3c, 0xec, 0x53, 0x22, 0x2b, 0xfb, 0x0c, 0x1d,
-	0x5c, 0x4c, 0xe9, 0xe0, 0x03, 0x63, 0x49, 0x44, 0x2d, 0xe5, 0xa4, 0xd1, 0x64, 0x7d, 0x56, 0xca,
-	0x56, 0xe3, 0x55, 0xd0, 0x53, 0x36, 0xcc, 0x42, 0x89, 0x51, 0x57, 0xa2, 0x5c, 0xbb, 0xf9, 0xcb,
-	0x79, 0x58, 0x4c, 0x4c, 0x91, 0xb0, 0xd8, 0x87, 0xc5, 0xf1, 0xac, 0xe4, 0x27, 0x45, 0x96, 0xb0,
-	0xac, 0x7d, 0x56, 0x23, 0x9b, 0x14, 0xb4, 0x37, 0x25, 0x27, 0xe6, 0x54, 0x65, 0x53, 0x93, 0xd2,
-	0xce, 0x4d, 0xca, 0xfa, 0x54, 0x45, 0xb9, 0x59, 0xe1, 0x67, 0x13, 0x7f, 0xde, 0x15, 0xaf, 0xd3,
-	0xf1, 0x45, 0x9d, 0xd1, 0xf8, 0xf3, 0xb4, 0xf1, 0x3b, 0x0d, 0x1a, 0x59, 0xaf, 0xd0, 0x01, 0xe8,
-	0x93, 0xf1, 0x68, 0x5d, 0x20, 0x1e, 0xad, 0x54, 0x68, 0xc0, 0x8d, 0x7f, 0x1b, 0x8f, 0x01, 0x52,
-	0xea, 0x1f, 0x42, 0x33, 0xfb, 0xd2, 0xa5, 0x2e, 0x9d, 0x39, 0x4f, 0x5d, 0x8d, 0xcc, 0x53, 0x17,
-	0x31, 0xfe, 0xa6, 0x8d, 0x01, 0x02, 0xed, 0xf1, 0xee, 0x54, 0x46, 0x5b, 0x1c, 0x5d, 0x77, 0xce,
-	0x8f, 0x76, 0x4b, 0xfd, 0xb2, 0x92, 0xd9, 0x46, 0x04, 0x55, 0x45, 0x3e, 0xef, 0xba, 0x2c, 0xb3,
-	0x92, 0xb9, 0x2e, 0xab, 0x0c, 0xc4, 0xcc, 0x89, 0xf0, 0x17, 0x27, 0xc3, 0xff, 0x63, 0x2d, 0x0b,
-	0xe8, 0x0b, 0xbe, 0x5b, 0xb7, 0x64, 0xfd, 0x56, 0xb2, 0x85, 0x49, 0x59, 0x5e, 0xbd, 0xa7, 0x01,
-	0x61, 0xd2, 0x12, 0xf3, 0x8f, 0x1a, 0xac, 0xb4, 0x23, 0xec, 0x50, 0xac, 0x34, 0xe4, 0x54, 0xe2,
-	0xc2, 0xe4, 0xa3, 0xf2, 0xff, 0xf6, 0x49, 0x8c, 0xf5, 0x72, 0x34, 0xa0, 0x4e, 0xdf, 0xce, 0x3c,
-	0x13, 0x8a, 0x33, 0xb4, 0xc9, 0x39, 0x3b, 0xc9, 0x5b, 0xa1, 0x7a, 0x61, 0x9c, 0x4f, 0x5e, 0x18,
-	0xcd, 0x23, 0x58, 0x1d, 0x73, 0x43, 0xee, 0xf5, 0x15, 0x28, 0xe3, 0x28, 0x0a, 0x22, 0x99, 0x4f,
-	0x31, 0x48, 0x07, 0xbc, 0x30, 0x3d, 0xe0, 0xe6, 0x26, 0xac, 0x88, 0x5e, 0xf6, 0xe2, 0xc1, 0x31,
-	0xef, 0xc1, 0xea, 0xd8, 0x9c, 0x59, 0x96, 0x98, 0x6f, 0xc0, 0x6a, 0x3b, 0x18, 0x84, 0x4e, 0x97,
-	0x5e, 0x62, 0x8d, 0x16, 0x5c, 0x19, 0x9f, 0x34, 0x73, 0x91, 0xef, 0x03, 0xb2, 0x70, 0xd8, 0x77,
-	0xba, 0x98, 0x3f, 0x90, 0x5f, 0x20, 0xc5, 0x57, 0xa1, 0xe2, 0x07, 0x2e, 0x4e, 0x9e, 0x01, 0xe7,
-	0xd9, 0x70, 0xcf, 0x15, 0x0d, 0xc2, 0xd9, 0xd8, 0x0b, 0x30, 0xf8, 0xf8, 0x4c, 0xbe, 0xff, 0x9a,
-	0x77, 0x60, 0x39, 0xb3, 0xd6, 0x4c, 0xc3, 0xfe, 0xa2, 0x01, 0x12, 0x79, 0xe3, 0x2d, 0xd0, 0x45,
-	0xda, 0x80, 0x99, 0xcf, 0x97, 0x5f, 0x09, 0x32, 0x45, 0x0b, 0x91, 0x87, 0x4c, 0xce, 0x49, 0x90,
-	0xc9, 0x7c, 0xcf, 0x78, 0x73, 0x5e, 0xe6, 0x05, 0x50, 0xe2, 0xaa, 0x74, 0xbe, 0xf7, 0x2c, 0xf3,
-	0xe3, 0x93, 0x66, 0x2e, 0xf2, 0x66, 0x8c, 0x94, 0xcb, 0xac, 0xf2, 0x3a, 0x5c, 0x9d, 0x98, 0x35,
-	0x73, 0x99, 0xdf, 0x6a, 0x70, 0xdd, 0x92, 0xb1, 0xe3, 0x79, 0x3f, 0x8c, 0x70, 0xe8, 0x44, 0xf8,
-	0xeb, 0x97, 0x50, 0xf3, 0x4d, 0x78, 0x21, 0xdf, 0xd2, 0x99, 0x0e, 0x3e, 0x00, 0x23, 0x33, 0xab,
-	0x1d, 0x0c, 0x06, 0x1e, 0xbd, 0x48, 0x2c, 0xdf, 0x18, 0x8b, 0x8c, 0x9a, 0x39, 0x73, 0xb9, 0x6f,
-	0x8d, 0x4f, 0xea, 0x63, 0xc7, 0x1f, 0x86, 0x17, 0x59, 0x6f, 0xdc, 0xbf, 0x78, 0xea, 0xcc, 0x05,
-	0xff, 0xae, 0xc1, 0x9a, 0xf8, 0x13, 0xf0, 0xeb, 0xbd, 0x1d, 0x2f, 0x79, 0x59, 0x36, 0xff, 0x1f,
-	0xae, 0xe5, 0xb8, 0x35, 0x33, 0x14, 0x0e, 0x2b, 0x60, 0x7c, 0xca, 0x45, 0x73, 0x7c, 0xd9, 0x7f,
-	0x41, 0xcd, 0xbb, 0xb0, 0x92, 0x5d, 0x62, 0xa6, 0x41, 0xc7, 0xb1, 0xf4, 0x85, 0x51, 0x70, 0x69,
-	0x8b, 0xee, 0xc1, 0xea, 0xd8, 0x1a, 0x33, 0x4d, 0xfa, 0x18, 0xea, 0xf2, 0x0f, 0xee, 0x0b, 0x9c,
-	0x25, 0x53, 0x6c, 0x29, 0x4e, 0xb3, 0xe5, 0x36, 0x34, 0x94, 0xf2, 0x59, 0x46, 0xbc, 0xb6, 0x07,
-	0xf5, 0xcc, 0xeb, 0x2f, 0xaa, 0x41, 0x79, 0xfb, 0xa3, 0xa3, 0xdd, 0xce, 0xe2, 0x1c, 0xd2, 0xa1,
-	0xf2, 0xe8, 0xe9, 0xc1, 0xd6, 0xd1, 0x37, 0xdf, 0x5c, 0xd4, 0x50, 0x13, 0xf4, 0xfd, 0xad, 0x0f,
-	0x6d, 0x45, 0x28, 0x70, 0xc2, 0xde, 0xb3, 0x98, 0x50, 0xdc, 0xfc, 0x43, 0x09, 0xf4, 0x0f, 0x1c,
-	0x42, 0x83, 0x7d, 0x87, 0x77, 0x4e, 0x6f, 0x33, 0xff, 0x7a, 0x1e, 0x37, 0x89, 0xf5, 0xa2, 0x08,
-	0xc5, 0x5d, 0x6a, 0xfc, 0xe1, 0x83, 0xb1, 0x18, 0xd3, 0xd4, 0xc7, 0x16, 0x73, 0x1b, 0xda, 0x7d,
-	0x0d, 0x7d, 0x9b, 0xd9, 0x2f, 0x26, 0x8b, 0x6b, 0x08, 0x5a, 0xce, 0xf9, 0x6e, 0xc2, 0x58, 0x9a,
-	0xf8, 0x68, 0x40, 0xce, 0x7f, 0x0b, 0xaa, 0xaa, 0x8f, 0x15, 0x33, 0xc7, 0xee, 0x52, 0xc6, 0x4a,
-	0x5e, 0xab, 0x6b, 0xce, 0xa1, 0x47, 0x50, 0xcf, 0x34, 0x41, 0x48, 0x7c, 0x97, 0x90, 0xd3, 0xde,
-	0x19, 0xd7, 0x72, 0x38, 0x69, 0x3d, 0x99, 0x16, 0x46, 0xe8, 0xc9, 0xeb, 0x84, 0x84, 0x9e, 0xdc,
-	0x7e, 0xc7, 0x9c, 0x63, 0x17, 0xa3, 0x6c, 0x9b, 0x82, 0xc4, 0xb2, 0x79, 0xfd, 0x8e, 0x61, 0xe4,
-	0xb1, 0x62, 0x55, 0x0f, 0x14, 0xe0, 0x94, 0xa6, 0x25, 0xf9, 0xa7, 0x53, 0x82, 0x41, 0x03, 0xa5,
-	0x49, 0xf1, 0xcc, 0x77, 0x41, 0x4f, 0xf5, 0x23, 0xe8, 0x8a, 0x10, 0x1a, 0x6f, 0x86, 0x8c, 0xab,
-	0x13, 0xf4, 0x58, 0xc3, 0x2d, 0xd6, 0xac, 0x1f, 0x0f, 0x7b, 0x12, 0x1b, 0x35, 0x26, 0xc9, 0xff,
-	0xfa, 0x33, 0x92, 0x9f, 0xe6, 0xdc, 0xe6, 0x9f, 0x2b, 0x00, 0x1c, 0x43, 0x02, 0x31, 0x8f, 0xa1,
-	0x9e, 0x79, 0x10, 0x13, 0x41, 0xcc, 0x7b, 0x83, 0x14, 0x41, 0xcc, 0x7d, 0x3d, 0x33, 0xe7, 0xee,
-	0x6b, 0xe8, 0x1d, 0x80, 0x23, 0xc7, 0xeb, 0x8b, 0xb7, 0x0d, 0xb4, 0x2a, 0x9e, 0x61, 0xc7, 0x5e,
-	0xb8, 0x8c, 0x2b, 0xe3, 0xe4, 0x94, 0x82, 0x77, 0x41, 0x4f, 0xbd, 0x8e, 0x88, 0x10, 0x4c, 0x3e,
-	0xbe, 0x88, 0x10, 0xe4, 0x3c, 0xa3, 0x88, 0x20, 0xa6, 0x0a, 0xa8, 0xd4, 0x30, 0x71, 0x50, 0x48,
-	0x0d, 0x93, 0x95, 0x56, 0x60, 0x21, 0xdb, 0xb8, 0xa0, 0x14, 0x74, 0xc6, 0x7a, 0x13, 0x81, 0x85,
-	0xfc, 0x3e, 0xc7, 0x9c, 0x43, 0x4f, 0xa1, 0x39, 0xd6, 0x9d, 0xa0, 0x34, 0x78, 0xc6, 0x95, 0x5d,
-	0xcf, 0xe5, 0xc5, 0xda, 0x3e, 0x66, 0xd5, 0x75, 0xb2, 0x1f, 0x40, 0x37, 0x14, 0x20, 0xa6, 0xf4,
-	0x34, 0xc6, 0xfa, 0x74, 0x81, 0x58, 0xf9, 0x87, 0xa2, 0x19, 0x1e, 0x3b, 0xfc, 0xd1, 0x4b, 0x13,
-	0x53, 0x33, 0x67, 0x8d, 0x71, 0x63, 0x2a, 0x7f, 0xaa, 0xd9, 0xb2, 0x6e, 0xe7, 0x98, 0x9d, 0x3d,
-	0x35, 0x72, 0xcc, 0x1e, 0x2b, 0xf9, 0xe6, 0x1c, 0x7a, 0xa6, 0x76, 0x9b, 0x0a, 0xc6, 0x0b, 0xc9,
-	0xd6, 0xca, 0x49, 0xfb, 0x8b, 0x53, 0xb8, 0xb1, 0xbe, 0x36, 0x2c, 0xa4, 0xcf, 0x3b, 0x74, 0x35,
-	0x35, 0x21, 0xe3, 0xf8, 0xda, 0x24, 0x23, 0x5d, 0x95, 0x32, 0x47, 0x14, 0x4a, 0x0b, 0x67, 0x7d,
-	0xbc, 0x96, 0xc3, 0x89, 0xf5, 0xfc, 0x1f, 0x00, 0xdf, 0xce, 0x62, 0x9b, 0x4e, 0xd9, 0xcd, 0xdb,
-	0x2f, 0x42, 0xd5, 0x0b, 0x5a, 0xfc, 0x53, 0xc1, 0x6d, 0xb1, 0xad, 0x0f, 0xa3, 0x80, 0x06, 0x87,
-	0xda, 0xaf, 0x0b, 0x85, 0x0f, 0x3a, 0xc7, 0xf3, 0xfc, 0xf3, 0xc1, 0x37, 0xfe, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0xad, 0xac, 0x3e, 0xc9, 0x4d, 0x28, 0x00, 0x00,
+	// 6534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x3c, 0x4d, 0x8f, 0x24, 0xc9,
+	0x55, 0x9b, 0x59, 0xdf, 0xaf, 0x3e, 0x3b, 0xba, 0x67, 0xba, 0xa7, 0xf6, 0x63, 0x7a, 0x73, 0x77,
+	0x76, 0x67, 0x76, 0x67, 0xdb, 0xeb, 0xd9, 0xc1, 0xbb, 0x1e, 0x6b, 0x3f, 0xfa, 0xa3, 0x66, 0xa6,
+	0x67, 0xfa, 0xcb, 0x59, 0x3d, 0x6b, 0xaf, 0x8c, 0x94, 0xca, 0xaa, 0x8a, 0xae, 0xce, 0xed, 0xaa,
+	0xcc, 0x22, 0x33, 0x6b, 0x66, 0xda, 0x07, 0x83, 0x6c, 0x81, 0x40, 0x02, 0x84, 0x84, 0x10, 0x8b,
+	0x41, 0x08, 0x01, 0x96, 0x38, 0x20, 0x2e, 0x16, 0x27, 0x23, 0xf8, 0x03, 0x96, 0x11, 0xe6, 0x06,
+	0x17, 0x23, 0x4e, 0x1c, 0xe0, 0xe2, 0x0b, 0x48, 0x1c, 0x50, 0x7c, 0x65, 0x46, 0x7e, 0x54, 0x76,
+	0xf5, 0x7c, 0x20, 0xdf, 0x32, 0xde, 0x7b, 0x11, 0xf1, 0xe2, 0xc5, 0x8b, 0xf7, 0x5e, 0x44, 0xbc,
+	0x48, 0xa8, 0x3e, 0x34, 0x3d, 0xdf, 0x59, 0x9b, 0xb8, 0x8e, 0xef, 0x20, 0x75, 0xd2, 0xd3, 0xfe,
+	0x55, 0x81, 0xc6, 0x86, 0x39, 0x32, 0xed, 0x3e, 0xd6, 0xf1, 0xaf, 0x4c, 0xb1, 0xe7, 0xa3, 0xcb,
+	0x50, 0xf5, 0x7c, 0xc7, 0xc5, 0xc6, 0xd0, 0x75, 0xa6, 0x93, 0x15, 0x75, 0x55, 0xb9, 0x5a, 0xd1,
+	0x81, 0x82, 0xee, 0x10, 0x48, 0x48, 0xd0, 0x77, 0xa6, 0xb6, 0xbf, 0x92, 0x5b, 0x55, 0xae, 0xd6,
+	0x39, 0xc1, 0x26, 0x81, 0x10, 0x82, 0x81, 0xe9, 0x9b, 0x46, 0x1f, 0xdb, 0x3e, 0x76, 0x57, 0xf2,
+	0xac, 0x05, 0x02, 0xda, 0xa4, 0x10, 0xb4, 0x0c, 0xa5, 0x81, 0x7b, 0x6a, 0xb8, 0x53, 0x7b, 0xa5,
+	0xb0, 0xaa, 0x5c, 0x2d, 0xeb, 0xc5, 0x81, 0x7b, 0xaa, 0x4f, 0x6d, 0xf4, 0x22, 0x54, 0xc6, 0xe6,
+	0x63, 0x63, 0xec, 0x3c, 0xc4, 0xde, 0x4a, 0x91, 0x36, 0x5c, 0x1e, 0x9b, 0x8f, 0x77, 0x49, 0x19,
+	0xbd, 0x0b, 0x4b, 0x04, 0x61, 0x58, 0xa4, 0x8d, 0x87, 0xe6, 0xc8, 0xf0, 0x70, 0xdf, 0xb1, 0x07,
+	0x2b, 0x25, 0x4a, 0x87, 0x08, 0x6e, 0x9b, 0xa3, 0xba, 0x14, 0xa3, 0x9d, 0x40, 0x33, 0x18, 0x9c,
+	0x37, 0x71, 0x6c, 0x0f, 0xa3, 0xd7, 0xa0, 0xc0, 0x5a, 0x57, 0x56, 0x73, 0x57, 0xab, 0x37, 0xea,
+	0x6b, 0x93, 0xde, 0x5a, 0xf7, 0xd8, 0x74, 0x07, 0xa4, 0x0f, 0x9d, 0xe1, 0xd0, 0xcb, 0x00, 0x03,
+	0xc7, 0xc6, 0x9c, 0x0f, 0x95, 0xb6, 0x5f, 0x21, 0x10, 0xc6, 0xc8, 0x12, 0x14, 0xb0, 0xeb, 0x3a,
+	0x2e, 0x1d, 0x7a, 0x45, 0x67, 0x05, 0xed, 0x07, 0x0a, 0x54, 0x82, 0x96, 0x50, 0x1b, 0xca, 0x27,
+	0xf8, 0xd4, 0x9b, 0x98, 0x7d, 0xbc, 0xa2, 0x50, 0xb2, 0xa0, 0x4c, 0x46, 0xe9, 0x61, 0xf7, 0x21,
+	0x76, 0x0d, 0x6b, 0xc0, 0x5b, 0x2f, 0x33, 0xc0, 0xf6, 0x00, 0xbd, 0x0a, 0xb5, 0x23, 0xd7, 0x19,
+	0x1b, 0xe6, 0x60, 0xe0, 0x62, 0xcf, 0xe3, 0x7d, 0x54, 0x09, 0x6c, 0x9d, 0x81, 0x08, 0x7b, 0xbe,
+	0x13, 0x10, 0x30, 0xf1, 0x56, 0x7c, 0x47, 0x42, 0x7b, 0xd6, 0xb7, 0xb1, 0xd1, 0x3b, 0xf5, 0xb1,
+	0x47, 0x05, 0x9c, 0xd7, 0x2b, 0x04, 0xb2, 0x41, 0x00, 0xda, 0xcf, 0x73, 0xd0, 0xe8, 0x92, 0xc9,
+	0xba, 0x8b, 0x4d, 0xd7, 0xef, 0x61, 0xd3, 0x47, 0x1f, 0x40, 0x83, 0xcd, 0xa8, 0x8b, 0x3d, 0x67,
+	0xea, 0x72, 0x96, 0xab, 0x37, 0x16, 0xa8, 0x74, 0x08, 0x46, 0xe7, 0x08, 0xbd, 0xee, 0xc9, 0x45,
+	0xf4, 0x36, 0x1f, 0xf3, 0xb6, 0x7d, 0xe4, 0xd0, 0xa1, 0xc8, 0x22, 0x25, 0x40, 0x3d, 0xc4, 0xa3,
+	0x75, 0x58, 0x10, 0x32, 0x30, 0x3c, 0xec, 0xfb, 0x96, 0x3d, 0x24, 0xe3, 0x23, 0xf3, 0xb0, 0x44,
+	0x2a, 0xdd, 0xe7, 0xc8, 0x2e, 0xc7, 0xe9, 0xad, 0x93, 0x18, 0x04, 0x7d, 0x08, 0x2d, 0x17, 0x4f,
+	0x46, 0x56, 0xdf, 0xf4, 0x2d, 0xc7, 0x36, 0x46, 0xe6, 0x90, 0x08, 0x80, 0xb4, 0x80, 0x48, 0x0b,
+	0x7a, 0x88, 0xdb, 0x31, 0x87, 0x7a, 0xd3, 0x8d, 0x94, 0x3d, 0xf4, 0x11, 0x2c, 0x78, 0x84, 0x1d,
+	0x63, 0x60, 0x79, 0x27, 0xc6, 0xd4, 0x33, 0x87, 0x54, 0x42, 0x41, 0x7d, 0xca, 0xeb, 0x96, 0xe5,
+	0x9d, 0x3c, 0x20, 0x28, 0xbd, 0xe9, 0x45, 0xca, 0x1e, 0xba, 0x0b, 0x4b, 0x3d, 0xc7, 0xf1, 0x3d,
+	0xdf, 0x35, 0x27, 0xc6, 0xc4, 0x75, 0x86, 0x44, 0xe0, 0x54, 0x55, 0x49, 0x13, 0x17, 0x48, 0x13,
+	0x1b, 0x02, 0x7f, 0xc0, 0xd1, 0xfa, 0x62, 0x2f, 0x0e, 0xc2, 0x1e, 0x5a, 0x83, 0xea, 0x91, 0x33,
+	0x1a, 0x39, 0x8f, 0xd8, 0x18, 0x4a, 0xa1, 0x36, 0xde, 0xa6, 0x60, 0xc2, 0x3e, 0x1c, 0x89, 0x4f,
+	0xc2, 0x79, 0x20, 0x0c, 0xc3, 0xb2, 0x07, 0xf8, 0x31, 0xf6, 0x56, 0xca, 0xb4, 0xd2, 0xa2, 0x2c,
+	0xba, 0x6d, 0x86, 0xd2, 0x9b, 0x27, 0x51, 0x80, 0xb6, 0x05, 0x35, 0x3a, 0x91, 0xbb, 0xd8, 0x23,
+	0x43, 0x41, 0x37, 0xa1, 0xe9, 0xe2, 0xb1, 0xe3, 0x63, 0xa3, 0x3f, 0x9a, 0x7a, 0x3e, 0x76, 0xc5,
+	0x8a, 0xa8, 0x92, 0xe6, 0x36, 0x19, 0x4c, 0x6f, 0x30, 0x1a, 0x5e, 0xf4, 0xb4, 0xef, 0x29, 0xd0,
+	0x88, 0xca, 0xe8, 0xc9, 0x15, 0xfd, 0x12, 0x94, 0xd9, 0x5c, 0x58, 0x03, 0x6e, 0x43, 0x4a, 0xb4,
+	0xbc, 0x3d, 0x88, 0x69, 0x70, 0x3e, 0xae, 0xc1, 0xff, 0xae, 0xc0, 0x42, 0x42, 0xcc, 0xcf, 0x85,
+	0x91, 0x8b, 0x50, 0xe4, 0x0b, 0x82, 0xad, 0x32, 0x5e, 0x42, 0x57, 0xa0, 0xd1, 0x77, 0x26, 0x16,
+	0x1e, 0x18, 0xd8, 0xf6, 0x5d, 0x2b, 0x58, 0x66, 0x75, 0x06, 0xed, 0x30, 0x20, 0x59, 0xcb, 0x9c,
+	0x8c, 0x8d, 0xa4, 0x48, 0x89, 0xaa, 0x0c, 0x46, 0xc7, 0x82, 0x56, 0xa0, 0xe4, 0x62, 0xd6, 0x04,
+	0xb3, 0x63, 0xa2, 0xa8, 0xfd, 0xa6, 0x02, 0x95, 0x40, 0x17, 0x9e, 0xcb, 0xe8, 0xde, 0x84, 0xe6,
+	0xc8, 0x1c, 0x1a, 0x63, 0x6b, 0x34, 0xb2, 0xb8, 0x2d, 0x25, 0xc3, 0xcc, 0xe9, 0x8d, 0x91, 0x39,
+	0xdc, 0x0d, 0xa1, 0xda, 0x8f, 0x15, 0x68, 0x44, 0x97, 0x56, 0x26, 0x3f, 0x72, 0x97, 0x6a, 0xb4,
+	0xcb, 0xeb, 0x80, 0x98, 0x08, 0x0d, 0xd9, 0x43, 0x30, 0x1b, 0xd7, 0x62, 0x98, 0xad, 0xd0, 0x4f,
+	0x5c, 0x07, 0xe4, 0x9b, 0xee, 0x10, 0xfb, 0x46, 0xd2, 0x9f, 0xb4, 0x18, 0x46, 0xa2, 0x4e, 0x19,
+	0x4e, 0x21, 0x75, 0x38, 0x3f, 0x52, 0xa1, 0xb9, 0x39, 0xb2, 0xb0, 0xed, 0x87, 0x26, 0xf0, 0x32,
+	0x54, 0xfb, 0x14, 0x64, 0xd8, 0xe6, 0x18, 0x0b, 0xaf, 0xc7, 0x40, 0x7b, 0xe6, 0x18, 0xa3, 0x7d,
+	0x68, 0xf0, 0x95, 0x62, 0xb0, 0x65, 0x49, 0xb9, 0xae, 0xde, 0xb8, 0xca, 0xd6, 0x4b, 0xa4, 0x35,
+	0xb1, 0x7e, 0xd8, 0xf4, 0xf1, 0x25, 0xa7, 0xd7, 0xfb, 0x32, 0xb4, 0xfd, 0xb7, 0x0a, 0x2c, 0xa5,
+	0xd1, 0x65, 0x8a, 0xf6, 0x32, 0x54, 0x2d, 0xcf, 0x98, 0xda, 0x9c, 0x05, 0x95, 0x7a, 0x4f, 0xb0,
+	0xbc, 0x07, 0x1c, 0x12, 0xf7, 0xbd, 0xb9, 0x84, 0xef, 0xfd, 0x18, 0x5e, 0x1a, 0x58, 0x9e, 0xd9,
+	0x1b, 0x45, 0xa6, 0xc0, 0x38, 0x32, 0x47, 0xa3, 0x9e, 0xd9, 0x3f, 0xa1, 0xd2, 0x2d, 0xeb, 0x97,
+	0x38, 0x4d, 0x28, 0xde, 0xdb, 0x9c, 0x40, 0xfb, 0x7e, 0x01, 0xea, 0x6c, 0xbc, 0x82, 0xe1, 0x2b,
+	0x50, 0xe2, 0x43, 0xe3, 0x7e, 0x23, 0x62, 0x43, 0x04, 0x0e, 0x7d, 0x0c, 0xa5, 0xe9, 0x64, 0x60,
+	0xfa, 0xdc, 0xa5, 0x56, 0x6f, 0x5c, 0x09, 0x45, 0xc7, 0x9b, 0x8a, 0x3a, 0x9b, 0x07, 0x94, 0x5a,
+	0x17, 0xb5, 0xd0, 0xbb, 0x50, 0x74, 0x31, 0x31, 0x03, 0x5c, 0xf4, 0x2b, 0xc9, 0xfa, 0x3a, 0xc5,
+	0xeb, 0x9c, 0x0e, 0x61, 0xb8, 0x24, 0xbb, 0x8b, 0x23, 0xb3, 0xef, 0x3b, 0xae, 0xd1, 0x3f, 0x36,
+	0xed, 0x21, 0x5b, 0xd2, 0xd5, 0x1b, 0xd7, 0xd2, 0x1a, 0x09, 0xaa, 0xdc, 0xa6, 0x35, 0x36, 0x69,
+	0x05, 0x7d, 0xd9, 0x4d, 0x47, 0xb4, 0xbf, 0x50, 0x60, 0x31, 0x85, 0x73, 0x74, 0x05, 0x0a, 0xb6,
+	0x33, 0x08, 0x82, 0x8d, 0xa6, 0x24, 0x96, 0x3d, 0x67, 0x80, 0x75, 0x86, 0x25, 0xeb, 0xd7, 0xf2,
+	0x8c, 0x01, 0x1e, 0x61, 0x1f, 0xf3, 0x29, 0x2d, 0x5b, 0xde, 0x16, 0x2d, 0x47, 0xb4, 0x21, 0x17,
+	0xd3, 0x86, 0x57, 0xa1, 0x66, 0x79, 0xc4, 0x0f, 0x8d, 0x1d, 0xc2, 0x13, 0x9f, 0xbb, 0xaa, 0xe5,
+	0x1d, 0x08, 0x50, 0xfb, 0x37, 0x14, 0x28, 0x32, 0xa1, 0x90, 0xf8, 0xa9, 0x3f, 0x75, 0x5d, 0xa2,
+	0xe3, 0x42, 0x93, 0xa9, 0x30, 0x15, 0x16, 0x3f, 0x71, 0x1c, 0xe7, 0xaf, 0x4b, 0x6a, 0xac, 0xc1,
+	0x22, 0x5f, 0x7f, 0x91, 0x0a, 0x6c, 0x4d, 0x2f, 0x30, 0x94, 0x4c, 0x9f, 0xc1, 0x6b, 0x7b, 0x00,
+	0xcb, 0x33, 0xe4, 0x8a, 0xde, 0x01, 0x94, 0x9c, 0x25, 0xce, 0xd6, 0x42, 0x42, 0xe6, 0x91, 0x5e,
+	0xd4, 0x68, 0x2f, 0xda, 0x5f, 0xa8, 0x50, 0xe2, 0x1c, 0x65, 0xae, 0xa3, 0x60, 0x66, 0x72, 0x99,
+	0x33, 0x73, 0x03, 0x2e, 0xe0, 0xc7, 0x13, 0xdc, 0xf7, 0xf1, 0x20, 0x2a, 0x82, 0x3c, 0x65, 0x6e,
+	0x51, 0x20, 0x65, 0x21, 0xcc, 0x12, 0x73, 0x61, 0xa6, 0x98, 0xd3, 0xc7, 0x5f, 0x9c, 0x35, 0xfe,
+	0xd8, 0x12, 0x2f, 0x25, 0x96, 0x38, 0x09, 0xd0, 0xa9, 0xfd, 0x65, 0x01, 0x7a, 0x99, 0x07, 0xe8,
+	0x04, 0x44, 0x03, 0x74, 0x6d, 0x0a, 0x55, 0x69, 0xb0, 0x4f, 0x11, 0xfe, 0x5d, 0x07, 0xe0, 0x96,
+	0x7e, 0x76, 0xfc, 0xe7, 0x89, 0x4f, 0xed, 0xf7, 0x55, 0xa8, 0x47, 0x9a, 0x23, 0xde, 0xcf, 0xc6,
+	0xfe, 0x23, 0xc7, 0x3d, 0xe1, 0x33, 0x29, 0x8a, 0x04, 0x13, 0x8d, 0x80, 0x45, 0x11, 0xbd, 0x06,
+	0x75, 0x73, 0x30, 0xb6, 0xec, 0x58, 0x00, 0x5c, 0xa3, 0x40, 0x11, 0x03, 0x23, 0xc8, 0xfb, 0x22,
+	0xae, 0xaa, 0xe8, 0xf4, 0x1b, 0xad, 0x42, 0x8d, 0x86, 0x7d, 0x34, 0xb4, 0x18, 0xf6, 0x84, 0x5c,
+	0x08, 0x8c, 0x4c, 0xc3, 0x9d, 0x1e, 0x7a, 0x0b, 0x16, 0xcc, 0xd1, 0xc8, 0xe9, 0x9b, 0x64, 0xbe,
+	0x05, 0x59, 0x85, 0x92, 0x35, 0x03, 0x04, 0xa7, 0x8d, 0xcd, 0x02, 0x24, 0x66, 0x01, 0x41, 0xfe,
+	0xdb, 0x8e, 0x8d, 0x57, 0xaa, 0x14, 0x43, 0xbf, 0x09, 0xcc, 0x25, 0x46, 0xb6, 0xc6, 0x60, 0xe4,
+	0x5b, 0xfb, 0xfb, 0x1c, 0x2c, 0xed, 0x38, 0x7d, 0x73, 0x44, 0x65, 0xe6, 0x6d, 0xdb, 0x42, 0x7f,
+	0x1b, 0xa0, 0x5a, 0x03, 0xbe, 0x0c, 0x54, 0x6b, 0x80, 0x36, 0x81, 0xc9, 0xd2, 0x18, 0x9b, 0x64,
+	0x5b, 0x46, 0xf4, 0xf6, 0x0d, 0x22, 0xeb, 0xb4, 0xca, 0x7c, 0x4f, 0x63, 0x4e, 0x48, 0x28, 0x72,
+	0xaa, 0x33, 0x7f, 0xbc, 0x6b, 0x4e, 0x68, 0x48, 0x22, 0x6b, 0x25, 0x0b, 0x09, 0xaa, 0xfd, 0x33,
+	0xd5, 0x31, 0x3f, 0x4b, 0x1d, 0xdf, 0x81, 0x92, 0x08, 0x48, 0x0b, 0x61, 0x40, 0x4a, 0xe3, 0xce,
+	0x2d, 0x7c, 0x64, 0xd9, 0x16, 0xa1, 0xd5, 0x05, 0x0d, 0x7a, 0x17, 0xca, 0x41, 0xec, 0x5f, 0x5c,
+	0x55, 0x66, 0xc6, 0xfe, 0x01, 0x55, 0x5c, 0x9d, 0x4b, 0x71, 0x75, 0x46, 0x5f, 0x86, 0x0b, 0xbc,
+	0x75, 0x83, 0xb9, 0x8a, 0x81, 0x61, 0xfa, 0x86, 0xed, 0xd1, 0x19, 0xce, 0xeb, 0x88, 0x23, 0x99,
+	0x51, 0x1e, 0xac, 0xfb, 0x7b, 0x5e, 0xfb, 0x1e, 0xd4, 0x23, 0x12, 0x42, 0x2d, 0xc8, 0x9d, 0xe0,
+	0x53, 0x2e, 0x6d, 0xf2, 0x49, 0x76, 0x8a, 0x0f, 0xcd, 0xd1, 0x14, 0xa7, 0xab, 0x35, 0xc3, 0xdd,
+	0x52, 0x3f, 0x50, 0xb4, 0x7f, 0x52, 0xa0, 0x15, 0x67, 0x3f, 0xd3, 0xf8, 0x5c, 0x07, 0x34, 0xc0,
+	0x47, 0xe6, 0x74, 0xe4, 0x1b, 0xbe, 0x1f, 0x6c, 0x63, 0x99, 0x55, 0x6d, 0x71, 0xcc, 0xa1, 0xcf,
+	0x37, 0xb1, 0xe8, 0x75, 0x68, 0x90, 0x3d, 0xb1, 0x44, 0xc9, 0xe6, 0xac, 0x36, 0x36, 0x1f, 0x87,
+	0x54, 0xd7, 0xc8, 0xc6, 0xc8, 0xc7, 0x36, 0x9d, 0x32, 0x29, 0x98, 0xab, 0xeb, 0xcd, 0x00, 0xce,
+	0x49, 0x35, 0xa8, 0x47, 0xc5, 0xc4, 0x62, 0xd7, 0xea, 0x34, 0x94, 0x8f, 0xf6, 0x6b, 0x0a, 0x34,
+	0x63, 0x7b, 0x8a, 0xcc, 0x21, 0x49, 0x4a, 0xa0, 0xce, 0xa1, 0x04, 0x09, 0x16, 0x72, 0x49, 0x16,
+	0x7e, 0xa6, 0x40, 0x33, 0xd6, 0x00, 0x59, 0x3f, 0x34, 0x3c, 0x63, 0xdd, 0xd3, 0x6f, 0x74, 0x23,
+	0x88, 0xd1, 0x89, 0x04, 0x1b, 0x37, 0xda, 0x29, 0x3d, 0xaf, 0x75, 0x29, 0x45, 0x10, 0xbf, 0x5f,
+	0x84, 0xa2, 0x73, 0x74, 0xe4, 0x61, 0x71, 0x7a, 0xc1, 0x4b, 0x04, 0x3e, 0xc2, 0xf6, 0xd0, 0x3f,
+	0xe6, 0xb2, 0xe3, 0x25, 0xe2, 0xa1, 0x3f, 0xf7, 0x1c, 0xdb, 0x98, 0x98, 0xfe, 0x31, 0x15, 0x57,
+	0x45, 0x2f, 0x13, 0xc0, 0x81, 0xe9, 0x1f, 0x6b, 0x1f, 0x40, 0x91, 0x35, 0x8f, 0x9a, 0x50, 0xfd,
+	0x74, 0x7d, 0xe7, 0x41, 0xc7, 0xd8, 0xf8, 0xec, 0xb0, 0xd3, 0x6d, 0xbd, 0x80, 0xea, 0x50, 0xb9,
+	0xd7, 0xdd, 0xdf, 0x33, 0x0e, 0xd6, 0x0f, 0xef, 0xb6, 0x14, 0xd4, 0x00, 0xb8, 0xdf, 0xf9, 0xcc,
+	0x38, 0xd0, 0x3b, 0xb7, 0xb7, 0xbf, 0xd9, 0x52, 0xb5, 0x2f, 0x72, 0xd2, 0xf6, 0x99, 0x18, 0xb6,
+	0x60, 0x8b, 0x27, 0x8d, 0xb2, 0x26, 0x80, 0x34, 0x0c, 0x7d, 0xd2, 0x58, 0x3f, 0xbe, 0xee, 0xf3,
+	0xf3, 0xae, 0xfb, 0xc2, 0xac, 0x75, 0x7f, 0x1d, 0x8a, 0x9e, 0x6f, 0xfa, 0x53, 0xb6, 0x8c, 0x1b,
+	0x6c, 0x19, 0x07, 0xa3, 0x59, 0xeb, 0x52, 0x9c, 0xce, 0x69, 0x78, 0xa8, 0xd2, 0x37, 0xed, 0x81,
+	0x45, 0xa6, 0x78, 0xa5, 0x24, 0x42, 0x95, 0x4d, 0x01, 0x22, 0xd1, 0x06, 0x89, 0x66, 0xb0, 0x3b,
+	0x36, 0x6d, 0xe2, 0x3d, 0x79, 0x40, 0x54, 0xa6, 0x94, 0x0b, 0x96, 0x77, 0x20, 0x30, 0x3c, 0x32,
+	0x8a, 0xd9, 0x85, 0x4a, 0xc2, 0xcd, 0xdd, 0x82, 0x22, 0xe3, 0x02, 0x55, 0xa0, 0xd0, 0xd9, 0x3d,
+	0x38, 0xfc, 0x8c, 0x4d, 0xc9, 0xc6, 0xfe, 0xfe, 0x61, 0xf7, 0x50, 0x5f, 0x3f, 0x68, 0x29, 0x04,
+	0xa3, 0x77, 0xd6, 0xb7, 0x3e, 0x6b, 0xa9, 0xa8, 0x0a, 0xa5, 0xad, 0xce, 0x4e, 0xe7, 0xb0, 0xb3,
+	0xd5, 0xca, 0x69, 0x25, 0x28, 0x74, 0xc6, 0x13, 0xff, 0x54, 0xfb, 0x1d, 0x05, 0x6a, 0xf7, 0xf1,
+	0xe9, 0xe1, 0xe9, 0x04, 0x7f, 0x4a, 0x96, 0xbc, 0x6c, 0x29, 0x6a, 0xcc, 0x52, 0x5c, 0x81, 0xc6,
+	0xc4, 0x74, 0x7d, 0xaa, 0x69, 0xc6, 0xb1, 0xe9, 0x1d, 0xd3, 0x89, 0xc9, 0xeb, 0xf5, 0x00, 0x7a,
+	0xd7, 0xf4, 0x8e, 0xd1, 0x1a, 0x54, 0xa8, 0xc7, 0xf0, 0x4f, 0x27, 0xcc, 0xee, 0x36, 0x98, 0x87,
+	0xdd, 0x9f, 0xac, 0xdb, 0x03, 0x12, 0x68, 0x93, 0x3e, 0xf4, 0xf2, 0x80, 0x7f, 0x91, 0x63, 0x26,
+	0x66, 0x80, 0xf2, 0xb4, 0x2b, 0x56, 0xd0, 0xf6, 0xa1, 0xcc, 0x4f, 0xea, 0xb2, 0x57, 0xe4, 0x9b,
+	0x50, 0x76, 0x39, 0x1d, 0x5f, 0x92, 0x55, 0x76, 0x42, 0x42, 0x61, 0x7a, 0x80, 0xd4, 0xde, 0x87,
+	0x8a, 0x38, 0x1d, 0xf3, 0xd0, 0x5b, 0x50, 0x71, 0x45, 0x81, 0x47, 0xad, 0x35, 0x56, 0x8d, 0x01,
+	0xf5, 0x10, 0xad, 0x7d, 0x3f, 0x0f, 0x25, 0xde, 0x5c, 0x44, 0xf3, 0x94, 0xa8, 0xe6, 0xad, 0x42,
+	0x6e, 0x32, 0xf5, 0xb9, 0x15, 0x6d, 0x90, 0xc6, 0x0e, 0xa6, 0xbe, 0x60, 0x83, 0xa0, 0x08, 0xc5,
+	0x90, 0x2f, 0x45, 0x4e, 0x71, 0x07, 0x87, 0x14, 0x43, 0xec, 0xa3, 0x5b, 0x50, 0x27, 0x51, 0x68,
+	0xef, 0xd4, 0x98, 0xb8, 0xf8, 0xc8, 0x7a, 0xcc, 0x63, 0xf7, 0x8b, 0x9c, 0x76, 0xe3, 0xf4, 0x80,
+	0x82, 0x45, 0x9d, 0xea, 0x30, 0x84, 0xa1, 0x6b, 0x50, 0xe4, 0x9a, 0x54, 0x08, 0xa3, 0x1a, 0xa6,
+	0x42, 0x82, 0x9e, 0x13, 0xa0, 0x37, 0xa0, 0x30, 0xc6, 0xee, 0x10, 0x73, 0xc7, 0xd4, 0x22, 0x94,
+	0xbb, 0x04, 0x20, 0x08, 0x19, 0x1a, 0xbd, 0x06, 0x79, 0xaf, 0x6f, 0xda, 0x54, 0x89, 0x79, 0xf0,
+	0xd8, 0xed, 0x9b, 0xb6, 0xa0, 0xa2, 0x48, 0x74, 0x03, 0x2a, 0xe6, 0x70, 0xe8, 0xe2, 0xa1, 0xc9,
+	0x95, 0x98, 0x7b, 0xba, 0x75, 0x01, 0x14, 0xe4, 0x21, 0x19, 0xfa, 0x2a, 0xd4, 0xa8, 0x89, 0x34,
+	0x46, 0x8e, 0x73, 0x32, 0x9d, 0xac, 0x54, 0xc2, 0x61, 0x52, 0x8b, 0xb6, 0x43, 0xc1, 0xc1, 0x30,
+	0xad, 0x10, 0x86, 0xde, 0x03, 0xf0, 0x1c, 0x97, 0x06, 0x2e, 0xd8, 0x5f, 0x81, 0xb0, 0xbf, 0x2e,
+	0x85, 0x76, 0x43, 0x89, 0x56, 0x3c, 0x01, 0x41, 0x5f, 0x81, 0xaa, 0x6f, 0x8d, 0xb1, 0xe1, 0x61,
+	0x7a, 0x02, 0x51, 0x5d, 0x55, 0xc4, 0x31, 0xd6, 0xa1, 0x35, 0xc6, 0x5d, 0x0a, 0x15, 0xd5, 0xc0,
+	0x0f, 0x40, 0x64, 0xc6, 0x7c, 0x7f, 0xb4, 0x52, 0x0b, 0x67, 0xec, 0xd0, 0x1f, 0x05, 0x33, 0xe6,
+	0xfb, 0x23, 0xed, 0x5f, 0x14, 0x80, 0x70, 0x9e, 0x9f, 0x7c, 0xd1, 0xcc, 0xe1, 0x29, 0xe8, 0x79,
+	0x68, 0xe8, 0x1d, 0x99, 0x65, 0xab, 0xf8, 0x81, 0x6b, 0xbc, 0x05, 0x2d, 0x67, 0x62, 0x98, 0xf6,
+	0xc0, 0x08, 0x97, 0x5f, 0x61, 0xd6, 0xf2, 0xab, 0x3b, 0x72, 0x31, 0x5c, 0x83, 0x45, 0x79, 0x0d,
+	0x7e, 0x4f, 0x85, 0x9a, 0xac, 0x17, 0xcf, 0x77, 0x78, 0x69, 0xfc, 0xe7, 0xcf, 0xcb, 0x7f, 0x41,
+	0xe2, 0x9f, 0x30, 0x47, 0x15, 0xd9, 0x38, 0x9a, 0xda, 0x7d, 0xba, 0x73, 0x2c, 0x52, 0xeb, 0x51,
+	0xa7, 0xd0, 0xdb, 0x1c, 0x18, 0x93, 0x6b, 0x29, 0x26, 0x57, 0xed, 0x08, 0xea, 0xdf, 0x70, 0x2d,
+	0x3f, 0x3c, 0x5b, 0x6f, 0x80, 0xea, 0x9c, 0x50, 0x21, 0x94, 0x75, 0xd5, 0x39, 0xa1, 0xa7, 0x67,
+	0xcc, 0x43, 0xa8, 0xfc, 0xf4, 0x8c, 0x96, 0xd0, 0x3b, 0x50, 0x39, 0xc1, 0xa7, 0x06, 0x63, 0x2c,
+	0x17, 0x2e, 0x35, 0xd9, 0xcc, 0x52, 0x4b, 0x46, 0xbf, 0xb4, 0x11, 0xd4, 0x23, 0xcb, 0xf5, 0xb9,
+	0x4a, 0x5b, 0xeb, 0x00, 0x84, 0xd6, 0xe7, 0x89, 0xbb, 0xd2, 0x06, 0x50, 0xa5, 0xcd, 0x3c, 0x5f,
+	0xd1, 0xfc, 0xae, 0x02, 0x28, 0x69, 0xff, 0x48, 0xeb, 0xdc, 0x4e, 0x32, 0xc6, 0x79, 0x89, 0x68,
+	0xc3, 0xc8, 0x1a, 0x5b, 0x3e, 0x0f, 0x1c, 0x58, 0x81, 0x48, 0x65, 0x64, 0x7a, 0xbe, 0xe1, 0x61,
+	0x6c, 0x1b, 0x64, 0xb4, 0x39, 0x5a, 0xa9, 0x4a, 0x80, 0x5d, 0x8c, 0xed, 0xfb, 0xf8, 0x14, 0xbd,
+	0x01, 0xc5, 0x23, 0x6b, 0x24, 0x4e, 0xdf, 0xf8, 0x9a, 0x27, 0x36, 0xef, 0x36, 0x85, 0xea, 0x1c,
+	0xab, 0xfd, 0x50, 0x05, 0x08, 0xc1, 0xe8, 0x5d, 0x80, 0x40, 0x67, 0x99, 0x3f, 0x49, 0x55, 0xda,
+	0x8a, 0xf0, 0x79, 0x1e, 0xfa, 0x04, 0xea, 0x47, 0x23, 0xc7, 0xf4, 0xbf, 0x72, 0xd3, 0x70, 0xe9,
+	0x29, 0x0d, 0xf3, 0x1b, 0x2f, 0x46, 0xfb, 0x5b, 0xbb, 0xcd, 0x68, 0x74, 0x42, 0xa2, 0xd7, 0x8e,
+	0xa4, 0x12, 0xba, 0x0a, 0xad, 0x60, 0x92, 0x8f, 0x48, 0xbc, 0x13, 0xcc, 0x73, 0x43, 0xcc, 0x33,
+	0x01, 0xef, 0x79, 0xc4, 0x69, 0x11, 0x61, 0x0f, 0x47, 0x4e, 0x8f, 0x6f, 0x22, 0x4b, 0x27, 0xf8,
+	0xf4, 0xce, 0xc8, 0xe9, 0x91, 0x30, 0x8b, 0xa0, 0x5c, 0x3c, 0xc4, 0x8f, 0x45, 0xc0, 0x77, 0x82,
+	0x4f, 0x75, 0x52, 0xe6, 0x48, 0xcf, 0x70, 0xec, 0xd1, 0x29, 0x5d, 0x39, 0x65, 0x8a, 0xf4, 0xf6,
+	0xed, 0xd1, 0x69, 0xfb, 0x06, 0xd4, 0x64, 0xe6, 0x88, 0x06, 0x8d, 0x2d, 0x9b, 0x4e, 0x84, 0xa2,
+	0x93, 0x4f, 0x0a, 0x31, 0x1f, 0xaf, 0xa8, 0x1c, 0x62, 0x3e, 0xd6, 0x6c, 0x58, 0x8c, 0xcc, 0xe2,
+	0x39, 0x95, 0xe6, 0x4b, 0x00, 0x81, 0xd2, 0x88, 0x13, 0x8d, 0xa4, 0xd6, 0x54, 0x84, 0xd6, 0x78,
+	0xda, 0x7f, 0x28, 0x50, 0x95, 0x1c, 0x16, 0x19, 0x90, 0xe7, 0x9b, 0xae, 0x6f, 0x84, 0xba, 0x5e,
+	0xa6, 0x00, 0x32, 0xf5, 0x6f, 0x42, 0x93, 0x21, 0xf1, 0x63, 0x12, 0x2d, 0x5a, 0x0f, 0xc5, 0x19,
+	0x55, 0x83, 0x82, 0x3b, 0x02, 0x4a, 0x6e, 0xf5, 0xb0, 0x3d, 0x90, 0x34, 0xa8, 0x88, 0xed, 0xc1,
+	0x7d, 0xba, 0x93, 0xaa, 0x13, 0x84, 0x65, 0x8b, 0xfa, 0xec, 0x9c, 0xaa, 0x86, 0xed, 0xc1, 0xb6,
+	0x80, 0xb1, 0x83, 0xf0, 0x87, 0xd8, 0xf5, 0x30, 0xbf, 0x13, 0x14, 0xc5, 0x50, 0x6b, 0x8b, 0xb2,
+	0xd6, 0x86, 0x1a, 0x59, 0xca, 0xd4, 0xc8, 0xef, 0x2a, 0x50, 0x63, 0x63, 0x7d, 0xce, 0x52, 0x25,
+	0xea, 0x74, 0x6c, 0x7a, 0xc6, 0xd8, 0x71, 0xc5, 0x08, 0x4b, 0xc7, 0xa6, 0xb7, 0xeb, 0xb8, 0x58,
+	0xd3, 0xa1, 0x15, 0x77, 0xfb, 0x33, 0x17, 0x69, 0x38, 0x30, 0x35, 0x73, 0x60, 0x7f, 0xad, 0xc0,
+	0x82, 0xd4, 0xe8, 0x39, 0x47, 0xb7, 0x04, 0x85, 0xf0, 0xfa, 0x36, 0xaf, 0xb3, 0x02, 0x99, 0x29,
+	0xb1, 0xfa, 0x18, 0x96, 0xdd, 0xbd, 0x88, 0x05, 0xc6, 0xb6, 0xdb, 0x2d, 0xc8, 0x79, 0xd3, 0x31,
+	0x9d, 0x25, 0x45, 0x27, 0x9f, 0x42, 0xc7, 0x8b, 0x09, 0x1d, 0x2f, 0x85, 0x3a, 0xfe, 0x57, 0x0a,
+	0xa0, 0x64, 0x0c, 0x43, 0x7c, 0x0c, 0x8b, 0x78, 0xa4, 0x1d, 0x4f, 0x85, 0x42, 0xe8, 0x76, 0x87,
+	0x9c, 0xe3, 0x60, 0x77, 0x4c, 0x99, 0xaf, 0xe9, 0xf4, 0x3b, 0xd4, 0x87, 0x5c, 0xa6, 0x15, 0xcb,
+	0x27, 0xad, 0x58, 0xd2, 0x76, 0x17, 0xd2, 0x6c, 0xf7, 0xd7, 0x61, 0x31, 0xc2, 0xe9, 0x39, 0x45,
+	0x8b, 0x20, 0x4f, 0xac, 0x01, 0x55, 0x99, 0x9a, 0x4e, 0xbf, 0xb5, 0x7f, 0x56, 0xa1, 0x15, 0x0f,
+	0xc4, 0x9e, 0xdc, 0x8f, 0xbd, 0x09, 0xaa, 0x33, 0xe1, 0x5b, 0x88, 0xe5, 0xb4, 0x18, 0x6f, 0x6d,
+	0x7f, 0xa2, 0xab, 0xce, 0x84, 0x6c, 0xcb, 0xc7, 0x78, 0xdc, 0xc3, 0xae, 0xb8, 0x25, 0x5d, 0x8c,
+	0x50, 0xef, 0x52, 0x9c, 0x2e, 0x68, 0xe8, 0xf5, 0xbb, 0x65, 0x1b, 0x5e, 0x9f, 0xa8, 0x30, 0x9b,
+	0xdf, 0xf2, 0xd8, 0xb2, 0xbb, 0xa4, 0x2c, 0xee, 0xe6, 0x19, 0xb2, 0xc8, 0x91, 0xe6, 0x63, 0x86,
+	0x0c, 0xe6, 0xa4, 0x24, 0xcf, 0xc9, 0x4b, 0x50, 0x31, 0xbd, 0x3e, 0xb6, 0x07, 0x96, 0x3d, 0xe4,
+	0xfb, 0xb8, 0x10, 0xa0, 0x7d, 0x02, 0xea, 0xfe, 0x04, 0x95, 0x20, 0xb7, 0xbe, 0xb5, 0xd5, 0x7a,
+	0x01, 0x01, 0x14, 0xf5, 0xce, 0xee, 0xfe, 0xa7, 0x9d, 0x96, 0x42, 0x80, 0x87, 0xfb, 0x07, 0x2d,
+	0x15, 0x95, 0x21, 0xaf, 0xaf, 0xef, 0xdd, 0x6f, 0xe5, 0x10, 0x82, 0x86, 0xbe, 0xbe, 0x77, 0x87,
+	0xec, 0xad, 0x8d, 0xee, 0xe6, 0xbe, 0xde, 0x69, 0xe5, 0xb5, 0x8f, 0xa1, 0x19, 0x1b, 0x0b, 0x99,
+	0x14, 0x36, 0x1a, 0xb1, 0xaa, 0x58, 0x89, 0x30, 0xc8, 0x38, 0x67, 0x66, 0x97, 0x15, 0xb4, 0xef,
+	0xc0, 0x82, 0x24, 0xba, 0x73, 0xfb, 0xea, 0x40, 0xb8, 0xb9, 0x39, 0x84, 0x4b, 0xcf, 0xfe, 0xec,
+	0x13, 0x7e, 0xc5, 0x46, 0xbf, 0xb5, 0x3f, 0x56, 0x60, 0x21, 0x11, 0x69, 0x3f, 0xb9, 0x5e, 0x90,
+	0x5d, 0x18, 0x35, 0xd5, 0x63, 0xe6, 0xf2, 0x72, 0x7a, 0x89, 0x96, 0x77, 0x3d, 0x74, 0x01, 0x88,
+	0x35, 0x26, 0x08, 0xd6, 0x7f, 0x01, 0xdb, 0x83, 0x5d, 0x3a, 0xe3, 0xbd, 0x69, 0xff, 0x04, 0xd3,
+	0x2a, 0xec, 0xb6, 0xac, 0xcc, 0x00, 0xbb, 0x9e, 0x76, 0x0f, 0x9a, 0x21, 0x73, 0x07, 0x8e, 0x65,
+	0xfb, 0x64, 0x1b, 0x4f, 0xb6, 0x01, 0x9e, 0x6f, 0x8e, 0x27, 0xa4, 0x8a, 0x42, 0xab, 0x54, 0x03,
+	0xd8, 0xae, 0x17, 0x86, 0x9c, 0x5c, 0xd2, 0xb4, 0xa0, 0x9d, 0x42, 0x2b, 0x6c, 0x6b, 0x83, 0xf6,
+	0x10, 0x61, 0x57, 0x89, 0xb2, 0xcb, 0x2d, 0x8a, 0x9a, 0xb0, 0x28, 0xb9, 0xc0, 0xa2, 0x08, 0x3b,
+	0x94, 0x0f, 0xed, 0x50, 0x60, 0xd4, 0x0a, 0x92, 0x51, 0xd3, 0xfe, 0x48, 0x01, 0x24, 0x0b, 0xf9,
+	0x9c, 0xd3, 0xfc, 0x36, 0x14, 0x27, 0x64, 0xec, 0x91, 0x59, 0x8e, 0xc9, 0x45, 0xe7, 0x24, 0x68,
+	0x0d, 0x4a, 0x4c, 0x7c, 0x62, 0xc1, 0x2d, 0x45, 0xa9, 0xd9, 0xc8, 0x75, 0x41, 0xa4, 0xfd, 0x8d,
+	0x02, 0x10, 0x6e, 0x9d, 0x9e, 0x7c, 0xe6, 0x5f, 0x95, 0x2c, 0xc2, 0x42, 0x74, 0x3f, 0x26, 0x6c,
+	0x41, 0xf6, 0x2e, 0x49, 0xbb, 0x22, 0x56, 0xe3, 0x9d, 0xce, 0x61, 0xeb, 0x05, 0x72, 0x2e, 0x72,
+	0xb8, 0xff, 0x60, 0x93, 0x9c, 0x5a, 0x55, 0xa1, 0x74, 0xd0, 0xd1, 0xbb, 0xdb, 0xdd, 0xc3, 0x96,
+	0xaa, 0x3d, 0x84, 0x2a, 0x6d, 0xfa, 0xfc, 0xee, 0xe6, 0xc8, 0x99, 0xf2, 0xb3, 0xcb, 0xb2, 0xce,
+	0x0a, 0xec, 0xd0, 0x72, 0x6c, 0x5a, 0xb6, 0x65, 0x0f, 0x8d, 0xc8, 0x0d, 0x74, 0x33, 0x80, 0x73,
+	0xf6, 0xfe, 0x2e, 0x07, 0xe5, 0xa0, 0xd7, 0x37, 0xa1, 0xf0, 0xc8, 0xb5, 0xfc, 0xc8, 0x3d, 0x45,
+	0x64, 0x2b, 0xa2, 0x33, 0x3c, 0x7a, 0x95, 0x9d, 0x2c, 0xa8, 0xe1, 0x3e, 0x5d, 0x0a, 0xca, 0xd9,
+	0xd1, 0xc2, 0xd7, 0xe2, 0x47, 0x0b, 0x2c, 0xea, 0x5e, 0x4e, 0x1c, 0x2d, 0xf0, 0x4a, 0x91, 0xb3,
+	0x85, 0xd7, 0xf9, 0x41, 0x40, 0x3e, 0x8c, 0xd4, 0xe5, 0x58, 0x83, 0x9f, 0x04, 0xbc, 0x27, 0x9f,
+	0x04, 0x14, 0xc2, 0x3d, 0x76, 0xc2, 0x7b, 0xcb, 0x47, 0x01, 0xb7, 0x62, 0x47, 0x01, 0xc5, 0x90,
+	0xad, 0x14, 0xe7, 0x14, 0x3d, 0x0b, 0xb8, 0x19, 0x39, 0x0b, 0x28, 0x85, 0x3d, 0x26, 0x8c, 0x9d,
+	0x7c, 0x18, 0xf0, 0x7e, 0xf4, 0x30, 0xa0, 0x1c, 0x9e, 0x3d, 0x24, 0x57, 0x4f, 0xe4, 0x34, 0xe0,
+	0x55, 0x76, 0x1a, 0x50, 0x09, 0xa5, 0x2c, 0xa9, 0x08, 0x3b, 0x0e, 0xf8, 0x75, 0x05, 0xea, 0x9b,
+	0xc7, 0x53, 0xfb, 0x64, 0xd7, 0xb4, 0xad, 0x23, 0xa2, 0xea, 0x2b, 0x50, 0x22, 0xe1, 0x1d, 0xd9,
+	0x7c, 0x2a, 0x54, 0xa3, 0x45, 0x91, 0x5e, 0xc5, 0x13, 0x52, 0x1e, 0x82, 0xb0, 0xbd, 0x0a, 0x50,
+	0x10, 0x0b, 0x40, 0x68, 0xfe, 0x93, 0x6f, 0x8e, 0xd8, 0x49, 0x26, 0x0b, 0x60, 0x2a, 0x14, 0x22,
+	0x6e, 0x21, 0xfb, 0xc7, 0xb8, 0x7f, 0x22, 0x8c, 0x43, 0x5d, 0x0f, 0xca, 0xda, 0x2f, 0x41, 0x55,
+	0x37, 0x1f, 0xdd, 0xe7, 0x31, 0x5b, 0xca, 0x7a, 0x8b, 0x58, 0xaf, 0x60, 0xc3, 0xff, 0x73, 0x05,
+	0xca, 0x3b, 0xce, 0x90, 0x5d, 0x15, 0x24, 0x76, 0x91, 0x4a, 0x72, 0xcf, 0x7e, 0xf6, 0xa1, 0x57,
+	0x78, 0x2c, 0x95, 0x9b, 0xfb, 0x58, 0x2a, 0x9f, 0x7d, 0x2c, 0xc5, 0x4f, 0x65, 0x0a, 0x33, 0x4f,
+	0x65, 0xc8, 0xcd, 0x83, 0xe3, 0x5a, 0x43, 0xcb, 0x8e, 0x24, 0x54, 0xb0, 0xcd, 0x7f, 0x8b, 0x61,
+	0xc2, 0x1b, 0x7f, 0xad, 0x0b, 0x8d, 0x4d, 0x67, 0x72, 0xba, 0x45, 0x12, 0xdf, 0xb0, 0xe7, 0x0d,
+	0xa9, 0x9b, 0xa7, 0xc7, 0x7a, 0x74, 0xc8, 0x05, 0x9d, 0x15, 0xd0, 0xdb, 0x80, 0xfa, 0xce, 0xe4,
+	0xd4, 0x60, 0xc6, 0x9c, 0xea, 0x90, 0xcd, 0x0c, 0x40, 0x4e, 0x6f, 0x12, 0x4c, 0x97, 0x20, 0x88,
+	0x12, 0xed, 0x79, 0xda, 0x9f, 0xaa, 0xb0, 0x14, 0x24, 0xef, 0x90, 0xe6, 0x85, 0xed, 0x7b, 0xc2,
+	0x8c, 0x92, 0x39, 0x2e, 0xb4, 0xde, 0x80, 0x26, 0xbf, 0xc6, 0x0e, 0x1a, 0x61, 0x7a, 0x51, 0x67,
+	0xe0, 0x2e, 0x6f, 0x6a, 0xc6, 0x75, 0x77, 0x61, 0xd6, 0x75, 0x37, 0xb9, 0x45, 0xa0, 0x32, 0xe3,
+	0x12, 0xe4, 0xa5, 0x68, 0x30, 0x94, 0x0f, 0x37, 0x2c, 0x7c, 0x1f, 0xc5, 0x76, 0xa5, 0x44, 0xef,
+	0xca, 0x54, 0xc7, 0xea, 0x14, 0x4c, 0x37, 0xa5, 0xf7, 0xf1, 0xa9, 0xf6, 0x9f, 0x0a, 0x5c, 0x88,
+	0x09, 0x88, 0x9b, 0xbd, 0xb5, 0xc8, 0x8e, 0x44, 0xca, 0x29, 0x90, 0x54, 0x5a, 0xde, 0x90, 0xfc,
+	0x32, 0xa0, 0x9e, 0x65, 0x8f, 0x9c, 0xe1, 0xa1, 0x69, 0x8d, 0x44, 0x9e, 0x14, 0xd7, 0xc9, 0xeb,
+	0x91, 0x5c, 0x35, 0xb9, 0x9b, 0xb5, 0x8d, 0x44, 0x1d, 0x3d, 0xa5, 0x9d, 0xf6, 0x6d, 0x40, 0x49,
+	0x4a, 0xb2, 0xac, 0x3d, 0x3c, 0x1c, 0x63, 0xdb, 0x0f, 0xce, 0x81, 0x59, 0x51, 0xba, 0x73, 0x61,
+	0x1e, 0x8c, 0x97, 0xb4, 0xef, 0xaa, 0xb0, 0x70, 0x30, 0x1d, 0x8d, 0x78, 0xb6, 0xc7, 0xd3, 0x69,
+	0x83, 0xd4, 0x7d, 0x6e, 0x56, 0xf7, 0x79, 0xb9, 0xfb, 0x70, 0xb2, 0x0a, 0xd1, 0xdd, 0x65, 0x42,
+	0x65, 0x8a, 0xe7, 0x50, 0x99, 0xd2, 0xd9, 0x2a, 0x53, 0x96, 0x55, 0x46, 0xfb, 0x33, 0x05, 0x90,
+	0x2c, 0x04, 0x3e, 0xe3, 0xaf, 0x42, 0xcd, 0xc6, 0x8f, 0x7d, 0x23, 0x2a, 0xd2, 0x2a, 0x81, 0x75,
+	0xf9, 0xb8, 0x2e, 0x03, 0x2d, 0x1a, 0x11, 0xd9, 0x02, 0x01, 0xed, 0xb3, 0x01, 0xbe, 0x41, 0xb6,
+	0xe5, 0x2c, 0xc3, 0x2c, 0x17, 0x1e, 0xe8, 0x0b, 0x6b, 0xa6, 0x0b, 0x24, 0x7a, 0x05, 0xaa, 0xce,
+	0x94, 0xb4, 0x63, 0x78, 0xa7, 0x76, 0x9f, 0xef, 0x60, 0x2b, 0xce, 0xd4, 0xdf, 0x3f, 0xea, 0x9e,
+	0xda, 0x7d, 0xed, 0x3e, 0xa0, 0x4d, 0x62, 0x46, 0xd9, 0xa4, 0x3f, 0xdd, 0x3c, 0x91, 0x5d, 0xf9,
+	0x62, 0xa4, 0x35, 0x3e, 0xe0, 0x8c, 0x7b, 0x84, 0x6b, 0xd0, 0xc2, 0xa6, 0x3b, 0xb2, 0xb0, 0x17,
+	0xca, 0x83, 0xb5, 0xda, 0x14, 0x70, 0x21, 0x93, 0x2b, 0xd0, 0x18, 0x99, 0xbe, 0x4c, 0xc8, 0x94,
+	0xa1, 0xce, 0xa0, 0x9c, 0x4c, 0x1b, 0xc2, 0xc5, 0xee, 0xb4, 0xe7, 0xf5, 0x5d, 0xab, 0x87, 0x3b,
+	0x8f, 0x27, 0x96, 0xfb, 0xb4, 0xb6, 0x28, 0xdc, 0xd2, 0xe7, 0xe4, 0x2d, 0xbd, 0xe6, 0x42, 0x95,
+	0xb5, 0xdf, 0x79, 0x88, 0xed, 0xa7, 0x88, 0xf2, 0xde, 0x82, 0x05, 0x4c, 0xda, 0x61, 0x9e, 0x47,
+	0xba, 0x0d, 0xce, 0xe9, 0x4d, 0x8e, 0x58, 0xf7, 0x79, 0xc0, 0xf4, 0xc3, 0x1c, 0x34, 0xb7, 0x30,
+	0x1b, 0x9c, 0x18, 0xd6, 0x3e, 0x2c, 0x0c, 0xb0, 0xd7, 0x97, 0x8d, 0xbf, 0xc7, 0x63, 0xa8, 0xd7,
+	0x98, 0xfb, 0x89, 0xd0, 0xd3, 0x72, 0xe8, 0x0f, 0x3c, 0xbd, 0x39, 0x88, 0x02, 0xd0, 0x5d, 0x68,
+	0xd0, 0x06, 0x85, 0x70, 0x84, 0x75, 0x79, 0x75, 0x56, 0x6b, 0xe2, 0x4e, 0xd9, 0xd3, 0xeb, 0x03,
+	0xb9, 0x88, 0x36, 0xa0, 0x46, 0x5b, 0x12, 0x89, 0x64, 0xcc, 0x29, 0x5e, 0x9e, 0xd5, 0x8e, 0x48,
+	0x2e, 0xab, 0x0e, 0xc2, 0x82, 0xd4, 0x86, 0x85, 0x6d, 0xdf, 0x5b, 0xc9, 0x9f, 0xd5, 0x06, 0x25,
+	0x13, 0x6d, 0xd0, 0x42, 0x7b, 0x81, 0x49, 0x4d, 0x1a, 0x64, 0xbb, 0x49, 0xce, 0x9f, 0x25, 0x5e,
+	0xdb, 0xf7, 0xa0, 0x2a, 0xf1, 0x70, 0x56, 0xbe, 0x9e, 0xec, 0x69, 0xd5, 0x78, 0x96, 0x48, 0xbb,
+	0x2e, 0xda, 0xa2, 0xdd, 0x6b, 0xff, 0x53, 0x86, 0x56, 0xc8, 0x2b, 0x5f, 0x14, 0xbb, 0xd0, 0x8a,
+	0x4f, 0x5b, 0xfa, 0xac, 0x71, 0x03, 0x1e, 0x1d, 0x80, 0xde, 0x88, 0xce, 0x1a, 0xda, 0x9e, 0x31,
+	0x69, 0xda, 0xcc, 0xc6, 0x66, 0xce, 0xda, 0x66, 0xea, 0xac, 0xad, 0xce, 0x6c, 0x28, 0x75, 0xda,
+	0xa8, 0x07, 0xb7, 0x68, 0xbe, 0x54, 0x70, 0xe6, 0x44, 0x3d, 0x38, 0x81, 0xd1, 0x88, 0xaf, 0xfd,
+	0x8f, 0x2a, 0x34, 0xa2, 0xa3, 0x42, 0xfb, 0x50, 0x4d, 0xca, 0x63, 0x6d, 0x0e, 0x79, 0xac, 0x85,
+	0x9f, 0xf2, 0x4c, 0xa0, 0xaf, 0x43, 0x2d, 0xb2, 0x2e, 0xd8, 0xa5, 0xe9, 0x79, 0x5b, 0xac, 0x0e,
+	0x24, 0xcd, 0xf9, 0x42, 0x01, 0xd8, 0x8a, 0xe4, 0x65, 0xc5, 0x59, 0x8e, 0xa6, 0x0c, 0xdd, 0x82,
+	0x66, 0x34, 0x11, 0x4b, 0x70, 0x91, 0x92, 0x89, 0xd5, 0x88, 0x64, 0x62, 0x91, 0xd3, 0x08, 0x34,
+	0x70, 0xf9, 0x56, 0x8a, 0x67, 0x46, 0x71, 0x8b, 0x5f, 0xd1, 0x17, 0x04, 0x66, 0x5d, 0x20, 0xda,
+	0x3f, 0x51, 0x62, 0x5a, 0x8d, 0xb6, 0xd9, 0xa9, 0x36, 0x2d, 0xf0, 0xe0, 0xe2, 0xed, 0xb3, 0x35,
+	0x22, 0x48, 0xdc, 0xd1, 0xc3, 0xda, 0x6d, 0x17, 0xca, 0x02, 0x7c, 0xd6, 0x1d, 0x75, 0x90, 0x7d,
+	0xae, 0x26, 0xb3, 0xcf, 0x03, 0x64, 0x42, 0x45, 0x72, 0x49, 0x15, 0xf9, 0x07, 0x35, 0xba, 0x2a,
+	0xe7, 0x4c, 0x4a, 0x5d, 0xe3, 0x1e, 0x56, 0xd0, 0xaa, 0x49, 0x5a, 0xea, 0x5f, 0x67, 0x29, 0x6b,
+	0x92, 0x93, 0xa7, 0x7d, 0xa3, 0xf0, 0x0e, 0xa0, 0xc9, 0xc8, 0xec, 0x63, 0xe2, 0xa2, 0x8c, 0x47,
+	0xa6, 0x6b, 0xd3, 0x54, 0xa9, 0x02, 0x9b, 0xc8, 0x00, 0xf3, 0x0d, 0x8e, 0x78, 0x76, 0x4f, 0x12,
+	0xb4, 0x9f, 0xaa, 0xb0, 0xb4, 0xe9, 0x62, 0x33, 0xc8, 0xf7, 0x4f, 0xf3, 0x86, 0x6a, 0x32, 0x05,
+	0xf5, 0x19, 0xe7, 0x93, 0xbd, 0x0d, 0x88, 0xed, 0xee, 0x22, 0xc9, 0x7a, 0x2c, 0x3a, 0x6b, 0x52,
+	0xcc, 0x56, 0x98, 0xb1, 0x27, 0xf2, 0xfc, 0x8a, 0x52, 0x9e, 0x9f, 0x9c, 0x61, 0x56, 0x9a, 0x37,
+	0xc3, 0x4c, 0x5e, 0x98, 0xe5, 0xb3, 0x32, 0x2a, 0x13, 0xa9, 0x26, 0xf2, 0x8b, 0x26, 0x90, 0x5f,
+	0x34, 0x69, 0x7f, 0xa8, 0xc0, 0x85, 0x98, 0x50, 0xb9, 0x55, 0x0f, 0x5e, 0x11, 0x29, 0xd2, 0x2b,
+	0x22, 0x59, 0x6d, 0xd5, 0x0c, 0xb5, 0x7d, 0x0b, 0xf2, 0x93, 0x91, 0x69, 0xaf, 0xe4, 0xa4, 0x4d,
+	0xba, 0x33, 0x71, 0x46, 0xce, 0xf0, 0x94, 0x25, 0xd5, 0x1e, 0x8c, 0x4c, 0x5b, 0xa7, 0x34, 0xe4,
+	0xe8, 0xef, 0x73, 0xa7, 0x27, 0x76, 0x3d, 0x15, 0xbd, 0xf0, 0xb9, 0xd3, 0xdb, 0x1e, 0x68, 0x5d,
+	0x58, 0x62, 0xdb, 0xcd, 0x73, 0xcc, 0xf6, 0x59, 0xd9, 0xe5, 0xda, 0x16, 0x5c, 0x88, 0x35, 0x9a,
+	0x39, 0xda, 0x90, 0x35, 0x55, 0x66, 0xed, 0x3d, 0xb8, 0xb0, 0xe9, 0x8c, 0x27, 0x66, 0xdf, 0x9f,
+	0x9f, 0x37, 0xad, 0x03, 0x17, 0xe3, 0x95, 0x9e, 0xa4, 0xef, 0x2f, 0x14, 0x40, 0x34, 0x71, 0x8c,
+	0xe5, 0xbd, 0xcd, 0x13, 0x11, 0x5e, 0x83, 0x02, 0x3d, 0x7d, 0xe1, 0x33, 0x96, 0x9a, 0xfa, 0xc6,
+	0x28, 0x88, 0x9e, 0x90, 0x54, 0x6f, 0x97, 0x1f, 0xd6, 0x95, 0xf5, 0xa2, 0xe5, 0x6d, 0xb9, 0xce,
+	0x24, 0x79, 0xa8, 0x90, 0x4f, 0x5e, 0x4d, 0xbf, 0x0d, 0x8b, 0x11, 0xce, 0xb2, 0x86, 0xa7, 0x61,
+	0xb8, 0xc0, 0x36, 0x13, 0x81, 0x81, 0x9e, 0x63, 0x24, 0xf2, 0xd2, 0x51, 0xe7, 0x59, 0x3a, 0xda,
+	0x1a, 0x5c, 0x8c, 0x77, 0x93, 0xc9, 0xd6, 0x5f, 0x2a, 0x80, 0x88, 0x05, 0x24, 0xf9, 0x6c, 0xce,
+	0x00, 0xcf, 0xa3, 0x74, 0xcb, 0x50, 0xb2, 0x9d, 0x01, 0x0e, 0x93, 0xda, 0x8a, 0xa4, 0xb8, 0x3d,
+	0x60, 0x5b, 0x9f, 0x47, 0xb1, 0x3c, 0x60, 0xb0, 0xf1, 0x23, 0x91, 0x05, 0x1c, 0x53, 0xd7, 0x42,
+	0xd6, 0x43, 0xc4, 0x62, 0x64, 0xd9, 0xda, 0xb0, 0x18, 0xe1, 0x32, 0x53, 0x93, 0xc4, 0x62, 0x54,
+	0xcf, 0xb5, 0x18, 0x73, 0xb2, 0xd6, 0xfd, 0x2a, 0x2c, 0x6c, 0x11, 0x1f, 0xcd, 0x7d, 0x3c, 0x13,
+	0x8a, 0x94, 0x03, 0xad, 0x44, 0x73, 0xa0, 0xcf, 0x0a, 0x2b, 0xe5, 0x81, 0xe5, 0xe4, 0x81, 0x91,
+	0x2d, 0x4a, 0xdf, 0xb4, 0xfb, 0x78, 0xc4, 0x37, 0x78, 0xbc, 0xa4, 0xfd, 0x16, 0x51, 0x7b, 0x89,
+	0x83, 0x67, 0xf8, 0x5c, 0xf2, 0x12, 0x94, 0x2d, 0xcf, 0xc0, 0x24, 0x9b, 0x8e, 0x33, 0x53, 0xb2,
+	0x3c, 0x9a, 0x5c, 0x17, 0xca, 0x33, 0x2f, 0xeb, 0xc8, 0x77, 0x15, 0x78, 0xb1, 0x8b, 0xfd, 0xc0,
+	0x6d, 0x1d, 0x1e, 0xbb, 0x8e, 0xef, 0x8f, 0xe4, 0x17, 0xaa, 0xd9, 0x71, 0x94, 0x24, 0x38, 0x35,
+	0x2a, 0xb8, 0xab, 0xd0, 0xa2, 0x4f, 0xb1, 0x8c, 0x09, 0x71, 0x58, 0xe1, 0x06, 0x2a, 0xaf, 0x37,
+	0x28, 0xfc, 0x00, 0xbb, 0x7c, 0xff, 0x74, 0x13, 0x5e, 0x4a, 0xe7, 0x21, 0x53, 0xbd, 0x7f, 0xa2,
+	0x02, 0x62, 0xe6, 0x9e, 0x4a, 0x69, 0x9e, 0x35, 0x77, 0xd6, 0x6b, 0xd0, 0x67, 0xef, 0x5e, 0xa5,
+	0x27, 0x90, 0x31, 0xf7, 0x1a, 0xbc, 0x77, 0xe4, 0xee, 0xf5, 0x39, 0x24, 0x6b, 0x4b, 0x99, 0xc2,
+	0xe5, 0xd0, 0x5c, 0xc6, 0xdf, 0x2f, 0x0a, 0x1a, 0x62, 0xf3, 0x22, 0xf2, 0xcc, 0x94, 0xfe, 0x7b,
+	0xc2, 0xfb, 0x9c, 0xc3, 0xe6, 0x11, 0x0b, 0x16, 0xaf, 0x94, 0xd9, 0xc9, 0xcd, 0xc0, 0xcf, 0x9c,
+	0xa7, 0x97, 0x2f, 0xc1, 0x72, 0xa2, 0x56, 0x66, 0x37, 0xff, 0xad, 0xc0, 0x8b, 0x22, 0x54, 0xa4,
+	0x46, 0xe8, 0xc0, 0xc5, 0x13, 0xd3, 0xc5, 0xbf, 0x80, 0x2a, 0x15, 0x9b, 0xf3, 0x42, 0xd6, 0x9c,
+	0x17, 0xe7, 0x98, 0xf3, 0x9b, 0xf0, 0x52, 0xfa, 0xc8, 0x33, 0x05, 0xf6, 0x01, 0xb4, 0x23, 0xb5,
+	0x36, 0x9d, 0xf1, 0xd8, 0xf2, 0xe7, 0x99, 0x9b, 0xf7, 0xe0, 0xc5, 0xd4, 0x9a, 0x99, 0xdd, 0x7d,
+	0x35, 0x5e, 0x69, 0x84, 0x4d, 0x7b, 0x3a, 0x99, 0xa7, 0xbf, 0xf8, 0xf8, 0x82, 0xaa, 0x99, 0x1d,
+	0xfe, 0x40, 0x85, 0x15, 0xf6, 0x92, 0xeb, 0x17, 0xdb, 0xc0, 0x9c, 0xf7, 0x14, 0x3d, 0xa6, 0x3d,
+	0xc5, 0x2c, 0xed, 0x29, 0xcd, 0xa1, 0x3d, 0x5f, 0x86, 0x4b, 0x29, 0x62, 0xca, 0x14, 0xad, 0x09,
+	0x8b, 0xbc, 0xca, 0xbc, 0x3a, 0x73, 0xde, 0xa7, 0x71, 0xda, 0x75, 0x58, 0x8a, 0x76, 0x91, 0xc9,
+	0x50, 0x2f, 0xa0, 0x9e, 0x5b, 0xab, 0xce, 0xcd, 0xd1, 0x3b, 0x70, 0x21, 0xd6, 0x47, 0x26, 0x4b,
+	0x7f, 0xa0, 0x40, 0x9d, 0xd1, 0xcf, 0x13, 0xb3, 0xcd, 0x60, 0x26, 0x97, 0xa1, 0x04, 0x4f, 0xf6,
+	0xcb, 0x08, 0xcd, 0x22, 0x4f, 0x93, 0x19, 0x5b, 0x4f, 0x10, 0xee, 0x9f, 0x67, 0x23, 0xa5, 0xfd,
+	0x9e, 0x0a, 0x28, 0x89, 0xcc, 0x9c, 0x94, 0xf8, 0xf2, 0x52, 0x93, 0xcb, 0xeb, 0xbc, 0xa2, 0xba,
+	0xc1, 0xa2, 0x5e, 0xb6, 0x82, 0xc5, 0xc9, 0x02, 0x3d, 0x20, 0x22, 0xdc, 0xd8, 0x78, 0xd0, 0xa5,
+	0x18, 0x1a, 0x08, 0xb3, 0x4f, 0x92, 0x96, 0x50, 0xa4, 0x0b, 0x4a, 0x3c, 0xd1, 0xba, 0x18, 0xc4,
+	0x71, 0xe1, 0xd9, 0x00, 0x19, 0x27, 0xa7, 0x22, 0x39, 0x7f, 0xd8, 0xf3, 0xad, 0x31, 0xdd, 0x8f,
+	0xc8, 0x6f, 0xd7, 0x1b, 0x01, 0x98, 0x3d, 0xc5, 0xb7, 0xa1, 0x1e, 0xe9, 0x35, 0x6a, 0x6c, 0x94,
+	0x98, 0xb1, 0x99, 0x1d, 0x97, 0x89, 0xc7, 0x72, 0xb9, 0x94, 0xc7, 0x72, 0x79, 0xe9, 0xb1, 0xdc,
+	0x4f, 0x55, 0x40, 0x49, 0xbe, 0xb3, 0x7b, 0xcd, 0xbe, 0x2e, 0x9a, 0xf1, 0xca, 0xf0, 0x23, 0x58,
+	0x08, 0x8f, 0x55, 0xc4, 0x61, 0x9c, 0x24, 0x6b, 0xca, 0xc4, 0x8e, 0xc3, 0x2c, 0x9f, 0xde, 0x0a,
+	0x68, 0xd9, 0x4b, 0x20, 0x0f, 0x7d, 0x0d, 0xda, 0x13, 0xab, 0x7f, 0x62, 0xf4, 0xb0, 0xe7, 0x1b,
+	0xf1, 0x96, 0xb8, 0x0a, 0x2f, 0x13, 0x8a, 0x0d, 0xec, 0xf9, 0x1b, 0xd1, 0xda, 0x68, 0x0b, 0x96,
+	0x7c, 0xd7, 0xb4, 0x3d, 0xba, 0x75, 0x34, 0x47, 0xfc, 0xc1, 0xb9, 0x38, 0xd3, 0x49, 0xe9, 0x7f,
+	0x51, 0x26, 0x67, 0xaf, 0xc9, 0x53, 0x27, 0xb1, 0x94, 0x3a, 0x89, 0x26, 0xd4, 0x23, 0xcd, 0x3d,
+	0x7b, 0x71, 0x6a, 0x3f, 0xcb, 0xd1, 0x57, 0x26, 0xd6, 0xb7, 0xf1, 0x3d, 0xa7, 0x27, 0xbd, 0x6c,
+	0xac, 0xd0, 0x97, 0x8d, 0x4f, 0x73, 0xe4, 0x30, 0xcf, 0xcb, 0xa6, 0xf3, 0xba, 0xa4, 0x6b, 0x50,
+	0xf0, 0x7c, 0xd3, 0xc7, 0xfc, 0x65, 0xd3, 0x22, 0x7f, 0x01, 0xc3, 0xb8, 0xa7, 0x2f, 0x9b, 0xb0,
+	0xce, 0x28, 0x88, 0x92, 0x7a, 0x3e, 0x9e, 0xf0, 0x57, 0xb8, 0xf4, 0x3b, 0x34, 0x40, 0x65, 0xd9,
+	0x00, 0x69, 0xc0, 0x2e, 0x7a, 0x83, 0x1d, 0x7e, 0x85, 0xe5, 0x4e, 0x71, 0x20, 0x4d, 0x1b, 0x78,
+	0x1d, 0x1a, 0xe4, 0xc4, 0xc0, 0x3b, 0x0e, 0x88, 0x80, 0x12, 0xd5, 0x04, 0x94, 0x52, 0x7d, 0x29,
+	0x58, 0xcd, 0xd5, 0xd5, 0x9c, 0x48, 0x0a, 0x61, 0xfc, 0xd1, 0x79, 0x0c, 0x8e, 0xf9, 0xc4, 0x72,
+	0x7e, 0x0d, 0xea, 0xec, 0xf1, 0x55, 0x1f, 0x8f, 0x46, 0x24, 0x17, 0xaf, 0xc6, 0x12, 0x70, 0xe9,
+	0xeb, 0x2b, 0x0e, 0xd3, 0x3e, 0x82, 0x02, 0x1d, 0x19, 0xc9, 0xf7, 0xd1, 0x1f, 0xec, 0xed, 0x6d,
+	0xef, 0xdd, 0x61, 0xcf, 0xa5, 0xba, 0x0f, 0x36, 0x37, 0x3b, 0x9d, 0xad, 0xce, 0x56, 0x4b, 0x21,
+	0x49, 0x7a, 0xb7, 0xd7, 0xb7, 0x77, 0x3a, 0x5b, 0x2d, 0x95, 0xa0, 0x36, 0xd7, 0xf7, 0x36, 0x3b,
+	0x3b, 0x3b, 0xf4, 0xc5, 0xd4, 0xbf, 0x29, 0xb0, 0x98, 0xc2, 0xc4, 0x73, 0x58, 0x9b, 0x6c, 0x43,
+	0xe9, 0x62, 0x73, 0x70, 0x2a, 0x12, 0x6d, 0x2d, 0x4f, 0x27, 0x45, 0xa2, 0xf3, 0xe1, 0x62, 0x93,
+	0x7f, 0x80, 0xd3, 0x08, 0xc0, 0x54, 0xe7, 0xd1, 0x4d, 0xb8, 0x18, 0xfe, 0x7f, 0x25, 0xf2, 0xcf,
+	0x88, 0x22, 0x95, 0xf8, 0x52, 0xf0, 0xef, 0x15, 0xf9, 0xcf, 0x11, 0x7a, 0x30, 0x44, 0xf6, 0xba,
+	0x6d, 0x0e, 0xb7, 0x7c, 0xd6, 0x56, 0x5d, 0xdb, 0x85, 0xa5, 0x68, 0x9b, 0xdc, 0x8d, 0x5d, 0x86,
+	0xdc, 0xe7, 0x4e, 0x8f, 0x1f, 0x5e, 0xd7, 0x23, 0x2a, 0xa8, 0x13, 0x4c, 0xa8, 0x66, 0xaa, 0xec,
+	0xa7, 0x75, 0x58, 0x64, 0x93, 0x3a, 0xdb, 0x59, 0x9f, 0x9b, 0xc5, 0xeb, 0xb0, 0x14, 0x6d, 0x33,
+	0x33, 0x52, 0xf8, 0x6d, 0x05, 0x5e, 0xe1, 0x7f, 0x4c, 0x88, 0x07, 0x87, 0xcf, 0x82, 0x9b, 0x19,
+	0xf1, 0x68, 0x6e, 0x46, 0x3c, 0xaa, 0xbd, 0x0f, 0x97, 0x67, 0x72, 0x93, 0x39, 0x8e, 0xff, 0x52,
+	0xe0, 0xca, 0x8c, 0x9a, 0xbf, 0xb8, 0x7b, 0xb1, 0x5b, 0x70, 0x89, 0x9b, 0xba, 0x99, 0x6f, 0x39,
+	0x97, 0x19, 0x41, 0x62, 0x50, 0xda, 0x47, 0xf0, 0xc6, 0x59, 0xe3, 0xcd, 0x14, 0xd8, 0x77, 0xe0,
+	0xf5, 0x19, 0xf5, 0xe7, 0x8f, 0xab, 0x33, 0xf9, 0x57, 0xb3, 0xf9, 0xff, 0x10, 0xae, 0x9c, 0xd1,
+	0x7f, 0x26, 0xfb, 0x7f, 0x92, 0x83, 0xf2, 0x3a, 0xf9, 0x89, 0x40, 0x9a, 0x8b, 0x22, 0x49, 0xdd,
+	0x96, 0x2d, 0x82, 0x47, 0xfa, 0x9d, 0xf9, 0x6b, 0x8e, 0x33, 0x03, 0x5a, 0xfa, 0xde, 0x81, 0xca,
+	0x83, 0x9f, 0x4b, 0x8a, 0x22, 0xba, 0x1a, 0xf5, 0x3e, 0xf4, 0xd2, 0x48, 0xf0, 0x15, 0x75, 0x3e,
+	0xc1, 0x38, 0x4a, 0x99, 0x8e, 0xa6, 0x3c, 0x8f, 0xa3, 0xa9, 0xa4, 0x38, 0x9a, 0x37, 0x08, 0x27,
+	0x78, 0x42, 0xbc, 0x50, 0xf0, 0xfa, 0x41, 0x70, 0xd2, 0xf5, 0xf1, 0x44, 0x67, 0xe8, 0xa4, 0x7f,
+	0xa9, 0x3e, 0x07, 0xff, 0xf2, 0x21, 0xd4, 0xe4, 0xbe, 0xd1, 0x22, 0x14, 0xc2, 0xf4, 0xbb, 0x9c,
+	0x9e, 0x37, 0x09, 0xc7, 0x2b, 0x24, 0x27, 0x9b, 0xfe, 0xc5, 0x45, 0x44, 0x98, 0xbc, 0xa8, 0x0d,
+	0xa0, 0xb9, 0x63, 0x79, 0xfe, 0x3d, 0xa7, 0xf7, 0x4c, 0xcc, 0x76, 0xfa, 0x2b, 0x04, 0xed, 0x1e,
+	0xb4, 0xc2, 0x5e, 0xb8, 0xb6, 0xad, 0x42, 0xfe, 0x73, 0xa7, 0x17, 0x79, 0x4e, 0x2b, 0x06, 0xa2,
+	0x53, 0xcc, 0x0c, 0x4b, 0x7e, 0x19, 0xea, 0x77, 0x30, 0x69, 0x4a, 0xf0, 0x1b, 0xd3, 0x49, 0xed,
+	0x36, 0x34, 0x04, 0x01, 0xef, 0xea, 0x15, 0xd9, 0x67, 0x44, 0x7b, 0xca, 0x70, 0x19, 0x1a, 0xb4,
+	0xd8, 0x3c, 0x65, 0xf4, 0x75, 0x0d, 0x16, 0x24, 0x9a, 0xac, 0x75, 0xf4, 0xd6, 0x8f, 0x15, 0xa8,
+	0x47, 0xde, 0x77, 0x91, 0x64, 0x62, 0xf1, 0x22, 0xbe, 0x0a, 0xa5, 0xdb, 0x3b, 0xfb, 0xeb, 0x87,
+	0x5f, 0xb9, 0xd9, 0x52, 0xc8, 0x7b, 0xf9, 0xdd, 0xf5, 0x6f, 0x1a, 0x02, 0xa0, 0x52, 0xc0, 0xf6,
+	0x5e, 0x00, 0xa0, 0x59, 0xff, 0x9b, 0x77, 0x1f, 0xec, 0xdd, 0x37, 0x76, 0xd7, 0xf7, 0xb6, 0x6f,
+	0x77, 0xba, 0x87, 0xad, 0x3c, 0x69, 0x6d, 0x7b, 0x8f, 0xa0, 0x0b, 0x44, 0x45, 0x48, 0x03, 0xac,
+	0x58, 0xa4, 0xc5, 0xed, 0x3d, 0x5e, 0x2c, 0x91, 0x64, 0xe6, 0x6e, 0xe7, 0xb0, 0x55, 0x26, 0xaf,
+	0x08, 0x76, 0x48, 0xfa, 0x72, 0x85, 0x74, 0x70, 0xf7, 0xb3, 0x83, 0x8e, 0xbe, 0xb3, 0x7f, 0x67,
+	0x67, 0xff, 0x4e, 0x0b, 0x08, 0xe0, 0x70, 0x7b, 0xb7, 0x63, 0x74, 0x3b, 0xfa, 0x76, 0xa7, 0xdb,
+	0xaa, 0x12, 0xc0, 0xde, 0xfa, 0x6e, 0x67, 0xcb, 0xd8, 0xed, 0xe8, 0x77, 0x3a, 0xad, 0xda, 0x8d,
+	0x1f, 0x01, 0x54, 0x3f, 0x35, 0x3d, 0xdf, 0xd9, 0x35, 0xe9, 0xd5, 0xdb, 0xd7, 0xc8, 0x3e, 0x78,
+	0x68, 0x91, 0x6f, 0x7a, 0x56, 0x8e, 0x50, 0x70, 0x39, 0x1f, 0xfc, 0x00, 0xaa, 0xdd, 0x0a, 0x60,
+	0xfc, 0xa7, 0x42, 0xda, 0x0b, 0x57, 0x95, 0x77, 0x15, 0xf4, 0x11, 0x34, 0x44, 0x65, 0x96, 0xf2,
+	0x81, 0x16, 0x53, 0xfe, 0x1f, 0xd5, 0x5e, 0x48, 0xfc, 0x94, 0x88, 0xd7, 0x7f, 0x1f, 0xca, 0xe2,
+	0x3e, 0x9e, 0xd5, 0x8c, 0x25, 0xb6, 0xb4, 0x97, 0xd2, 0xae, 0xec, 0xb5, 0x17, 0xd0, 0x6d, 0xa8,
+	0x47, 0xae, 0x21, 0x11, 0xfb, 0x79, 0x52, 0xca, 0x75, 0x6f, 0xfb, 0x52, 0x0a, 0x46, 0x6e, 0x27,
+	0x72, 0xc1, 0xc7, 0xda, 0x49, 0xbb, 0x48, 0x6c, 0x5f, 0x4a, 0xc1, 0x04, 0xed, 0x6c, 0x43, 0x83,
+	0x9f, 0x87, 0x8a, 0x86, 0x58, 0xb7, 0x69, 0xd7, 0x7e, 0xed, 0x76, 0x1a, 0x2a, 0x68, 0xea, 0x03,
+	0x71, 0x30, 0x21, 0x5a, 0x5a, 0x08, 0x83, 0x25, 0xd1, 0x02, 0x92, 0x41, 0xd2, 0x60, 0x9a, 0x2c,
+	0x1f, 0x3c, 0x88, 0xbe, 0x90, 0x1c, 0x4b, 0xcb, 0x31, 0x5e, 0x7b, 0x25, 0x89, 0x08, 0xda, 0xd9,
+	0x84, 0x9a, 0x1c, 0x1f, 0xb1, 0x46, 0x52, 0xa2, 0xb0, 0xf6, 0x4a, 0x12, 0x11, 0x34, 0xf2, 0x09,
+	0x54, 0xa5, 0x2b, 0x27, 0x74, 0x51, 0xe4, 0x0a, 0x44, 0x6f, 0xca, 0xda, 0xcb, 0x09, 0x78, 0xd0,
+	0xc2, 0x4d, 0x28, 0xf1, 0xdf, 0x5d, 0x32, 0x9d, 0x8c, 0xfe, 0xd8, 0xb3, 0xbd, 0x18, 0x81, 0x05,
+	0xb5, 0x3e, 0x04, 0x08, 0x2f, 0x7e, 0x10, 0x4d, 0x18, 0x48, 0x5c, 0x45, 0xb5, 0x2f, 0xc6, 0xc1,
+	0x41, 0xf5, 0x01, 0x2c, 0xcf, 0x70, 0xba, 0x88, 0x26, 0x14, 0x65, 0x47, 0x82, 0xed, 0xd7, 0x32,
+	0x69, 0x82, 0x5e, 0xbe, 0x05, 0x4b, 0x69, 0xb7, 0x31, 0x88, 0x26, 0x77, 0x65, 0xdc, 0x15, 0xb5,
+	0x57, 0x67, 0x13, 0xc8, 0x92, 0x97, 0xee, 0x55, 0x99, 0xe4, 0x93, 0x57, 0xc0, 0xed, 0xe5, 0x04,
+	0x5c, 0xd6, 0xe6, 0xe8, 0x2d, 0x28, 0xd3, 0xe6, 0xd4, 0x0b, 0xd8, 0x76, 0x3b, 0x0d, 0x15, 0x34,
+	0xf5, 0x3e, 0x94, 0x85, 0x07, 0x61, 0x2b, 0x3c, 0xe6, 0xb5, 0xda, 0x4b, 0x51, 0x60, 0x50, 0xf1,
+	0xcb, 0x50, 0x64, 0xde, 0x80, 0xe9, 0x7f, 0xc4, 0x75, 0xb4, 0x91, 0x0c, 0x0a, 0xaa, 0xdc, 0x82,
+	0x4a, 0x60, 0xd4, 0xd1, 0x52, 0xa8, 0x9b, 0x52, 0xc5, 0x0b, 0x31, 0x68, 0x50, 0xf7, 0x0a, 0x11,
+	0x5a, 0x6f, 0x3a, 0xe4, 0x56, 0xb1, 0x42, 0xe8, 0xe8, 0xa5, 0x5e, 0x3b, 0xfc, 0xd4, 0x5e, 0xb8,
+	0xf1, 0xbf, 0x55, 0x00, 0x6a, 0x3d, 0x99, 0x7a, 0xdd, 0x85, 0x7a, 0x24, 0xe9, 0x98, 0x99, 0x8f,
+	0xb4, 0x7c, 0xf0, 0xf6, 0xa5, 0x14, 0x8c, 0xe8, 0xfd, 0x5d, 0x05, 0x7d, 0x0c, 0x40, 0x12, 0x8f,
+	0x59, 0xfe, 0x28, 0x53, 0xdb, 0x44, 0x16, 0x71, 0xfb, 0x62, 0x1c, 0x2c, 0x35, 0xf0, 0x09, 0x54,
+	0xa5, 0x0c, 0x54, 0x36, 0xeb, 0xc9, 0x04, 0xd7, 0xf6, 0x72, 0x02, 0x1e, 0x88, 0x60, 0x03, 0x9a,
+	0xb1, 0xfc, 0x51, 0x44, 0xe7, 0x36, 0x3d, 0xa9, 0xb4, 0x4d, 0x53, 0xb5, 0xa5, 0x3c, 0xd0, 0x80,
+	0x8b, 0xf0, 0x9c, 0x9a, 0x73, 0x91, 0x38, 0xdf, 0x6f, 0x2f, 0x27, 0xe0, 0xb2, 0xee, 0x45, 0xef,
+	0xaf, 0x90, 0x64, 0x78, 0x53, 0x75, 0x2f, 0xfd, 0xba, 0x4b, 0x7b, 0x01, 0xed, 0x40, 0x33, 0x76,
+	0x49, 0x85, 0x64, 0xd3, 0x1b, 0x6f, 0xec, 0xc5, 0x54, 0x5c, 0xd0, 0xda, 0x5d, 0x91, 0xae, 0x10,
+	0x39, 0xaa, 0x7f, 0x92, 0xe5, 0xf5, 0xf5, 0x78, 0x92, 0x41, 0xf0, 0x9b, 0xa5, 0x27, 0x5e, 0x66,
+	0xdf, 0x82, 0x25, 0x61, 0x6f, 0xe4, 0x3b, 0x26, 0x66, 0x50, 0x32, 0xee, 0xdd, 0xda, 0xab, 0xb3,
+	0x09, 0x82, 0xc6, 0xbf, 0x09, 0x8b, 0x11, 0x0a, 0xb6, 0xfd, 0x40, 0xaf, 0x24, 0xaa, 0x46, 0xf6,
+	0x45, 0xed, 0xcb, 0x33, 0xf1, 0x33, 0xd9, 0xe6, 0x67, 0xf7, 0x29, 0x6c, 0x47, 0x6f, 0x0e, 0xda,
+	0xab, 0xb3, 0x09, 0x82, 0xc6, 0xf7, 0x84, 0x23, 0x15, 0xc2, 0x78, 0x29, 0xf4, 0x79, 0x29, 0x3a,
+	0xf9, 0xf2, 0x0c, 0xac, 0xec, 0x16, 0xe5, 0x3b, 0x0f, 0xd9, 0xb7, 0x46, 0x07, 0xbe, 0x92, 0x44,
+	0xc8, 0x01, 0x47, 0xe4, 0x9a, 0x02, 0xc9, 0xc4, 0xd1, 0x31, 0x5e, 0x4a, 0xc1, 0x04, 0xed, 0x9c,
+	0xce, 0x3c, 0x94, 0x10, 0xa3, 0xbd, 0x96, 0xe1, 0x8a, 0x62, 0x4a, 0xf0, 0xd6, 0x3c, 0xa4, 0x41,
+	0xd7, 0x0f, 0xe1, 0xe5, 0xcc, 0x7d, 0x29, 0xba, 0x9a, 0xd1, 0x5c, 0x54, 0x52, 0xd7, 0xe6, 0xa0,
+	0xfc, 0xff, 0x71, 0x9a, 0xaf, 0x03, 0x50, 0xfb, 0xcf, 0xec, 0xfa, 0x0c, 0xf3, 0xbf, 0xf1, 0x32,
+	0x94, 0x2d, 0x67, 0x8d, 0xfe, 0x75, 0x7c, 0x83, 0xf9, 0x81, 0x03, 0xd7, 0xf1, 0x9d, 0x03, 0xe5,
+	0xcf, 0x55, 0xf5, 0xd3, 0x6e, 0xaf, 0x48, 0xff, 0x44, 0xfe, 0xde, 0xff, 0x0d, 0x00, 0xd4, 0x67,
+	0xad, 0x06, 0x98, 0x5c, 0x00, 0x00,
 }
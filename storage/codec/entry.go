@@ -2,7 +2,11 @@ package codec
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"github.com/chrislusf/glog"
+	"hash/crc32"
+	"math"
 	"time"
 )
 
@@ -18,15 +22,44 @@ type Entry struct {
 	Value         []byte
 }
 
+// The legacy layout is a 21-byte header followed by the value:
+//
+//	PartitionHash(8) UpdatedAtNs(8) TtlSecond(4) OpAndDataType(1)
+//
+// The versioned layout sets the high bit of the OpAndDataType byte, and
+// appends a format version byte and a crc32 checksum to the header:
+//
+//	PartitionHash(8) UpdatedAtNs(8) TtlSecond(4) OpAndDataType|0x80(1) Version(1) Checksum(4)
+//
+// The checksum covers the first 22 header bytes and the value.
+// The partition hash stays at the front so it can be read without decoding.
+const (
+	// EntryFormatVersion is the format version written by ToBytes
+	EntryFormatVersion = 1
+
+	legacyHeaderLength    = 21
+	versionedHeaderLength = 26
+	versionedFlag         = 0x80
+)
+
+var (
+	// ErrChecksumMismatch is returned when the stored checksum does not match the entry content
+	ErrChecksumMismatch = errors.New("entry checksum mismatch")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
 // ToBytes serializes the entry into bytes
 func (e *Entry) ToBytes() []byte {
-	b := make([]byte, len(e.Value)+21)
+	b := make([]byte, len(e.Value)+versionedHeaderLength)
 
 	binary.LittleEndian.PutUint64(b, e.PartitionHash)
 	binary.LittleEndian.PutUint64(b[8:], e.UpdatedAtNs)
 	binary.LittleEndian.PutUint32(b[16:], e.TtlSecond)
-	b[20] = byte(e.OpAndDataType)
-	copy(b[21:], e.Value)
+	b[20] = byte(e.OpAndDataType) | versionedFlag
+	b[21] = EntryFormatVersion
+	copy(b[versionedHeaderLength:], e.Value)
+	binary.LittleEndian.PutUint32(b[22:], checksum(b))
 
 	return b
 }
@@ -34,21 +67,71 @@ func (e *Entry) ToBytes() []byte {
 // FromBytes deserialize bytes into one Entry
 func FromBytes(b []byte) *Entry {
 
-	if len(b) <= 21 {
-		glog.Errorf("failed to decode entry: %x", b)
+	entry, err := Decode(b)
+	if err != nil {
+		glog.Errorf("failed to decode entry %x: %v", b, err)
 		return nil
 	}
 
+	return entry
+
+}
+
+// Decode deserialize bytes in either the legacy or the versioned layout into one Entry.
+// The checksum of the versioned layout is verified.
+func Decode(b []byte) (*Entry, error) {
+
+	if IsLegacyFormat(b) {
+		if len(b) <= legacyHeaderLength {
+			return nil, fmt.Errorf("legacy entry too short: %d bytes", len(b))
+		}
+		return &Entry{
+			PartitionHash: binary.LittleEndian.Uint64(b[0:8]),
+			UpdatedAtNs:   binary.LittleEndian.Uint64(b[8:16]),
+			TtlSecond:     binary.LittleEndian.Uint32(b[16:20]),
+			OpAndDataType: OpAndDataType(b[20]),
+			Value:         b[21:],
+		}, nil
+	}
+
+	if len(b) < versionedHeaderLength {
+		return nil, fmt.Errorf("entry too short: %d bytes", len(b))
+	}
+	if b[21] != EntryFormatVersion {
+		return nil, fmt.Errorf("unknown entry format version %d", b[21])
+	}
+	if binary.LittleEndian.Uint32(b[22:26]) != checksum(b) {
+		return nil, ErrChecksumMismatch
+	}
+
 	return &Entry{
 		PartitionHash: binary.LittleEndian.Uint64(b[0:8]),
 		UpdatedAtNs:   binary.LittleEndian.Uint64(b[8:16]),
 		TtlSecond:     binary.LittleEndian.Uint32(b[16:20]),
-		OpAndDataType: OpAndDataType(b[20]),
-		Value:         b[21:],
-	}
+		OpAndDataType: OpAndDataType(b[20] &^ versionedFlag),
+		Value:         b[versionedHeaderLength:],
+	}, nil
 
 }
 
+// IsLegacyFormat checks whether the bytes are written in the legacy layout without version and checksum.
+func IsLegacyFormat(b []byte) bool {
+	return len(b) <= legacyHeaderLength || b[20]&versionedFlag == 0
+}
+
+// Migrate rewrites bytes in the legacy layout into the current layout.
+// It returns false if the bytes are already in the current layout.
+func Migrate(b []byte) (migrated []byte, changed bool, err error) {
+	if !IsLegacyFormat(b) {
+		return b, false, nil
+	}
+	entry, err := Decode(b)
+	if err != nil {
+		return nil, false, err
+	}
+	return entry.ToBytes(), true, nil
+}
+
 // GetPartitionHashFromBytes reads the partition hash directly from bytes
 func GetPartitionHashFromBytes(b []byte) uint64 {
 	return binary.LittleEndian.Uint64(b[0:8])
@@ -59,6 +142,43 @@ func GetPartitionHashFromBytes(b []byte) uint64 {
 func (e *Entry) IsExpired() bool {
 
 	return e.TtlSecond > 0 &&
-		e.UpdatedAtNs+uint64(e.TtlSecond*1e9) < uint64(time.Now().UnixNano())
+		e.UpdatedAtNs+uint64(e.TtlSecond)*1e9 < uint64(time.Now().UnixNano())
+
+}
+
+// ExpireAt sets the ttl so that the entry expires at the time, without changing the updated_at time.
+// 0 expiresAtNs removes the ttl.
+func (e *Entry) ExpireAt(expiresAtNs uint64) {
+	if expiresAtNs == 0 {
+		e.TtlSecond = 0
+		return
+	}
+	if expiresAtNs <= e.UpdatedAtNs {
+		// the earliest expiry possible, since 0 ttl means no ttl
+		e.TtlSecond = 1
+		return
+	}
+	// round up so the entry does not expire earlier than requested
+	ttlSecond := (expiresAtNs - e.UpdatedAtNs + 1e9 - 1) / 1e9
+	if ttlSecond > math.MaxUint32 {
+		ttlSecond = math.MaxUint32
+	}
+	e.TtlSecond = uint32(ttlSecond)
+}
+
+// RemainingTtlSecond returns the seconds before the entry expires, or -1 if the entry has no ttl.
+func (e *Entry) RemainingTtlSecond(now time.Time) int64 {
+	if e.TtlSecond == 0 {
+		return -1
+	}
+	remaining := int64(e.UpdatedAtNs+uint64(e.TtlSecond)*1e9) - now.UnixNano()
+	if remaining < 0 {
+		return 0
+	}
+	return remaining / 1e9
+}
 
+func checksum(b []byte) uint32 {
+	crc := crc32.Update(0, crcTable, b[:22])
+	return crc32.Update(crc, crcTable, b[versionedHeaderLength:])
 }
@@ -0,0 +1,190 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// The SET value is the members added, followed by the members removed:
+//
+//	uvarint(len(adds)) [uvarint(len(member)) member]... uvarint(len(removes)) [uvarint(len(member)) member]...
+//
+// A set merge operand adds or removes members. A stored set value only has added members,
+// since the removed members are dropped after a full merge.
+//
+// The LIST value is the capacity followed by the items, oldest first:
+//
+//	uvarint(capacity) uvarint(len(items)) [uvarint(len(item)) item]...
+//
+// Merging a list appends the items and keeps only the last capacity items. 0 capacity means unbounded.
+
+// ErrInvalidCollection is returned when the value is not a valid set or list encoding
+var ErrInvalidCollection = errors.New("invalid collection encoding")
+
+// EncodeSet encodes the members to add and to remove as a SET value.
+func EncodeSet(adds, removes [][]byte) []byte {
+	b := appendMembers(nil, sortedMembers(adds))
+	return appendMembers(b, sortedMembers(removes))
+}
+
+// DecodeSet decodes a SET value into the members added and removed.
+func DecodeSet(b []byte) (adds, removes [][]byte, err error) {
+	if adds, b, err = readMembers(b); err != nil {
+		return nil, nil, err
+	}
+	if removes, b, err = readMembers(b); err != nil {
+		return nil, nil, err
+	}
+	return adds, removes, nil
+}
+
+// EncodeList encodes the items to append, and the maximum number of items to keep, as a LIST value.
+func EncodeList(capacity uint32, items [][]byte) []byte {
+	b := appendUvarint(nil, uint64(capacity))
+	return appendMembers(b, items)
+}
+
+// DecodeList decodes a LIST value into the capacity and the items.
+func DecodeList(b []byte) (capacity uint32, items [][]byte, err error) {
+	c, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, nil, ErrInvalidCollection
+	}
+	if items, _, err = readMembers(b[n:]); err != nil {
+		return 0, nil, err
+	}
+	return uint32(c), items, nil
+}
+
+// mergeSet applies the set operand y after e.
+// Both can be merge operands, so the removes are kept for the partial merges.
+func (e *Entry) mergeSet(y *Entry) bool {
+	yAdds, yRemoves, err := DecodeSet(y.Value)
+	if err != nil {
+		return false
+	}
+	eAdds, eRemoves, err := DecodeSet(e.Value)
+	if err != nil || e.OpAndDataType != y.OpAndDataType {
+		e.OpAndDataType = y.OpAndDataType
+		eAdds, eRemoves = nil, nil
+	}
+
+	// adds = (eAdds - yRemoves) + yAdds, removes = (eRemoves - yAdds) + yRemoves
+	adds := subtractMembers(eAdds, yRemoves)
+	removes := subtractMembers(eRemoves, yAdds)
+	e.Value = EncodeSet(append(adds, yAdds...), append(removes, yRemoves...))
+
+	return true
+}
+
+// mergeList appends the items of the list operand y after e, and keeps the last capacity items of y.
+func (e *Entry) mergeList(y *Entry) bool {
+	capacity, yItems, err := DecodeList(y.Value)
+	if err != nil {
+		return false
+	}
+	_, eItems, err := DecodeList(e.Value)
+	if err != nil || e.OpAndDataType != y.OpAndDataType {
+		e.OpAndDataType = y.OpAndDataType
+		eItems = nil
+	}
+
+	items := append(eItems, yItems...)
+	if capacity > 0 && len(items) > int(capacity) {
+		items = items[len(items)-int(capacity):]
+	}
+	e.Value = EncodeList(capacity, items)
+
+	return true
+}
+
+// canPartialMergeList checks whether two list operands can be combined into one.
+// If the right capacity is larger, the left capacity would have been applied to the existing items,
+// which can not be expressed by one operand.
+func canPartialMergeList(left, right *Entry) bool {
+	leftCapacity, _, err := DecodeList(left.Value)
+	if err != nil {
+		return false
+	}
+	rightCapacity, _, err := DecodeList(right.Value)
+	if err != nil {
+		return false
+	}
+	return rightCapacity == 0 && leftCapacity == 0 || rightCapacity != 0 && (leftCapacity == 0 || rightCapacity <= leftCapacity)
+}
+
+// FinalizeMerge drops the removed set members once all operands are merged with the existing value.
+func (e *Entry) FinalizeMerge() {
+	if e.OpAndDataType != OpAndDataType(pb.OpAndDataType_SET) {
+		return
+	}
+	adds, _, err := DecodeSet(e.Value)
+	if err != nil {
+		return
+	}
+	e.Value = EncodeSet(adds, nil)
+}
+
+func appendMembers(b []byte, members [][]byte) []byte {
+	b = appendUvarint(b, uint64(len(members)))
+	for _, m := range members {
+		b = appendUvarint(b, uint64(len(m)))
+		b = append(b, m...)
+	}
+	return b
+}
+
+func appendUvarint(b []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], x)
+	return append(b, buf[:n]...)
+}
+
+func readMembers(b []byte) (members [][]byte, rest []byte, err error) {
+	count, n := binary.Uvarint(b)
+	if n <= 0 {
+		return nil, nil, ErrInvalidCollection
+	}
+	b = b[n:]
+	for i := uint64(0); i < count; i++ {
+		size, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b)-n) < size {
+			return nil, nil, ErrInvalidCollection
+		}
+		members = append(members, b[n:n+int(size)])
+		b = b[n+int(size):]
+	}
+	return members, b, nil
+}
+
+// sortedMembers de-duplicates and sorts the members
+func sortedMembers(members [][]byte) [][]byte {
+	seen := make(map[string]bool, len(members))
+	var result [][]byte
+	for _, m := range members {
+		if !seen[string(m)] {
+			seen[string(m)] = true
+			result = append(result, m)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return string(result[i]) < string(result[j])
+	})
+	return result
+}
+
+func subtractMembers(members, toRemove [][]byte) (result [][]byte) {
+	removed := make(map[string]bool, len(toRemove))
+	for _, m := range toRemove {
+		removed[string(m)] = true
+	}
+	for _, m := range members {
+		if !removed[string(m)] {
+			result = append(result, m)
+		}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package codec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func setEntry(adds, removes []string) []byte {
+	return (&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_SET),
+		Value:         EncodeSet(toMembers(adds), toMembers(removes)),
+	}).ToBytes()
+}
+
+func listEntry(capacity uint32, items ...string) []byte {
+	return (&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_LIST),
+		Value:         EncodeList(capacity, toMembers(items)),
+	}).ToBytes()
+}
+
+func toMembers(members []string) (result [][]byte) {
+	for _, m := range members {
+		result = append(result, []byte(m))
+	}
+	return
+}
+
+func toStrings(members [][]byte) (result []string) {
+	for _, m := range members {
+		result = append(result, string(m))
+	}
+	return
+}
+
+func TestMergeSet(t *testing.T) {
+
+	existing := setEntry([]string{"a", "b"}, nil)
+	add := setEntry([]string{"c", "a"}, nil)
+	remove := setEntry(nil, []string{"b", "c"})
+	addAgain := setEntry([]string{"c"}, nil)
+
+	// full merge, applying the operands one by one
+	entry, merged := MergeEntry(existing, add)
+	if !merged || !entry.MergeWith(remove) || !entry.MergeWith(addAgain) {
+		t.Fatal("merge error")
+	}
+	entry.FinalizeMerge()
+	adds, removes, err := DecodeSet(entry.Value)
+	if err != nil {
+		t.Fatalf("decode set: %v", err)
+	}
+	if fmt.Sprintf("%v %v", toStrings(adds), toStrings(removes)) != "[a c] []" {
+		t.Errorf("full merge: %v %v", toStrings(adds), toStrings(removes))
+	}
+
+	// partial merges of the operands first
+	operand, merged := PartialMerge(add, remove)
+	if !merged {
+		t.Fatal("partial merge error")
+	}
+	if operand, merged = PartialMerge(operand, addAgain); !merged {
+		t.Fatal("partial merge error")
+	}
+	entry, merged = MergeEntry(existing, operand)
+	if !merged {
+		t.Fatal("merge error")
+	}
+	entry.FinalizeMerge()
+	adds, _, _ = DecodeSet(entry.Value)
+	if fmt.Sprintf("%v", toStrings(adds)) != "[a c]" {
+		t.Errorf("partial merge: %v", toStrings(adds))
+	}
+
+}
+
+func TestMergeList(t *testing.T) {
+
+	mergedBytes, merged := Merge(listEntry(3, "a", "b"), listEntry(3, "c", "d"))
+	if !merged {
+		t.Fatal("merge error")
+	}
+	capacity, items, err := DecodeList(FromBytes(mergedBytes).Value)
+	if err != nil {
+		t.Fatalf("decode list: %v", err)
+	}
+	if capacity != 3 || fmt.Sprintf("%v", toStrings(items)) != "[b c d]" {
+		t.Errorf("merge list: %d %v", capacity, toStrings(items))
+	}
+
+	// the first push to a new list is also trimmed to the capacity
+	entry, merged := MergeEntry(nil, listEntry(2, "a", "b", "c"))
+	if !merged {
+		t.Fatal("merge error")
+	}
+	if capacity, items, _ = DecodeList(entry.Value); capacity != 2 || fmt.Sprintf("%v", toStrings(items)) != "[b c]" {
+		t.Errorf("new list: %d %v", capacity, toStrings(items))
+	}
+
+	if _, merged = PartialMerge(listEntry(2, "a"), listEntry(3, "b")); merged {
+		t.Errorf("partial merge should fail when the capacity increases")
+	}
+	if _, merged = PartialMerge(listEntry(3, "a"), listEntry(2, "b")); !merged {
+		t.Errorf("partial merge should succeed when the capacity decreases")
+	}
+
+}
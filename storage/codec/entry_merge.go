@@ -9,17 +9,68 @@ import (
 func Merge(a, b []byte) (mergedBytes []byte, merged bool) {
 
 	x, merged := MergeEntry(a, b)
+	if !merged {
+		return nil, false
+	}
 	return x.ToBytes(), merged
 
 }
 
+// PartialMerge merges two merge operands into one, if the result is the same as applying them in order.
+func PartialMerge(left, right []byte) (mergedBytes []byte, merged bool) {
+
+	x, y := FromBytes(left), FromBytes(right)
+	if x == nil || y == nil {
+		return nil, false
+	}
+	if x.OpAndDataType == OpAndDataType(pb.OpAndDataType_LIST) && y.OpAndDataType == OpAndDataType(pb.OpAndDataType_LIST) {
+		if !canPartialMergeList(x, y) {
+			return nil, false
+		}
+	}
+	if x.OpAndDataType == OpAndDataType(pb.OpAndDataType_NAMED_MERGE) || y.OpAndDataType == OpAndDataType(pb.OpAndDataType_NAMED_MERGE) {
+		if x.OpAndDataType != y.OpAndDataType {
+			return nil, false
+		}
+		if x, merged = partialMergeNamed(x, y); !merged {
+			return nil, false
+		}
+		return x.ToBytes(), true
+	}
+	return Merge(left, right)
+
+}
+
 // MergeEntry merges two []byte into one Entry object.
 func MergeEntry(a, b []byte) (mergedEntry *Entry, merged bool) {
 	if a == nil {
-		return FromBytes(b), true
+		x := FromBytes(b)
+		if x != nil && x.OpAndDataType == OpAndDataType(pb.OpAndDataType_NAMED_MERGE) {
+			// the merge function decides the value of a new key
+			created := &Entry{
+				PartitionHash: x.PartitionHash,
+				UpdatedAtNs:   x.UpdatedAtNs,
+				TtlSecond:     x.TtlSecond,
+			}
+			return created, created.mergeNamed(x)
+		}
+		if x != nil && x.OpAndDataType == OpAndDataType(pb.OpAndDataType_LIST) {
+			// a new list also keeps only the last capacity items
+			created := &Entry{
+				PartitionHash: x.PartitionHash,
+				UpdatedAtNs:   x.UpdatedAtNs,
+				TtlSecond:     x.TtlSecond,
+				OpAndDataType: x.OpAndDataType,
+			}
+			return created, created.mergeList(x)
+		}
+		return x, x != nil
 	}
 
 	x := FromBytes(a)
+	if x == nil {
+		return nil, false
+	}
 
 	merged = x.MergeWith(b)
 
@@ -34,6 +85,14 @@ func (e *Entry) MergeWith(b []byte) (merged bool) {
 	}
 
 	y := FromBytes(b)
+	if y == nil {
+		return false
+	}
+
+	if isInt64DataType(y.OpAndDataType) != isInt64DataType(e.OpAndDataType) {
+		// the int64 values are big endian, and can not be merged with other types
+		return false
+	}
 
 	switch y.OpAndDataType {
 	case OpAndDataType(pb.OpAndDataType_BYTES):
@@ -51,8 +110,41 @@ func (e *Entry) MergeWith(b []byte) (merged bool) {
 		if left > right {
 			e.Value = y.Value
 		}
+	case OpAndDataType(pb.OpAndDataType_INT64):
+		if len(e.Value) != 8 || len(y.Value) != 8 {
+			e.Value = y.Value
+			break
+		}
+		result := util.BytesToInt64(e.Value) + util.BytesToInt64(y.Value)
+		e.Value = util.Int64ToBytes(result)
+	case OpAndDataType(pb.OpAndDataType_MAX_INT64):
+		if len(e.Value) != 8 || len(y.Value) != 8 || util.BytesToInt64(e.Value) < util.BytesToInt64(y.Value) {
+			e.Value = y.Value
+		}
+	case OpAndDataType(pb.OpAndDataType_MIN_INT64):
+		if len(e.Value) != 8 || len(y.Value) != 8 || util.BytesToInt64(e.Value) > util.BytesToInt64(y.Value) {
+			e.Value = y.Value
+		}
+	case OpAndDataType(pb.OpAndDataType_SET):
+		return e.mergeSet(y)
+	case OpAndDataType(pb.OpAndDataType_LIST):
+		return e.mergeList(y)
+	case OpAndDataType(pb.OpAndDataType_HYPERLOGLOG):
+		return e.mergeHyperLogLog(y)
+	case OpAndDataType(pb.OpAndDataType_TIME_SERIES):
+		return e.mergeTimeSeries(y)
+	case OpAndDataType(pb.OpAndDataType_NAMED_MERGE):
+		return e.mergeNamed(y)
 	}
 
 	return true
 
 }
+
+func isInt64DataType(t OpAndDataType) bool {
+	switch t {
+	case OpAndDataType(pb.OpAndDataType_INT64), OpAndDataType(pb.OpAndDataType_MAX_INT64), OpAndDataType(pb.OpAndDataType_MIN_INT64):
+		return true
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package codec
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/util"
@@ -134,3 +135,157 @@ func TestMergeEntry(t *testing.T) {
 	assert.Equal(t, aEntry.Value, mergedEntry.Value, "left nil merge")
 
 }
+
+func TestMergeInt64(t *testing.T) {
+
+	mergedBytes, merged := Merge((&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_INT64),
+		Value:         util.Int64ToBytes(1<<53 + 1),
+	}).ToBytes(), (&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_INT64),
+		Value:         util.Int64ToBytes(-2),
+	}).ToBytes())
+
+	if !merged {
+		t.Error("merge error")
+	}
+
+	mergedEntry := FromBytes(mergedBytes)
+
+	mergedValue := util.BytesToInt64(mergedEntry.Value)
+
+	if mergedValue != 1<<53-1 {
+		t.Errorf("merge error: %d %d", mergedValue, int64(1<<53-1))
+	}
+}
+
+func TestMergeMaxMinInt64(t *testing.T) {
+
+	for _, x := range []struct {
+		dataType pb.OpAndDataType
+		expected int64
+	}{
+		{pb.OpAndDataType_MAX_INT64, 345},
+		{pb.OpAndDataType_MIN_INT64, -234},
+	} {
+		mergedBytes, merged := Merge((&Entry{
+			OpAndDataType: OpAndDataType(x.dataType),
+			Value:         util.Int64ToBytes(-234),
+		}).ToBytes(), (&Entry{
+			OpAndDataType: OpAndDataType(x.dataType),
+			Value:         util.Int64ToBytes(345),
+		}).ToBytes())
+
+		if !merged {
+			t.Error("merge error")
+		}
+
+		mergedValue := util.BytesToInt64(FromBytes(mergedBytes).Value)
+
+		if mergedValue != x.expected {
+			t.Errorf("merge %v error: %d %d", x.dataType, mergedValue, x.expected)
+		}
+	}
+}
+
+func TestMergeInt64MismatchedType(t *testing.T) {
+
+	float64Entry := &Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_FLOAT64),
+		Value:         util.Float64ToBytes(1.5),
+	}
+
+	for _, dataType := range []pb.OpAndDataType{pb.OpAndDataType_INT64, pb.OpAndDataType_MAX_INT64, pb.OpAndDataType_MIN_INT64} {
+		_, merged := Merge(float64Entry.ToBytes(), (&Entry{
+			OpAndDataType: OpAndDataType(dataType),
+			Value:         util.Int64ToBytes(1),
+		}).ToBytes())
+		if merged {
+			t.Errorf("merged %v onto a float64 value", dataType)
+		}
+	}
+
+	int64Entry := &Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_INT64),
+		Value:         util.Int64ToBytes(1),
+	}
+
+	for _, dataType := range []pb.OpAndDataType{pb.OpAndDataType_FLOAT64, pb.OpAndDataType_MAX_FLOAT64, pb.OpAndDataType_MIN_FLOAT64} {
+		_, merged := Merge(int64Entry.ToBytes(), (&Entry{
+			OpAndDataType: OpAndDataType(dataType),
+			Value:         util.Float64ToBytes(1.5),
+		}).ToBytes())
+		if merged {
+			t.Errorf("merged %v onto an int64 value", dataType)
+		}
+	}
+
+	// the int64 merge types share the same encoding
+	mergedBytes, merged := Merge((&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_INT64),
+		Value:         util.Int64ToBytes(3),
+	}).ToBytes(), (&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_MAX_INT64),
+		Value:         util.Int64ToBytes(5),
+	}).ToBytes())
+	if !merged || util.BytesToInt64(FromBytes(mergedBytes).Value) != 5 {
+		t.Errorf("merge max int64 onto int64 error")
+	}
+
+}
+
+func init() {
+	RegisterMergeFunction("test_bitwise_or", func(existingValue, operand []byte) ([]byte, bool) {
+		merged := make([]byte, len(operand))
+		copy(merged, operand)
+		for i := 0; i < len(existingValue) && i < len(merged); i++ {
+			merged[i] |= existingValue[i]
+		}
+		return merged, true
+	}, func(leftOperand, rightOperand []byte) ([]byte, bool) {
+		if len(leftOperand) != len(rightOperand) {
+			return nil, false
+		}
+		merged := make([]byte, len(rightOperand))
+		for i := range merged {
+			merged[i] = leftOperand[i] | rightOperand[i]
+		}
+		return merged, true
+	})
+}
+
+func namedMergeEntry(name string, value []byte) []byte {
+	return (&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_NAMED_MERGE),
+		Value:         EncodeNamedMerge(name, value),
+	}).ToBytes()
+}
+
+func TestMergeNamed(t *testing.T) {
+
+	mergedBytes, merged := Merge(namedMergeEntry("test_bitwise_or", []byte{0x01, 0x10}), namedMergeEntry("test_bitwise_or", []byte{0x02, 0x20}))
+	if !merged {
+		t.Fatal("merge error")
+	}
+	name, value, err := DecodeNamedMerge(FromBytes(mergedBytes).Value)
+	if err != nil || name != "test_bitwise_or" || !bytes.Equal(value, []byte{0x03, 0x30}) {
+		t.Errorf("merged: %s %x %v", name, value, err)
+	}
+
+	partialBytes, merged := PartialMerge(namedMergeEntry("test_bitwise_or", []byte{0x04}), namedMergeEntry("test_bitwise_or", []byte{0x08}))
+	if !merged {
+		t.Fatal("partial merge error")
+	}
+	if _, value, _ = DecodeNamedMerge(FromBytes(partialBytes).Value); !bytes.Equal(value, []byte{0x0c}) {
+		t.Errorf("partial merged: %x", value)
+	}
+
+	if _, merged = Merge(namedMergeEntry("test_bitwise_or", []byte{0x01}), namedMergeEntry("unknown", []byte{0x02})); merged {
+		t.Errorf("unknown merge function should fail")
+	}
+
+	if err = ValidateNamedMerge(&pb.MergeRequest{OpAndDataType: pb.OpAndDataType_NAMED_MERGE, MergeFunction: "unknown"}); err == nil {
+		t.Errorf("unknown merge function should be rejected")
+	}
+
+}
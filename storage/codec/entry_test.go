@@ -41,3 +41,101 @@ func TestPutRequestConversion(t *testing.T) {
 	}
 
 }
+
+func TestLegacyFormatConversion(t *testing.T) {
+
+	entry := &Entry{
+		PartitionHash: 234234234,
+		UpdatedAtNs:   uint64(time.Now().UnixNano()),
+		TtlSecond:     60,
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_FLOAT64),
+		Value:         util.Float64ToBytes(999),
+	}
+
+	legacyBytes := make([]byte, 21+len(entry.Value))
+	copy(legacyBytes, entry.ToBytes()[:20])
+	legacyBytes[20] = byte(entry.OpAndDataType)
+	copy(legacyBytes[21:], entry.Value)
+
+	if !IsLegacyFormat(legacyBytes) {
+		t.Errorf("legacy format not detected: %x", legacyBytes)
+	}
+
+	legacyEntry, err := Decode(legacyBytes)
+	if err != nil {
+		t.Fatalf("decode legacy format: %v", err)
+	}
+	if legacyEntry.PartitionHash != entry.PartitionHash || legacyEntry.TtlSecond != entry.TtlSecond ||
+		legacyEntry.OpAndDataType != entry.OpAndDataType || !bytes.Equal(legacyEntry.Value, entry.Value) {
+		t.Errorf("decode legacy format: %+v, expected %+v", legacyEntry, entry)
+	}
+
+	migratedBytes, changed, err := Migrate(legacyBytes)
+	if err != nil || !changed {
+		t.Fatalf("migrate legacy format: %v %v", changed, err)
+	}
+	if !bytes.Equal(migratedBytes, entry.ToBytes()) {
+		t.Errorf("migrate legacy format: %x, expected %x", migratedBytes, entry.ToBytes())
+	}
+
+	if _, changed, _ = Migrate(migratedBytes); changed {
+		t.Error("migrate current format should not change anything")
+	}
+
+}
+
+func TestChecksumMismatch(t *testing.T) {
+
+	entryBytes := (&Entry{
+		PartitionHash: 234234234,
+		UpdatedAtNs:   uint64(time.Now().UnixNano()),
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("some value"),
+	}).ToBytes()
+
+	if _, err := Decode(entryBytes); err != nil {
+		t.Errorf("decode: %v", err)
+	}
+
+	entryBytes[len(entryBytes)-1] ^= 0x01
+
+	if _, err := Decode(entryBytes); err != ErrChecksumMismatch {
+		t.Errorf("expecting checksum mismatch, but got %v", err)
+	}
+
+	if FromBytes(entryBytes) != nil {
+		t.Error("corrupted entry should not be decoded")
+	}
+
+	if _, merged := Merge(entryBytes, entryBytes); merged {
+		t.Error("corrupted entry should not be merged")
+	}
+
+}
+
+func TestExpireAt(t *testing.T) {
+
+	now := time.Now()
+	entry := &Entry{
+		UpdatedAtNs: uint64(now.Add(-time.Hour).UnixNano()),
+		TtlSecond:   60,
+	}
+
+	if !entry.IsExpired() {
+		t.Error("entry should be expired")
+	}
+
+	entry.ExpireAt(uint64(now.Add(10 * time.Minute).UnixNano()))
+	if entry.IsExpired() {
+		t.Error("touched entry should not be expired")
+	}
+	if remaining := entry.RemainingTtlSecond(now); remaining < 599 || remaining > 600 {
+		t.Errorf("remaining ttl: %d", remaining)
+	}
+
+	entry.ExpireAt(0)
+	if entry.TtlSecond != 0 || entry.RemainingTtlSecond(now) != -1 {
+		t.Errorf("persisted entry ttl: %d", entry.TtlSecond)
+	}
+
+}
@@ -0,0 +1,175 @@
+package codec
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/chrislusf/vasto/util"
+)
+
+// HyperLogLog estimates the number of distinct elements with 2^14 registers, about 0.8% standard error.
+//
+// The HYPERLOGLOG value is either sparse, for sketches with few elements, or dense:
+//
+//	0x01 [uint16(register index) uint8(rank)]...   sorted by the register index
+//	0x02 [uint8(rank)] * 2^14
+//
+// Merging two sketches keeps the maximum rank of each register, so merges can be applied in any order,
+// and replaying the same merge is harmless.
+type HyperLogLog struct {
+	registers map[uint16]uint8 // sparse registers, nil if dense
+	dense     []uint8
+}
+
+const (
+	hllPrecision    = 14
+	hllRegisters    = 1 << hllPrecision
+	hllSparseFormat = 1
+	hllDenseFormat  = 2
+	hllSparseEntry  = 3
+)
+
+// NewHyperLogLog creates an empty sketch
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{
+		registers: make(map[uint16]uint8),
+	}
+}
+
+// DecodeHyperLogLog decodes the sketch from the HYPERLOGLOG value
+func DecodeHyperLogLog(b []byte) (*HyperLogLog, error) {
+	if len(b) == 0 {
+		return nil, ErrInvalidCollection
+	}
+	switch b[0] {
+	case hllSparseFormat:
+		if (len(b)-1)%hllSparseEntry != 0 {
+			return nil, ErrInvalidCollection
+		}
+		h := NewHyperLogLog()
+		for i := 1; i < len(b); i += hllSparseEntry {
+			index := binary.BigEndian.Uint16(b[i:])
+			if index >= hllRegisters {
+				return nil, ErrInvalidCollection
+			}
+			h.set(index, b[i+2])
+		}
+		return h, nil
+	case hllDenseFormat:
+		if len(b) != 1+hllRegisters {
+			return nil, ErrInvalidCollection
+		}
+		dense := make([]uint8, hllRegisters)
+		copy(dense, b[1:])
+		return &HyperLogLog{dense: dense}, nil
+	}
+	return nil, ErrInvalidCollection
+}
+
+// Add adds one element to the sketch
+func (h *HyperLogLog) Add(element []byte) {
+	x := util.Hash(element)
+	index := uint16(x >> (64 - hllPrecision))
+	rank := uint8(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1)) + 1)
+	h.set(index, rank)
+}
+
+// Merge unions the other sketch into this sketch
+func (h *HyperLogLog) Merge(other *HyperLogLog) {
+	if other.dense != nil {
+		for index, rank := range other.dense {
+			if rank > 0 {
+				h.set(uint16(index), rank)
+			}
+		}
+		return
+	}
+	for index, rank := range other.registers {
+		h.set(index, rank)
+	}
+}
+
+// Count returns the estimated number of distinct elements
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(hllRegisters)
+	sum, zeros := 0.0, 0
+	for i := 0; i < hllRegisters; i++ {
+		rank := h.get(uint16(i))
+		if rank == 0 {
+			zeros++
+		}
+		sum += 1 / float64(uint64(1)<<rank)
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// linear counting for small cardinalities
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+// Bytes encodes the sketch as a HYPERLOGLOG value
+func (h *HyperLogLog) Bytes() []byte {
+	if h.dense != nil {
+		b := make([]byte, 1+hllRegisters)
+		b[0] = hllDenseFormat
+		copy(b[1:], h.dense)
+		return b
+	}
+	indexes := make([]int, 0, len(h.registers))
+	for index := range h.registers {
+		indexes = append(indexes, int(index))
+	}
+	sort.Ints(indexes)
+	b := make([]byte, 1, 1+hllSparseEntry*len(indexes))
+	b[0] = hllSparseFormat
+	for _, index := range indexes {
+		b = append(b, byte(index>>8), byte(index), h.registers[uint16(index)])
+	}
+	return b
+}
+
+func (h *HyperLogLog) get(index uint16) uint8 {
+	if h.dense != nil {
+		return h.dense[index]
+	}
+	return h.registers[index]
+}
+
+func (h *HyperLogLog) set(index uint16, rank uint8) {
+	if h.dense != nil {
+		if h.dense[index] < rank {
+			h.dense[index] = rank
+		}
+		return
+	}
+	if h.registers[index] < rank {
+		h.registers[index] = rank
+	}
+	if len(h.registers)*hllSparseEntry > hllRegisters {
+		// switch to dense when the sparse encoding is no longer smaller
+		h.dense = make([]uint8, hllRegisters)
+		for i, r := range h.registers {
+			h.dense[i] = r
+		}
+		h.registers = nil
+	}
+}
+
+// mergeHyperLogLog unions the sketch y into e
+func (e *Entry) mergeHyperLogLog(y *Entry) bool {
+	other, err := DecodeHyperLogLog(y.Value)
+	if err != nil {
+		return false
+	}
+	h, err := DecodeHyperLogLog(e.Value)
+	if err != nil || e.OpAndDataType != y.OpAndDataType {
+		e.OpAndDataType = y.OpAndDataType
+		h = NewHyperLogLog()
+	}
+	h.Merge(other)
+	e.Value = h.Bytes()
+	return true
+}
@@ -0,0 +1,61 @@
+package codec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func TestHyperLogLogCount(t *testing.T) {
+
+	for _, n := range []int{0, 10, 1000, 100000} {
+		h := NewHyperLogLog()
+		for i := 0; i < n; i++ {
+			h.Add([]byte(fmt.Sprintf("visitor%d", i)))
+			// duplicates should not change the estimate
+			h.Add([]byte(fmt.Sprintf("visitor%d", i)))
+		}
+
+		decoded, err := DecodeHyperLogLog(h.Bytes())
+		if err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+
+		count := float64(decoded.Count())
+		if count < float64(n)*0.97 || count > float64(n)*1.03 {
+			t.Errorf("count %d: estimated %v", n, count)
+		}
+	}
+
+}
+
+func TestMergeHyperLogLog(t *testing.T) {
+
+	var merged []byte
+	for i := 0; i < 2000; i++ {
+		h := NewHyperLogLog()
+		h.Add([]byte(fmt.Sprintf("visitor%d", i%1000)))
+		operand := (&Entry{
+			OpAndDataType: OpAndDataType(pb.OpAndDataType_HYPERLOGLOG),
+			Value:         h.Bytes(),
+		}).ToBytes()
+		if merged == nil {
+			merged = operand
+			continue
+		}
+		var ok bool
+		if merged, ok = Merge(merged, operand); !ok {
+			t.Fatal("merge error")
+		}
+	}
+
+	h, err := DecodeHyperLogLog(FromBytes(merged).Value)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if count := h.Count(); count < 970 || count > 1030 {
+		t.Errorf("merged count: %d, expecting about 1000", count)
+	}
+
+}
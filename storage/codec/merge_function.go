@@ -0,0 +1,150 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// MergeFunction merges the operand into the existing value, and returns the merged value.
+// existingValue is nil if the key does not exist, or holds a value of another data type.
+// Return false if the operand can not be merged, which is treated as an error by rocksdb.
+type MergeFunction func(existingValue, operand []byte) ([]byte, bool)
+
+// PartialMergeFunction combines two operands into one, if the result is the same as merging them in order.
+// Return false if they can not be combined, and they will be merged one by one.
+type PartialMergeFunction func(leftOperand, rightOperand []byte) ([]byte, bool)
+
+type namedMergeFunction struct {
+	fullMerge    MergeFunction
+	partialMerge PartialMergeFunction
+}
+
+var (
+	mergeFunctions     = make(map[string]*namedMergeFunction)
+	mergeFunctionsLock sync.RWMutex
+
+	// ErrInvalidNamedMerge error when the NAMED_MERGE value is malformed
+	ErrInvalidNamedMerge = errors.New("invalid named merge value")
+)
+
+// RegisterMergeFunction makes a merge function available by the name, for the NAMED_MERGE data type.
+// It should be called before the store starts, usually in an init() function of the store binary.
+// partialMerge is optional. If the name is empty or registered twice, it panics.
+//
+// All stores of the cluster must register the same functions, since the replicas
+// apply the merges independently.
+func RegisterMergeFunction(name string, fullMerge MergeFunction, partialMerge PartialMergeFunction) {
+	mergeFunctionsLock.Lock()
+	defer mergeFunctionsLock.Unlock()
+	if name == "" {
+		panic("codec: RegisterMergeFunction with an empty name")
+	}
+	if fullMerge == nil {
+		panic("codec: RegisterMergeFunction " + name + " is nil")
+	}
+	if _, dup := mergeFunctions[name]; dup {
+		panic("codec: RegisterMergeFunction called twice for " + name)
+	}
+	mergeFunctions[name] = &namedMergeFunction{
+		fullMerge:    fullMerge,
+		partialMerge: partialMerge,
+	}
+}
+
+// HasMergeFunction checks whether the merge function is registered.
+func HasMergeFunction(name string) bool {
+	return getMergeFunction(name) != nil
+}
+
+func getMergeFunction(name string) *namedMergeFunction {
+	mergeFunctionsLock.RLock()
+	defer mergeFunctionsLock.RUnlock()
+	return mergeFunctions[name]
+}
+
+// EncodeNamedMerge encodes the NAMED_MERGE value as
+//
+//	uvarint(len(name)) name value
+func EncodeNamedMerge(name string, value []byte) []byte {
+	b := appendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(name)+len(value)), uint64(len(name)))
+	b = append(b, name...)
+	return append(b, value...)
+}
+
+// DecodeNamedMerge decodes the NAMED_MERGE value into the merge function name and the merged value
+func DecodeNamedMerge(b []byte) (name string, value []byte, err error) {
+	nameLength, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b)-n) < nameLength {
+		return "", nil, ErrInvalidNamedMerge
+	}
+	return string(b[n : n+int(nameLength)]), b[n+int(nameLength):], nil
+}
+
+// mergeNamed merges the NAMED_MERGE entry y into e, by the merge function named in y.
+// The existing value is dropped if it is of another data type or another merge function.
+func (e *Entry) mergeNamed(y *Entry) bool {
+	name, operand, err := DecodeNamedMerge(y.Value)
+	if err != nil {
+		return false
+	}
+	f := getMergeFunction(name)
+	if f == nil {
+		return false
+	}
+	var existing []byte
+	if e.OpAndDataType == y.OpAndDataType {
+		if existingName, existingValue, err := DecodeNamedMerge(e.Value); err == nil && existingName == name {
+			existing = existingValue
+		}
+	}
+	merged, ok := f.fullMerge(existing, operand)
+	if !ok {
+		return false
+	}
+	e.OpAndDataType = y.OpAndDataType
+	e.Value = EncodeNamedMerge(name, merged)
+	return true
+}
+
+// partialMergeNamed combines two NAMED_MERGE operands of the same merge function.
+func partialMergeNamed(x, y *Entry) (*Entry, bool) {
+	leftName, left, err := DecodeNamedMerge(x.Value)
+	if err != nil {
+		return nil, false
+	}
+	rightName, right, err := DecodeNamedMerge(y.Value)
+	if err != nil || leftName != rightName {
+		return nil, false
+	}
+	f := getMergeFunction(leftName)
+	if f == nil || f.partialMerge == nil {
+		return nil, false
+	}
+	merged, ok := f.partialMerge(left, right)
+	if !ok {
+		return nil, false
+	}
+	x.Value = EncodeNamedMerge(leftName, merged)
+	return x, true
+}
+
+// ValidateNamedMerge checks the merge function of the merge request is registered.
+func ValidateNamedMerge(m *pb.MergeRequest) error {
+	if m.OpAndDataType != pb.OpAndDataType_NAMED_MERGE {
+		if m.MergeFunction != "" {
+			return fmt.Errorf("merge function %s with data type %v", m.MergeFunction, m.OpAndDataType)
+		}
+		return nil
+	}
+	if m.MergeFunction == "" {
+		return fmt.Errorf("missing merge function name")
+	}
+	if !HasMergeFunction(m.MergeFunction) {
+		return fmt.Errorf("unknown merge function %s", m.MergeFunction)
+	}
+	return nil
+}
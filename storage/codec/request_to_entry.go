@@ -15,10 +15,19 @@ func NewPutEntry(put *pb.PutRequest, updatedAtNs uint64) *Entry {
 
 // NewMergeEntry creates an Entry from pb.MergeRequest
 func NewMergeEntry(m *pb.MergeRequest, updatedAtNs uint64) *Entry {
+	if m.OpAndDataType == pb.OpAndDataType_NAMED_MERGE {
+		return &Entry{
+			PartitionHash: m.PartitionHash,
+			UpdatedAtNs:   updatedAtNs,
+			TtlSecond:     m.TtlSecond,
+			OpAndDataType: OpAndDataType(m.OpAndDataType),
+			Value:         EncodeNamedMerge(m.MergeFunction, m.Value),
+		}
+	}
 	return &Entry{
 		PartitionHash: m.PartitionHash,
 		UpdatedAtNs:   updatedAtNs,
-		TtlSecond:     0,
+		TtlSecond:     m.TtlSecond,
 		OpAndDataType: OpAndDataType(m.OpAndDataType),
 		Value:         m.Value,
 	}
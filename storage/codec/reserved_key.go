@@ -0,0 +1,29 @@
+package codec
+
+import (
+	"bytes"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+// Key prefixes reserved by the store. Values under these keys are maintained by the store itself,
+// and are not always encoded as entries.
+var (
+	InternalKeyPrefix  = []byte("_vasto.")
+	IndexKeyPrefix     = []byte("_vasto_index.")
+	SortedSetKeyPrefix = []byte("_vasto_zset.")
+	ExpiryKeyPrefix    = []byte("_vasto_expiry.")
+)
+
+// IsInternalKey checks whether the key is one of the store's own records, which are not encoded as entries.
+// The other reserved keys are encoded as entries, with the partition hash of the client key.
+func IsInternalKey(key []byte) bool {
+	return bytes.HasPrefix(key, InternalKeyPrefix)
+}
+
+// IsReservedKey checks whether the key is maintained by the store, instead of written by the clients.
+func IsReservedKey(key []byte) bool {
+	return bytes.HasPrefix(key, InternalKeyPrefix) || pb.IsChunkKey(key) ||
+		bytes.HasPrefix(key, IndexKeyPrefix) || bytes.HasPrefix(key, SortedSetKeyPrefix) ||
+		bytes.HasPrefix(key, ExpiryKeyPrefix)
+}
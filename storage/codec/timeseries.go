@@ -0,0 +1,140 @@
+package codec
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+)
+
+// TimeSeries is a block of points, sorted by the timestamp in milliseconds.
+//
+// The TIME_SERIES value is encoded as
+//
+//	0x01 uvarint(retentionSecond) uvarint(len(points)) [varint(timestamp delta) uvarint(value bits xor previous value bits)]...
+//
+// Consecutive timestamps and similar values make the deltas small.
+// Merging two blocks keeps all points, and the later block wins if both have the same timestamp.
+// Points older than the retention are dropped by the compaction filter. 0 retention keeps all points.
+type TimeSeries struct {
+	RetentionSecond uint32
+	Points          []TimeSeriesPoint
+}
+
+// TimeSeriesPoint is one point in the time series
+type TimeSeriesPoint struct {
+	TimestampMs int64
+	Value       float64
+}
+
+const timeSeriesFormat = 1
+
+// Bytes encodes the time series as a TIME_SERIES value
+func (ts *TimeSeries) Bytes() []byte {
+	b := []byte{timeSeriesFormat}
+	b = appendUvarint(b, uint64(ts.RetentionSecond))
+	b = appendUvarint(b, uint64(len(ts.Points)))
+	var buf [binary.MaxVarintLen64]byte
+	var lastTimestamp int64
+	var lastBits uint64
+	for _, p := range ts.Points {
+		n := binary.PutVarint(buf[:], p.TimestampMs-lastTimestamp)
+		b = append(b, buf[:n]...)
+		bits := math.Float64bits(p.Value)
+		b = appendUvarint(b, bits^lastBits)
+		lastTimestamp, lastBits = p.TimestampMs, bits
+	}
+	return b
+}
+
+// DecodeTimeSeries decodes the TIME_SERIES value
+func DecodeTimeSeries(b []byte) (*TimeSeries, error) {
+	if len(b) == 0 || b[0] != timeSeriesFormat {
+		return nil, ErrInvalidCollection
+	}
+	b = b[1:]
+	retention, n := binary.Uvarint(b)
+	if n <= 0 {
+		return nil, ErrInvalidCollection
+	}
+	b = b[n:]
+	count, n := binary.Uvarint(b)
+	if n <= 0 || count > uint64(len(b)) {
+		return nil, ErrInvalidCollection
+	}
+	b = b[n:]
+	ts := &TimeSeries{
+		RetentionSecond: uint32(retention),
+		Points:          make([]TimeSeriesPoint, 0, count),
+	}
+	var lastTimestamp int64
+	var lastBits uint64
+	for i := uint64(0); i < count; i++ {
+		delta, n := binary.Varint(b)
+		if n <= 0 {
+			return nil, ErrInvalidCollection
+		}
+		b = b[n:]
+		xor, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, ErrInvalidCollection
+		}
+		b = b[n:]
+		lastTimestamp, lastBits = lastTimestamp+delta, lastBits^xor
+		ts.Points = append(ts.Points, TimeSeriesPoint{
+			TimestampMs: lastTimestamp,
+			Value:       math.Float64frombits(lastBits),
+		})
+	}
+	return ts, nil
+}
+
+// Merge adds the points of the other time series, which also sets the retention.
+func (ts *TimeSeries) Merge(other *TimeSeries) {
+	ts.RetentionSecond = other.RetentionSecond
+
+	points := make([]TimeSeriesPoint, 0, len(ts.Points)+len(other.Points))
+	points = append(points, ts.Points...)
+	points = append(points, other.Points...)
+	// stable, so the later point stays after the earlier one with the same timestamp
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].TimestampMs < points[j].TimestampMs
+	})
+
+	ts.Points = points[:0]
+	for i, p := range points {
+		if i+1 < len(points) && points[i+1].TimestampMs == p.TimestampMs {
+			continue
+		}
+		ts.Points = append(ts.Points, p)
+	}
+}
+
+// Trim drops the points older than the retention. It returns true if any point is dropped.
+func (ts *TimeSeries) Trim(now time.Time) bool {
+	if ts.RetentionSecond == 0 || len(ts.Points) == 0 {
+		return false
+	}
+	cutoff := now.Add(-time.Duration(ts.RetentionSecond)*time.Second).UnixNano() / int64(time.Millisecond)
+	i := sort.Search(len(ts.Points), func(i int) bool {
+		return ts.Points[i].TimestampMs >= cutoff
+	})
+	ts.Points = ts.Points[i:]
+	return i > 0
+}
+
+// mergeTimeSeries adds the points of the time series y into e
+func (e *Entry) mergeTimeSeries(y *Entry) bool {
+	other, err := DecodeTimeSeries(y.Value)
+	if err != nil {
+		return false
+	}
+	ts, err := DecodeTimeSeries(e.Value)
+	if err != nil || e.OpAndDataType != y.OpAndDataType {
+		e.OpAndDataType = y.OpAndDataType
+		ts = &TimeSeries{}
+	}
+	ts.Merge(other)
+	e.Value = ts.Bytes()
+	return true
+}
@@ -0,0 +1,62 @@
+package codec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/vasto/pb"
+)
+
+func timeSeriesEntry(retentionSecond uint32, points ...TimeSeriesPoint) []byte {
+	return (&Entry{
+		OpAndDataType: OpAndDataType(pb.OpAndDataType_TIME_SERIES),
+		Value:         (&TimeSeries{RetentionSecond: retentionSecond, Points: points}).Bytes(),
+	}).ToBytes()
+}
+
+func TestMergeTimeSeries(t *testing.T) {
+
+	mergedBytes, merged := Merge(
+		timeSeriesEntry(0, TimeSeriesPoint{1000, 1.5}, TimeSeriesPoint{3000, -2}),
+		timeSeriesEntry(60, TimeSeriesPoint{2000, 7}, TimeSeriesPoint{3000, 4}),
+	)
+	if !merged {
+		t.Fatal("merge error")
+	}
+
+	ts, err := DecodeTimeSeries(FromBytes(mergedBytes).Value)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	expected := []TimeSeriesPoint{{1000, 1.5}, {2000, 7}, {3000, 4}}
+	if len(ts.Points) != len(expected) {
+		t.Fatalf("merged points: %v, expecting %v", ts.Points, expected)
+	}
+	for i, p := range ts.Points {
+		if p != expected[i] {
+			t.Errorf("point %d: %v, expecting %v", i, p, expected[i])
+		}
+	}
+	if ts.RetentionSecond != 60 {
+		t.Errorf("retention: %d", ts.RetentionSecond)
+	}
+
+}
+
+func TestTrimTimeSeries(t *testing.T) {
+
+	now := time.Unix(1000, 0)
+	ts := &TimeSeries{
+		RetentionSecond: 10,
+		Points:          []TimeSeriesPoint{{980000, 1}, {990000, 2}, {995000, 3}},
+	}
+
+	if !ts.Trim(now) {
+		t.Errorf("trim should drop points")
+	}
+	if len(ts.Points) != 2 || ts.Points[0].TimestampMs != 990000 {
+		t.Errorf("trimmed points: %v", ts.Points)
+	}
+
+}
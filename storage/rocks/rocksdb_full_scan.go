@@ -1,6 +1,7 @@
 package rocks
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/chrislusf/gorocksdb"
 	"github.com/chrislusf/vasto/pb"
@@ -9,6 +10,11 @@ import (
 
 // FullScan scan through all entries
 func (d *Rocks) FullScan(batchSize uint64, limit uint64, fn func([]*pb.RawKeyValue) error) error {
+	return d.FullScanAfter(nil, batchSize, limit, fn)
+}
+
+// FullScanAfter scan through all entries after the startAfterKey, or all entries if startAfterKey is empty
+func (d *Rocks) FullScanAfter(startAfterKey []byte, batchSize uint64, limit uint64, fn func([]*pb.RawKeyValue) error) error {
 	newClientCounter := atomic.AddInt32(&d.clientCounter, 1)
 	defer atomic.AddInt32(&d.clientCounter, -1)
 	if newClientCounter <= 0 {
@@ -23,9 +29,18 @@ func (d *Rocks) FullScan(batchSize uint64, limit uint64, fn func([]*pb.RawKeyVal
 
 	var rowCount uint64
 	rows := make([]*pb.RawKeyValue, 0, batchSize)
-	for iter.SeekToFirst(); iter.Valid(); iter.Next() {
+	if len(startAfterKey) == 0 {
+		iter.SeekToFirst()
+	} else {
+		iter.Seek(startAfterKey)
+	}
+	for ; iter.Valid(); iter.Next() {
 
 		k := iter.Key()
+		if len(startAfterKey) > 0 && bytes.Equal(k.Data(), startAfterKey) {
+			k.Free()
+			continue
+		}
 		v := iter.Value()
 
 		rowCount++
@@ -0,0 +1,60 @@
+package rocks
+
+import (
+	"fmt"
+	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/vasto/storage/codec"
+	"sync/atomic"
+)
+
+// MigrateEntryFormat rewrites all entries still in the legacy on-disk format into the current format.
+// Internal keys and values not encoded as entries are left untouched.
+// The same rewrite also happens to the compacted entries during each compaction.
+func (d *Rocks) MigrateEntryFormat() (migratedCount int64, err error) {
+	newClientCounter := atomic.AddInt32(&d.clientCounter, 1)
+	defer atomic.AddInt32(&d.clientCounter, -1)
+	if newClientCounter <= 0 {
+		return 0, ErrorShutdownInProgress
+	}
+
+	opts := gorocksdb.NewDefaultReadOptions()
+	opts.SetFillCache(false)
+	defer opts.Destroy()
+	iter := d.db.NewIterator(opts)
+	defer iter.Close()
+
+	for iter.SeekToFirst(); iter.Valid(); iter.Next() {
+
+		k := iter.Key()
+		if codec.IsInternalKey(k.Data()) {
+			k.Free()
+			continue
+		}
+
+		v := iter.Value()
+		if !codec.IsLegacyFormat(v.Data()) {
+			v.Free()
+			k.Free()
+			continue
+		}
+		migrated, changed, decodeErr := codec.Migrate(v.Data())
+		v.Free()
+		if decodeErr != nil || !changed {
+			// not an entry
+			k.Free()
+			continue
+		}
+
+		err = d.db.Put(d.wo, k.Data(), migrated)
+		k.Free()
+		if err != nil {
+			return migratedCount, fmt.Errorf("migrate entry format: %v", err)
+		}
+		migratedCount++
+	}
+
+	if err := iter.Err(); err != nil {
+		return migratedCount, fmt.Errorf("migrate entry format iterate: %v", err)
+	}
+	return migratedCount, nil
+}
@@ -0,0 +1,113 @@
+package rocks
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/chrislusf/gorocksdb"
+	"sync/atomic"
+)
+
+// RangeScan iterates through the entries between startKey and endKey, in the forward or reverse order.
+// Empty startKey means from the first entry, and empty endKey means till the last entry.
+// The iteration stops when fn returns false.
+func (d *Rocks) RangeScan(startKey []byte, startExclusive bool, endKey []byte, endInclusive bool, reverse bool,
+	fn func(key, value []byte) bool) error {
+	if newClientCounter := atomic.AddInt32(&d.clientCounter, 1); newClientCounter <= 0 {
+		atomic.AddInt32(&d.clientCounter, -1)
+		return ErrorShutdownInProgress
+	}
+
+	opts := gorocksdb.NewDefaultReadOptions()
+	opts.SetFillCache(false)
+	iter := d.db.NewIterator(opts)
+
+	var err error
+	if reverse {
+		err = d.enumerateReverse(iter, startKey, startExclusive, endKey, endInclusive, fn)
+	} else {
+		err = d.enumerateForward(iter, startKey, startExclusive, endKey, endInclusive, fn)
+	}
+
+	iter.Close()
+	opts.Destroy()
+
+	atomic.AddInt32(&d.clientCounter, -1)
+
+	return err
+}
+
+func (d *Rocks) enumerateForward(iter *gorocksdb.Iterator, startKey []byte, startExclusive bool, endKey []byte, endInclusive bool,
+	fn func(key, value []byte) bool) error {
+
+	if len(startKey) == 0 {
+		iter.SeekToFirst()
+	} else {
+		iter.Seek(startKey)
+		if startExclusive && iter.Valid() && bytes.Equal(iterKey(iter), startKey) {
+			iter.Next()
+		}
+	}
+
+	for ; iter.Valid(); iter.Next() {
+		key := iterKey(iter)
+		if len(endKey) > 0 {
+			if c := bytes.Compare(key, endKey); c > 0 || c == 0 && !endInclusive {
+				break
+			}
+		}
+		if !fn(key, iterValue(iter)) {
+			break
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("range scan iterator: %v", err)
+	}
+	return nil
+}
+
+func (d *Rocks) enumerateReverse(iter *gorocksdb.Iterator, startKey []byte, startExclusive bool, endKey []byte, endInclusive bool,
+	fn func(key, value []byte) bool) error {
+
+	if len(endKey) == 0 {
+		iter.SeekToLast()
+	} else {
+		iter.Seek(endKey)
+		if !iter.Valid() {
+			iter.SeekToLast()
+		} else if c := bytes.Compare(iterKey(iter), endKey); c > 0 || c == 0 && !endInclusive {
+			iter.Prev()
+		}
+	}
+
+	for ; iter.Valid(); iter.Prev() {
+		key := iterKey(iter)
+		if len(startKey) > 0 {
+			if c := bytes.Compare(key, startKey); c < 0 || c == 0 && startExclusive {
+				break
+			}
+		}
+		if !fn(key, iterValue(iter)) {
+			break
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("reverse range scan iterator: %v", err)
+	}
+	return nil
+}
+
+func iterKey(iter *gorocksdb.Iterator) []byte {
+	k := iter.Key()
+	key := []byte(string(k.Data()))
+	k.Free()
+	return key
+}
+
+func iterValue(iter *gorocksdb.Iterator) []byte {
+	v := iter.Value()
+	value := []byte(string(v.Data()))
+	v.Free()
+	return value
+}
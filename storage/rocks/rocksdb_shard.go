@@ -3,15 +3,17 @@ package rocks
 import (
 	"github.com/chrislusf/glog"
 	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
 	"github.com/dgryski/go-jump"
 	"time"
 )
 
 type shardingCompactionFilter struct {
-	shardId    int32
-	shardCount int
-	isResizing bool
+	shardId         int32
+	shardCount      int
+	isResizing      bool
+	retentionSecond uint32
 }
 
 func (m *shardingCompactionFilter) configure(shardId int32, shardCount int) {
@@ -21,6 +23,10 @@ func (m *shardingCompactionFilter) configure(shardId int32, shardCount int) {
 
 func (m *shardingCompactionFilter) Name() string { return "vasto.sharding" }
 func (m *shardingCompactionFilter) Filter(level int, key, val []byte) (bool, []byte) {
+	if codec.IsInternalKey(key) {
+		// maintained by the store, and not encoded into Entry
+		return false, nil
+	}
 	entry := codec.FromBytes(val)
 	if entry == nil {
 		// vasto specific entries not encoded into Entry
@@ -35,13 +41,28 @@ func (m *shardingCompactionFilter) Filter(level int, key, val []byte) (bool, []b
 			return true, nil
 		}
 	}
-	if entry.TtlSecond == 0 {
-		return false, nil
-	}
-	if entry.UpdatedAtNs/uint64(1000000)+uint64(entry.TtlSecond) < uint64(time.Now().Unix()) {
+	if entry.IsExpired() {
 		// glog.V(1).Infof("skipping updatedAt:%d, ttl:%d", entry.UpdatedAtNs/uint64(1000000), entry.TtlSecond, string(key), string(val))
 		return true, nil
 	}
+	if m.retentionSecond > 0 && entry.UpdatedAtNs+uint64(m.retentionSecond)*1e9 < uint64(time.Now().UnixNano()) {
+		// not updated within the keyspace retention
+		return true, nil
+	}
+	if entry.OpAndDataType == codec.OpAndDataType(pb.OpAndDataType_TIME_SERIES) {
+		// drop the points older than the retention
+		if ts, err := codec.DecodeTimeSeries(entry.Value); err == nil && ts.Trim(time.Now()) {
+			if len(ts.Points) == 0 {
+				return true, nil
+			}
+			entry.Value = ts.Bytes()
+			return false, entry.ToBytes()
+		}
+	}
+	if codec.IsLegacyFormat(val) {
+		// rewrite entries in the legacy format during compaction
+		return false, entry.ToBytes()
+	}
 	return false, nil
 }
 
@@ -51,6 +72,12 @@ func (d *Rocks) SetCompactionForShard(shardId, shardCount int) {
 	d.compactionFilter.configure(int32(shardId), shardCount)
 }
 
+// SetRetention purges the entries not updated for retentionSecond during compaction.
+// 0 means no retention.
+func (d *Rocks) SetRetention(retentionSecond uint32) {
+	d.compactionFilter.retentionSecond = retentionSecond
+}
+
 func (d *Rocks) PrepareForClusterResize() {
 	d.compactionFilter.isResizing = true
 }
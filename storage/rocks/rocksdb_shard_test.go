@@ -7,6 +7,7 @@ import (
 	"github.com/chrislusf/vasto/pb"
 	"github.com/chrislusf/vasto/storage/codec"
 	"github.com/chrislusf/vasto/util"
+	"github.com/dgryski/go-jump"
 	"github.com/magiconair/properties/assert"
 	"math"
 	"time"
@@ -76,3 +77,214 @@ func TestSetTtlCompactionForShard(t *testing.T) {
 	assert.Equal(t, counter4, 0, "compaction with ttl")
 
 }
+
+func TestMigrateEntryFormat(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	total := 1000
+	now := uint64(time.Now().UnixNano())
+
+	for i := 0; i < total; i++ {
+		key := []byte(fmt.Sprintf("k%5d", i))
+		value := []byte(fmt.Sprintf("v%5d", i))
+		entry := &codec.Entry{
+			PartitionHash: util.Hash(key),
+			UpdatedAtNs:   now,
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+			Value:         value,
+		}
+		if i%2 == 0 {
+			db.Put(key, entry.ToBytes())
+			continue
+		}
+		// the legacy layout without version and checksum
+		legacyBytes := make([]byte, 21+len(value))
+		copy(legacyBytes, entry.ToBytes()[:21])
+		legacyBytes[20] = byte(entry.OpAndDataType)
+		copy(legacyBytes[21:], value)
+		db.Put(key, legacyBytes)
+	}
+	db.Put([]byte("_vasto.internal"), util.Uint64toBytes(1234))
+
+	migratedCount, err := db.MigrateEntryFormat()
+	if err != nil {
+		t.Errorf("migrate entry format: %v", err)
+	}
+	assert.Equal(t, migratedCount, int64(total/2), "migrated count")
+
+	db.PrefixScan([]byte("k"), nil, 0, func(key, value []byte) bool {
+		if codec.IsLegacyFormat(value) {
+			t.Errorf("entry %s is not migrated", string(key))
+		}
+		return true
+	})
+
+	internal, _ := db.Get([]byte("_vasto.internal"))
+	assert.Equal(t, util.BytesToUint64(internal), uint64(1234), "internal value")
+
+}
+
+func TestTimeSeriesRetentionCompaction(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	nowMs := time.Now().UnixNano() / int64(time.Millisecond)
+
+	put := func(key string, timestamps ...int64) {
+		ts := &codec.TimeSeries{RetentionSecond: 60}
+		for _, timestamp := range timestamps {
+			ts.Points = append(ts.Points, codec.TimeSeriesPoint{TimestampMs: timestamp, Value: 1})
+		}
+		entry := &codec.Entry{
+			PartitionHash: util.Hash([]byte(key)),
+			UpdatedAtNs:   uint64(time.Now().UnixNano()),
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_TIME_SERIES),
+			Value:         ts.Bytes(),
+		}
+		db.Put([]byte(key), entry.ToBytes())
+	}
+	put("ts1", nowMs-120000, nowMs-30000, nowMs)
+	put("ts2", nowMs-120000)
+
+	db.SetCompactionForShard(0, 1)
+	db.Compact()
+
+	b, _ := db.Get([]byte("ts1"))
+	ts, err := codec.DecodeTimeSeries(codec.FromBytes(b).Value)
+	if err != nil {
+		t.Fatalf("decode time series: %v", err)
+	}
+	assert.Equal(t, len(ts.Points), 2, "points within retention")
+
+	b, _ = db.Get([]byte("ts2"))
+	assert.Equal(t, len(b), 0, "time series without points")
+
+}
+
+func TestRetentionCompaction(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	put := func(key string, updatedAt time.Time) {
+		entry := &codec.Entry{
+			PartitionHash: util.Hash([]byte(key)),
+			UpdatedAtNs:   uint64(updatedAt.UnixNano()),
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+			Value:         []byte(key),
+		}
+		db.Put([]byte(key), entry.ToBytes())
+	}
+	put("old", time.Now().Add(-2*time.Minute))
+	put("new", time.Now())
+
+	db.SetCompactionForShard(0, 1)
+	db.SetRetention(60)
+	db.Compact()
+
+	b, _ := db.Get([]byte("old"))
+	assert.Equal(t, len(b), 0, "entry out of retention")
+
+	b, _ = db.Get([]byte("new"))
+	assert.Equal(t, len(b) > 0, true, "entry within retention")
+
+}
+
+func TestCompactionKeepsReservedKeys(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	// raw values, long enough to be mistaken for an entry header
+	internalKey := []byte("_vasto.bootstrap.checkpoint.x")
+	internalValue := []byte("not an entry, but longer than the entry header")
+	db.Put(internalKey, internalValue)
+
+	// an internal value that happens to look like a legacy entry
+	legacyKey := []byte("_vasto.legacy")
+	entry := &codec.Entry{
+		PartitionHash: util.Hash(legacyKey),
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("v"),
+	}
+	legacyValue := make([]byte, 22)
+	copy(legacyValue, entry.ToBytes()[:21])
+	legacyValue[20] = byte(entry.OpAndDataType)
+	legacyValue[21] = 'v'
+	db.Put(legacyKey, legacyValue)
+
+	for shardId := 0; shardId < 5; shardId++ {
+		db.SetCompactionForShard(shardId, 5)
+		db.Compact()
+	}
+
+	got, err := db.Get(internalKey)
+	if err != nil {
+		t.Fatalf("get internal key: %v", err)
+	}
+	assert.Equal(t, string(got), string(internalValue), "internal key after compaction")
+
+	got, _ = db.Get(legacyKey)
+	assert.Equal(t, got, legacyValue, "reserved key is not rewritten")
+
+	migrated, err := db.MigrateEntryFormat()
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	assert.Equal(t, migrated, int64(0), "reserved keys are not migrated")
+
+}
+
+func TestCompactionFiltersReservedEntries(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	shardCount := 5
+	now := uint64(time.Now().UnixNano())
+
+	// the reserved entries carry the partition hash of the client key
+	var keys [][]byte
+	for i := 0; i < 100; i++ {
+		clientKey := []byte(fmt.Sprintf("k%d", i))
+		key := append(append([]byte(nil), codec.IndexKeyPrefix...), clientKey...)
+		db.Put(key, (&codec.Entry{
+			PartitionHash: util.Hash(clientKey),
+			UpdatedAtNs:   now,
+			OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		}).ToBytes())
+		keys = append(keys, key)
+	}
+
+	expiredChunk := append(append([]byte(nil), pb.ChunkKeyPrefix...), "expired"...)
+	db.Put(expiredChunk, (&codec.Entry{
+		PartitionHash: 0,
+		UpdatedAtNs:   now - 2e9,
+		TtlSecond:     1,
+		OpAndDataType: codec.OpAndDataType(pb.OpAndDataType_BYTES),
+		Value:         []byte("chunk"),
+	}).ToBytes())
+
+	db.SetCompactionForShard(0, shardCount)
+	db.Compact()
+
+	kept := 0
+	for i, key := range keys {
+		b, _ := db.Get(key)
+		isInShard := jump.Hash(util.Hash([]byte(fmt.Sprintf("k%d", i))), shardCount) == 0
+		if isInShard {
+			kept++
+		}
+		assert.Equal(t, len(b) > 0, isInShard, fmt.Sprintf("index entry of k%d", i))
+	}
+	if kept == 0 {
+		t.Errorf("no index entries of shard 0 to keep")
+	}
+
+	b, _ := db.Get(expiredChunk)
+	assert.Equal(t, len(b), 0, "expired chunk")
+
+}
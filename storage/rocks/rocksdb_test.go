@@ -32,6 +32,27 @@ func TestPutGet(t *testing.T) {
 	}
 }
 
+func TestWriteBatch(t *testing.T) {
+	db := setupTestDb()
+	defer cleanup(db)
+
+	db.Put([]byte("k1"), []byte("v1"))
+
+	err := db.WriteBatch([]*pb.RawKeyValue{
+		{Key: []byte("k2"), Value: []byte("v2")},
+		{Key: []byte("k3"), Value: []byte("v3")},
+	}, [][]byte{[]byte("k1"), []byte("k3")})
+	if err != nil {
+		t.Errorf("write batch: %v", err)
+	}
+
+	for key, expected := range map[string]string{"k1": "", "k2": "v2", "k3": ""} {
+		if returned, _ := db.Get([]byte(key)); string(returned) != expected {
+			t.Errorf("get %s: %s, expecting %s", key, returned, expected)
+		}
+	}
+}
+
 func TestMerge(t *testing.T) {
 	db := setupTestDb()
 	defer cleanup(db)
@@ -152,6 +173,57 @@ func TestRangeScan(t *testing.T) {
 
 }
 
+func TestRangeScanWithBounds(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("k%3d", i))
+		value := []byte(fmt.Sprintf("v%3d", i))
+		db.Put(key, value)
+	}
+
+	scan := func(start string, startExclusive bool, end string, endInclusive bool, reverse bool) (keys []string) {
+		db.RangeScan([]byte(start), startExclusive, []byte(end), endInclusive, reverse, func(key, value []byte) bool {
+			keys = append(keys, string(key))
+			return true
+		})
+		return
+	}
+
+	keys := scan(fmt.Sprintf("k%3d", 10), false, fmt.Sprintf("k%3d", 20), false, false)
+	if len(keys) != 10 || keys[0] != fmt.Sprintf("k%3d", 10) || keys[9] != fmt.Sprintf("k%3d", 19) {
+		t.Errorf("forward scan [10, 20): %v", keys)
+	}
+
+	keys = scan(fmt.Sprintf("k%3d", 10), true, fmt.Sprintf("k%3d", 20), true, false)
+	if len(keys) != 10 || keys[0] != fmt.Sprintf("k%3d", 11) || keys[9] != fmt.Sprintf("k%3d", 20) {
+		t.Errorf("forward scan (10, 20]: %v", keys)
+	}
+
+	keys = scan(fmt.Sprintf("k%3d", 10), false, fmt.Sprintf("k%3d", 20), false, true)
+	if len(keys) != 10 || keys[0] != fmt.Sprintf("k%3d", 19) || keys[9] != fmt.Sprintf("k%3d", 10) {
+		t.Errorf("reverse scan [10, 20): %v", keys)
+	}
+
+	keys = scan(fmt.Sprintf("k%3d", 10), true, fmt.Sprintf("k%3d", 20), true, true)
+	if len(keys) != 10 || keys[0] != fmt.Sprintf("k%3d", 20) || keys[9] != fmt.Sprintf("k%3d", 11) {
+		t.Errorf("reverse scan (10, 20]: %v", keys)
+	}
+
+	keys = scan("", false, "", false, true)
+	if len(keys) != 100 || keys[0] != fmt.Sprintf("k%3d", 99) {
+		t.Errorf("reverse full scan: %d rows", len(keys))
+	}
+
+	keys = scan("", false, "z", false, true)
+	if len(keys) != 100 || keys[0] != fmt.Sprintf("k%3d", 99) {
+		t.Errorf("reverse scan after the last key: %d rows", len(keys))
+	}
+
+}
+
 func TestFullScan(t *testing.T) {
 
 	db := setupTestDb()
@@ -188,6 +260,40 @@ func TestFullScan(t *testing.T) {
 
 }
 
+func TestFullScanAfter(t *testing.T) {
+
+	db := setupTestDb()
+	defer cleanup(db)
+
+	for i := 0; i < 100; i++ {
+		db.Put([]byte(fmt.Sprintf("k%3d", i)), []byte("v"))
+	}
+
+	var keys []string
+	db.FullScanAfter([]byte(fmt.Sprintf("k%3d", 59)), 7, 0, func(rows []*pb.RawKeyValue) error {
+		for _, row := range rows {
+			keys = append(keys, string(row.Key))
+		}
+		return nil
+	})
+	if len(keys) != 40 || keys[0] != fmt.Sprintf("k%3d", 60) {
+		t.Errorf("full scan after k 59: %d rows %v", len(keys), keys)
+	}
+
+	// resume after a key that does not exist
+	keys = keys[:0]
+	db.FullScanAfter([]byte(fmt.Sprintf("k%3d", 59)+"a"), 7, 0, func(rows []*pb.RawKeyValue) error {
+		for _, row := range rows {
+			keys = append(keys, string(row.Key))
+		}
+		return nil
+	})
+	if len(keys) != 40 || keys[0] != fmt.Sprintf("k%3d", 60) {
+		t.Errorf("full scan after k 59a: %d rows", len(keys))
+	}
+
+}
+
 func setupTestDb() *Rocks {
 	db := NewDb("/tmp/rocks-test-go", &bytesMergeOperator{})
 	return db
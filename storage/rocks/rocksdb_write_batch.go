@@ -0,0 +1,28 @@
+package rocks
+
+import (
+	"github.com/chrislusf/gorocksdb"
+	"github.com/chrislusf/vasto/pb"
+	"sync/atomic"
+)
+
+// WriteBatch atomically applies the puts and then the deletes to local rocksdb
+func (d *Rocks) WriteBatch(puts []*pb.RawKeyValue, deletes [][]byte) (err error) {
+	if newClientCounter := atomic.AddInt32(&d.clientCounter, 1); newClientCounter <= 0 {
+		atomic.AddInt32(&d.clientCounter, -1)
+		return ErrorShutdownInProgress
+	}
+
+	wb := gorocksdb.NewWriteBatch()
+	for _, kv := range puts {
+		wb.Put(kv.Key, kv.Value)
+	}
+	for _, key := range deletes {
+		wb.Delete(key)
+	}
+	err = d.db.Write(d.wo, wb)
+	wb.Destroy()
+
+	atomic.AddInt32(&d.clientCounter, -1)
+	return
+}
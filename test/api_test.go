@@ -10,11 +10,24 @@ import (
 	m "github.com/chrislusf/vasto/cmd/master"
 	s "github.com/chrislusf/vasto/cmd/store"
 	"github.com/chrislusf/vasto/goclient/vs"
+	"github.com/chrislusf/vasto/pb"
+	"github.com/chrislusf/vasto/storage/codec"
 	"log"
 	"os"
 	"time"
 )
 
+func init() {
+	codec.RegisterMergeFunction("bitwise_or", func(existingValue, operand []byte) ([]byte, bool) {
+		merged := make([]byte, len(operand))
+		copy(merged, operand)
+		for i := 0; i < len(existingValue) && i < len(merged); i++ {
+			merged[i] |= existingValue[i]
+		}
+		return merged, true
+	}, nil)
+}
+
 func TestOpen(t *testing.T) {
 
 	masterPort := startMasterAndStore()
@@ -97,6 +110,398 @@ func TestOpen(t *testing.T) {
 		}
 	})
 
+	t.Run("int64", func(t *testing.T) {
+		k := vs.Key([]byte("counter1"))
+		big := int64(1<<53 + 1)
+		if err := ks.PutInt64(k, big); err != nil {
+			t.Errorf("put int64: %v", err)
+		}
+		x, err := ks.IncrementInt64(k, 2)
+		if err != nil || x != big+2 {
+			t.Errorf("increment int64: %d %v, expecting: %d", x, err, big+2)
+		}
+		x, err = ks.DecrementInt64(k, 5)
+		if err != nil || x != big-3 {
+			t.Errorf("decrement int64: %d %v, expecting: %d", x, err, big-3)
+		}
+		x, _ = ks.GetInt64(k)
+		if x != big-3 {
+			t.Errorf("get int64: %d, expecting: %d", x, big-3)
+		}
+
+		m := vs.Key([]byte("counter.max1"))
+		ks.PutMaxInt64(m, 7)
+		if x, _ = ks.PutMaxInt64(m, 3); x != 7 {
+			t.Errorf("put max int64: %d, expecting: %d", x, 7)
+		}
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		set := vs.Key([]byte("set1"))
+		ks.SetAdd(set, []byte("b"), []byte("a"))
+		ks.SetAdd(set, []byte("c"))
+		ks.SetRemove(set, []byte("b"))
+		members, err := ks.SetMembers(set)
+		if err != nil {
+			t.Errorf("set members: %v", err)
+		}
+		if fmt.Sprintf("%s", members) != "[a c]" {
+			t.Errorf("set members: %s, expecting: [a c]", members)
+		}
+
+		list := vs.Key([]byte("list1"))
+		ks.ListPush(list, 3, []byte("1"), []byte("2"))
+		ks.ListPush(list, 3, []byte("3"), []byte("4"))
+		items, err := ks.ListRange(list, 1, 0)
+		if err != nil {
+			t.Errorf("list range: %v", err)
+		}
+		if fmt.Sprintf("%s", items) != "[3 4]" {
+			t.Errorf("list range: %s, expecting: [3 4]", items)
+		}
+	})
+
+	t.Run("hyperloglog", func(t *testing.T) {
+		page1, page2 := vs.Key([]byte("visitors.page1")), vs.Key([]byte("visitors.page2"))
+		for i := 0; i < 100; i++ {
+			ks.PFAdd(page1, []byte(fmt.Sprintf("visitor%d", i)))
+			ks.PFAdd(page2, []byte(fmt.Sprintf("visitor%d", i+50)))
+		}
+		// the estimates are within a few percent
+		if count, err := ks.PFCount(page1); err != nil || count < 97 || count > 103 {
+			t.Errorf("pfcount: %d %v, expecting about 100", count, err)
+		}
+		if count, err := ks.PFCount(page1, page2, vs.Key([]byte("visitors.page3"))); err != nil || count < 145 || count > 155 {
+			t.Errorf("pfcount union: %d %v, expecting about 150", count, err)
+		}
+	})
+
+	t.Run("sorted set", func(t *testing.T) {
+		board := vs.Key([]byte("leaderboard1"))
+		ks.SortedSetAdd(board,
+			&pb.SortedSetMember{Member: []byte("alice"), Score: 30},
+			&pb.SortedSetMember{Member: []byte("bob"), Score: -5},
+			&pb.SortedSetMember{Member: []byte("carol"), Score: 20},
+			&pb.SortedSetMember{Member: []byte("dave"), Score: 10},
+		)
+		ks.SortedSetAdd(board, &pb.SortedSetMember{Member: []byte("bob"), Score: 25})
+		ks.SortedSetRemove(board, []byte("dave"))
+
+		members, err := ks.SortedSetTop(board, 2, false)
+		if err != nil {
+			t.Errorf("top: %v", err)
+		}
+		if len(members) != 2 || string(members[0].Member) != "alice" || string(members[1].Member) != "bob" || members[1].Score != 25 {
+			t.Errorf("top: %v", members)
+		}
+
+		if rank, err := ks.SortedSetRank(board, []byte("carol"), false); err != nil || rank != 2 {
+			t.Errorf("rank: %d %v, expecting: 2", rank, err)
+		}
+		if rank, err := ks.SortedSetRank(board, []byte("carol"), true); err != nil || rank != 0 {
+			t.Errorf("ascending rank: %d %v, expecting: 0", rank, err)
+		}
+		if _, err := ks.SortedSetRank(board, []byte("dave"), false); err != vs.ErrorNotFound {
+			t.Errorf("rank of removed member: %v", err)
+		}
+
+		members, err = ks.SortedSetRangeByScore(board, 20, 25, 0, true)
+		if err != nil {
+			t.Errorf("range by score: %v", err)
+		}
+		if len(members) != 2 || string(members[0].Member) != "carol" || string(members[1].Member) != "bob" {
+			t.Errorf("range by score: %v", members)
+		}
+	})
+
+	t.Run("time series", func(t *testing.T) {
+		series := vs.Key([]byte("cpu1"))
+		nowMs := time.Now().UnixNano() / int64(time.Millisecond)
+		start := nowMs - nowMs%60000 - 60000
+		ks.TimeSeriesAppend(series, time.Hour,
+			&pb.TimeSeriesPoint{TimestampMs: start, Value: 1},
+			&pb.TimeSeriesPoint{TimestampMs: start + 30000, Value: 3},
+		)
+		ks.TimeSeriesAppend(series, time.Hour,
+			&pb.TimeSeriesPoint{TimestampMs: start + 60000, Value: 10},
+			&pb.TimeSeriesPoint{TimestampMs: start + 30000, Value: 5},
+		)
+
+		points, err := ks.TimeSeriesRange(series, start, start+60000)
+		if err != nil {
+			t.Errorf("range: %v", err)
+		}
+		if len(points) != 2 || points[1].Value != 5 {
+			t.Errorf("range: %v", points)
+		}
+
+		buckets, err := ks.TimeSeriesDownsample(series, start, 0, 60000)
+		if err != nil {
+			t.Errorf("downsample: %v", err)
+		}
+		if len(buckets) != 2 || buckets[0].Count != 2 || buckets[0].Sum != 6 || buckets[0].Max != 5 || buckets[1].Min != 10 {
+			t.Errorf("downsample: %v", buckets)
+		}
+	})
+
+	t.Run("named merge", func(t *testing.T) {
+		k := vs.Key([]byte("flags1"))
+		if err := ks.MergeWithFunction(k, "bitwise_or", []byte{0x01}); err != nil {
+			t.Errorf("merge: %v", err)
+		}
+		ks.MergeWithFunction(k, "bitwise_or", []byte{0x04})
+
+		name, value, err := ks.GetMerged(k)
+		if err != nil || name != "bitwise_or" || bytes.Compare(value, []byte{0x05}) != 0 {
+			t.Errorf("get merged: %s %x %v", name, value, err)
+		}
+
+		if err := ks.MergeWithFunction(k, "unknown", []byte{0x02}); err == nil {
+			t.Errorf("unknown merge function should be rejected")
+		}
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		k := vs.Key([]byte("session1"))
+		ks.Put(k, []byte("token"))
+
+		if _, hasTtl, err := ks.GetTtl(k); err != nil || hasTtl {
+			t.Errorf("get ttl of new key: %v %v", hasTtl, err)
+		}
+
+		if err := ks.Touch(k, time.Hour); err != nil {
+			t.Errorf("touch: %v", err)
+		}
+		remaining, hasTtl, err := ks.GetTtl(k)
+		if err != nil || !hasTtl || remaining <= 59*time.Minute || remaining > time.Hour {
+			t.Errorf("get ttl after touch: %v %v %v", remaining, hasTtl, err)
+		}
+		if data, _, err := ks.Get(k); err != nil || string(data) != "token" {
+			t.Errorf("get touched value: %s %v", data, err)
+		}
+
+		if err := ks.Persist(k); err != nil {
+			t.Errorf("persist: %v", err)
+		}
+		if _, hasTtl, err := ks.GetTtl(k); err != nil || hasTtl {
+			t.Errorf("get ttl after persist: %v %v", hasTtl, err)
+		}
+
+		if err := ks.Touch(vs.Key([]byte("missing1")), time.Hour); err != vs.ErrorNotFound {
+			t.Errorf("touch missing key: %v", err)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		expired := make(chan []byte, 10)
+		go ks.SubscribeExpiry(ctx, []byte("session."), func(event *vs.ExpiryEvent) {
+			expired <- event.Key
+		})
+		time.Sleep(100 * time.Millisecond)
+
+		shortLived := ks.Clone()
+		shortLived.TtlSecond = 1
+		shortLived.Put(vs.Key([]byte("session.a")), []byte("token"))
+		shortLived.Put(vs.Key([]byte("other.a")), []byte("token"))
+
+		select {
+		case key := <-expired:
+			if string(key) != "session.a" {
+				t.Errorf("expired key: %s", key)
+			}
+		case <-ctx.Done():
+			t.Errorf("no expiry event received")
+		}
+	})
+
+	t.Run("chunked", func(t *testing.T) {
+		chunked := ks.Clone()
+		chunked.ChunkSizeByte = 10
+		k := vs.Key([]byte("large1"))
+		value := bytes.Repeat([]byte("0123456789abcdef"), 6)
+		if err := chunked.Put(k, value); err != nil {
+			t.Errorf("put chunked value: %v", err)
+		}
+		data, _, err := chunked.Get(k)
+		if err != nil {
+			t.Errorf("get chunked value: %v", err)
+		}
+		if bytes.Compare(data, value) != 0 {
+			t.Errorf("get chunked value: %s, expecting: %s", data, value)
+		}
+		if err := chunked.Delete(k); err != nil {
+			t.Errorf("delete chunked value: %v", err)
+		}
+		if _, _, err = chunked.Get(k); err != vs.ErrorNotFound {
+			t.Errorf("get deleted chunked value: %v", err)
+		}
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		for _, reverse := range []bool{false, true} {
+			it, err := ks.Scan(&vs.ScanOption{
+				StartKey:  []byte("x"),
+				EndKey:    []byte("y"),
+				Reverse:   reverse,
+				BatchSize: 1,
+			})
+			if err != nil {
+				t.Fatalf("scan: %v", err)
+			}
+			var keys []string
+			for it.Next() {
+				keys = append(keys, string(it.KeyValue().GetKey()))
+			}
+			if it.Err() != nil {
+				t.Errorf("scan: %v", it.Err())
+			}
+			expected := "[x1 x2 x3]"
+			if reverse {
+				expected = "[x3 x2 x1]"
+			}
+			if fmt.Sprintf("%v", keys) != expected {
+				t.Errorf("scan reverse=%v: %v, expecting: %v", reverse, keys, expected)
+			}
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		keyValues, err := ks.CollectByPrefixWithFilter([]byte("x"), 2, nil, &pb.ScanFilter{
+			KeyGlob:  "x*",
+			KeysOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("collect by prefix with filter: %v", err)
+		}
+		var keys []string
+		for _, kv := range keyValues {
+			keys = append(keys, string(kv.GetKey()))
+			if len(kv.GetValue()) != 0 {
+				t.Errorf("keys only %s: unexpected value %s", kv.GetKey(), kv.GetValue())
+			}
+		}
+		if fmt.Sprintf("%v", keys) != "[x1 x2]" {
+			t.Errorf("collect by prefix with filter: %v, expecting: [x1 x2]", keys)
+		}
+
+		it, err := ks.Scan(&vs.ScanOption{
+			Filter: &pb.ScanFilter{
+				Float64Range: &pb.ScanFilter_Float64Range{Min: 2, Max: 50},
+			},
+		})
+		if err != nil {
+			t.Fatalf("scan with filter: %v", err)
+		}
+		keys = nil
+		for it.Next() {
+			keys = append(keys, string(it.KeyValue().GetKey()))
+		}
+		if it.Err() != nil {
+			t.Errorf("scan with filter: %v", it.Err())
+		}
+		if fmt.Sprintf("%v", keys) != "[y1]" {
+			t.Errorf("scan with filter: %v, expecting: [y1]", keys)
+		}
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		result, err := ks.Aggregate([]byte("m"), nil)
+		if err != nil {
+			t.Fatalf("aggregate: %v", err)
+		}
+		// max1 is 100 and min1 is 1
+		if result.Count != 2 || result.Float64Count != 2 || result.Sum != 101 || result.Min != 1 || result.Max != 100 || result.Avg() != 50.5 {
+			t.Errorf("aggregate: %+v", result)
+		}
+
+		result, err = ks.AggregateByPartition([]byte("x1"), []byte("x"), nil)
+		if err != nil {
+			t.Fatalf("aggregate by partition: %v", err)
+		}
+		if result.Count != 3 || result.Float64Count != 0 || result.Avg() != 0 {
+			t.Errorf("aggregate by partition: %+v", result)
+		}
+	})
+
+	t.Run("index", func(t *testing.T) {
+		if err := c.DefineIndex("ks1", &pb.IndexDefinition{
+			Name:     "city",
+			Source:   pb.IndexDefinition_JSON_PATH,
+			JsonPath: "address.city",
+		}); err != nil {
+			t.Fatalf("define index: %v", err)
+		}
+		partitionKey := []byte("users")
+		put := func(key, value string) {
+			if err := ks.Put(vs.Key([]byte(key)).SetPartitionKey(partitionKey), []byte(value)); err != nil {
+				t.Errorf("put %s: %v", key, err)
+			}
+		}
+		lookup := func(term, expected string) {
+			keys, err := ks.LookupByIndex(partitionKey, "city", []byte(term), 0, nil)
+			if err != nil {
+				t.Errorf("lookup %s: %v", term, err)
+			}
+			var found []string
+			for _, key := range keys {
+				found = append(found, string(key))
+			}
+			if fmt.Sprintf("%v", found) != expected {
+				t.Errorf("lookup %s: %v, expecting: %v", term, found, expected)
+			}
+		}
+		put("user1", `{"address":{"city":"sf"}}`)
+		put("user2", `{"address":{"city":"la"}}`)
+		put("user3", `{"address":{"city":"sf"}}`)
+		lookup("sf", "[user1 user3]")
+
+		put("user1", `{"address":{"city":"la"}}`)
+		ks.Delete(vs.Key([]byte("user3")).SetPartitionKey(partitionKey))
+		lookup("sf", "[]")
+		lookup("la", "[user1 user2]")
+
+		if err := c.DropIndex("ks1", "city"); err != nil {
+			t.Errorf("drop index: %v", err)
+		}
+		if _, err := ks.LookupByIndex(partitionKey, "city", []byte("la"), 0, nil); err == nil {
+			t.Errorf("lookup dropped index should fail")
+		}
+	})
+
+	t.Run("keyspace settings", func(t *testing.T) {
+		if err := c.UpdateKeyspace("ks1", &pb.KeyspaceSettings{DefaultTtlSecond: 60, MaxTtlSecond: 3600}); err != nil {
+			t.Fatalf("update keyspace: %v", err)
+		}
+		defer c.UpdateKeyspace("ks1", &pb.KeyspaceSettings{})
+
+		k := vs.Key([]byte("defaultTtl1"))
+		if err := ks.Put(k, []byte("v")); err != nil {
+			t.Errorf("put: %v", err)
+		}
+		if remaining, hasTtl, err := ks.GetTtl(k); err != nil || !hasTtl || remaining > time.Minute || remaining < 59*time.Second {
+			t.Errorf("default ttl: %v %v %v", remaining, hasTtl, err)
+		}
+
+		longLived := ks.Clone()
+		longLived.TtlSecond = 7200
+		if err := longLived.Put(vs.Key([]byte("longTtl1")), []byte("v")); err == nil {
+			t.Errorf("put over the max ttl should fail")
+		}
+		if err := ks.Touch(k, 2*time.Hour); err == nil {
+			t.Errorf("touch over the max ttl should fail")
+		}
+		if err := ks.Persist(k); err == nil {
+			t.Errorf("persist with the max ttl should fail")
+		}
+
+		if err := c.UpdateKeyspace("ks1", &pb.KeyspaceSettings{DefaultTtlSecond: 7200, MaxTtlSecond: 3600}); err == nil {
+			t.Errorf("default ttl over the max ttl should fail")
+		}
+	})
+
 	os.RemoveAll("./ks1")
 }
 
@@ -109,17 +514,22 @@ func startMasterAndStore() int {
 	})
 
 	storeOption := &s.StoreOption{
-		Dir:               getString("."),
-		Host:              getString("localhost"),
-		ListenHost:        getString(""),
-		TcpPort:           getInt32(getPort()),
-		DisableUnixSocket: getBool(false),
-		Master:            getString(fmt.Sprintf("localhost:%d", masterPort)),
-		LogFileSizeMb:     getInt(128),
-		LogFileCount:      getInt(3),
-		DiskSizeGb:        getInt(10),
-		Tags:              getString(""),
-		DisableBinLog:     getBool(false),
+		Dir:                getString("."),
+		Host:               getString("localhost"),
+		ListenHost:         getString(""),
+		TcpPort:            getInt32(getPort()),
+		DisableUnixSocket:  getBool(false),
+		Master:             getString(fmt.Sprintf("localhost:%d", masterPort)),
+		DataCenter:         getString(""),
+		LogFileSizeMb:      getInt(128),
+		LogFileCount:       getInt(3),
+		DiskSizeGb:         getInt(10),
+		Tags:               getString(""),
+		Zone:               getString(""),
+		Rack:               getString(""),
+		DisableBinLog:      getBool(false),
+		MigrateEntryFormat: getBool(false),
+		MaxMessageSizeMb:   getInt(64),
 	}
 
 	go s.RunStore(storeOption)
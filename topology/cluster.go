@@ -17,6 +17,7 @@ type Cluster struct {
 	logicalShards     []LogicalShardGroup
 	expectedSize      int
 	replicationFactor int
+	shardCount        int
 	nextCluster       *Cluster
 }
 
@@ -60,7 +61,10 @@ func (cluster *Cluster) SetShard(store *pb.StoreResource, shard *pb.ShardInfo) (
 		StoreResource: store,
 		ShardInfo:     shard,
 	})
-	cluster.logicalShards[shardId] = sortedShards(shardGroup, len(cluster.logicalShards))
+	if cluster.shardCount != int(shard.ShardCount) {
+		cluster.SetShardCount(int(shard.ShardCount))
+	}
+	cluster.logicalShards[shardId] = sortedShards(shardGroup, cluster.ringSize())
 	if cluster.expectedSize != int(shard.ClusterSize) {
 		cluster.SetExpectedSize(int(shard.ClusterSize))
 	}
@@ -104,7 +108,7 @@ func (cluster *Cluster) RemoveShard(store *pb.StoreResource, shard *pb.ShardInfo
 			copy(shardGroup[i:], shardGroup[i+1:])
 			shardGroup[len(shardGroup)-1] = nil // or the zero value of T
 			shardGroup = shardGroup[:len(shardGroup)-1]
-			cluster.logicalShards[shardId] = sortedShards(shardGroup, len(cluster.logicalShards))
+			cluster.logicalShards[shardId] = sortedShards(shardGroup, cluster.ringSize())
 			break
 		}
 	}
@@ -142,7 +146,7 @@ func (cluster *Cluster) RemoveStore(store *pb.StoreResource) (removedShards []*p
 				i--
 			}
 		}
-		cluster.logicalShards[shardId] = sortedShards(shardGroup, len(cluster.logicalShards))
+		cluster.logicalShards[shardId] = sortedShards(shardGroup, cluster.ringSize())
 	}
 	return
 }
@@ -162,13 +166,17 @@ func (cluster *Cluster) isStoreInUse(store *pb.StoreResource) bool {
 	return false
 }
 
+// sortedShards sorts the copies of one shard by the distance from the primary server on the ring of clusterSize
 func sortedShards(shards LogicalShardGroup, clusterSize int) LogicalShardGroup {
+	if clusterSize == 0 {
+		return shards
+	}
 	sort.Slice(shards, func(i, j int) bool {
-		x := int(shards[i].ShardInfo.ServerId) - int(shards[i].ShardInfo.ShardId)
+		x := int(shards[i].ShardInfo.ServerId) - int(shards[i].ShardInfo.ShardId)%clusterSize
 		if x < 0 {
 			x += clusterSize
 		}
-		y := int(shards[j].ShardInfo.ServerId) - int(shards[j].ShardInfo.ShardId)
+		y := int(shards[j].ShardInfo.ServerId) - int(shards[j].ShardInfo.ShardId)%clusterSize
 		if y < 0 {
 			y += clusterSize
 		}
@@ -177,9 +185,17 @@ func sortedShards(shards LogicalShardGroup, clusterSize int) LogicalShardGroup {
 	return shards
 }
 
+// ringSize is the number of servers the shards are placed onto
+func (cluster *Cluster) ringSize() int {
+	if cluster.shardCount > 0 {
+		return cluster.expectedSize
+	}
+	return len(cluster.logicalShards)
+}
+
 // FindShardId calculates a Jump hash for the keyHash provided
 func (cluster *Cluster) FindShardId(keyHash uint64) int {
-	return int(jump.Hash(keyHash, cluster.expectedSize))
+	return int(jump.Hash(keyHash, cluster.LogicalShardCount()))
 }
 
 // ExpectedSize returns the expected size of the cluster
@@ -196,18 +212,51 @@ func (cluster *Cluster) ReplicationFactor() int {
 func (cluster *Cluster) SetExpectedSize(expectedSize int) {
 	if expectedSize > 0 {
 		cluster.expectedSize = expectedSize
+		logicalShardCount := LogicalShardCount(expectedSize, cluster.shardCount)
 		if len(cluster.logicalShards) == 0 {
-			cluster.logicalShards = make([]LogicalShardGroup, expectedSize)
+			cluster.logicalShards = make([]LogicalShardGroup, logicalShardCount)
 		}
-		if expectedSize < len(cluster.logicalShards) {
-			cluster.logicalShards = cluster.logicalShards[0:expectedSize]
+		if logicalShardCount < len(cluster.logicalShards) {
+			cluster.logicalShards = cluster.logicalShards[0:logicalShardCount]
 		}
 	}
 }
 
+// ShardCount returns the number of virtual shards, 0 if one shard per server
+func (cluster *Cluster) ShardCount() int {
+	return cluster.shardCount
+}
+
+// LogicalShardCount returns the number of shards the keys are hashed into
+func (cluster *Cluster) LogicalShardCount() int {
+	return LogicalShardCount(cluster.expectedSize, cluster.shardCount)
+}
+
+// SetShardCount sets the number of virtual shards, 0 if one shard per server
+func (cluster *Cluster) SetShardCount(shardCount int) {
+	cluster.shardCount = shardCount
+	if shardCount > len(cluster.logicalShards) {
+		nodes := make([]LogicalShardGroup, shardCount)
+		copy(nodes, cluster.logicalShards)
+		cluster.logicalShards = nodes
+	}
+}
+
+// DataCenter returns the data center of the cluster
+func (cluster *Cluster) DataCenter() string {
+	return cluster.dataCenter
+}
+
+// SetDataCenter sets the data center of the cluster
+func (cluster *Cluster) SetDataCenter(dataCenter string) {
+	cluster.dataCenter = dataCenter
+}
+
 // SetNextCluster creates a new cluster and sets the size and replication factor
 func (cluster *Cluster) SetNextCluster(expectedSize int, replicationFactor int) *Cluster {
 	cluster.nextCluster = NewCluster(cluster.keyspace, expectedSize, replicationFactor)
+	cluster.nextCluster.dataCenter = cluster.dataCenter
+	cluster.nextCluster.SetShardCount(cluster.shardCount)
 	return cluster.nextCluster
 }
 
@@ -228,8 +277,19 @@ func (cluster *Cluster) SetReplicationFactor(replicationFactor int) {
 	}
 }
 
-// CurrentSize returns the cluster current size
+// CurrentSize returns the number of servers having any shard of the cluster
 func (cluster *Cluster) CurrentSize() int {
+	servers := make(map[uint32]bool)
+	for _, shardGroup := range cluster.logicalShards {
+		for _, shard := range shardGroup {
+			servers[shard.ShardInfo.ServerId] = true
+		}
+	}
+	return len(servers)
+}
+
+// CurrentShardCount returns the number of shards, up to the last shard having any copy
+func (cluster *Cluster) CurrentShardCount() int {
 	for i := len(cluster.logicalShards); i > 0; i-- {
 		if len(cluster.logicalShards[i-1]) == 0 {
 			continue
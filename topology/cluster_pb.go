@@ -14,6 +14,9 @@ func (cluster *Cluster) ToCluster() *pb.Cluster {
 		Nodes:               cluster.toNodes(),
 		ExpectedClusterSize: uint32(cluster.ExpectedSize()),
 		CurrentClusterSize:  uint32(cluster.CurrentSize()),
+		ReplicationFactor:   uint32(cluster.ReplicationFactor()),
+		DataCenter:          cluster.dataCenter,
+		ShardCount:          uint32(cluster.shardCount),
 	}
 }
 
@@ -24,6 +24,8 @@ type BootstrapPlan struct {
 	TransitionalFollowSource []ClusterShard
 	FromClusterSize          int
 	ToClusterSize            int
+	// the replication factor to follow the peers with, 0 means the current one of the cluster
+	ToReplicationFactor int
 }
 
 // BootstrapPlanWithTopoChange builds the bootstrap plan based on the bootstrap request.
@@ -112,6 +114,40 @@ func BootstrapPlanWithTopoChange(req *BootstrapRequest) (plan *BootstrapPlan) {
 
 }
 
+// BootstrapPlanWithVirtualShards builds the bootstrap plan for the cluster with shardCount virtual shards.
+// The shards are not split when resizing, so the shard is copied as a whole from one of its existing copies.
+func BootstrapPlanWithVirtualShards(req *BootstrapRequest, shardCount int) (plan *BootstrapPlan) {
+	if shardCount == 0 {
+		return BootstrapPlanWithTopoChange(req)
+	}
+
+	plan = &BootstrapPlan{FromClusterSize: req.FromClusterSize, ToClusterSize: req.ToClusterSize}
+
+	if req.FromClusterSize == req.ToClusterSize {
+		plan.BootstrapSource = PartitionVirtualShards(req.ServerId, req.ShardId, req.ToClusterSize, shardCount, req.ReplicationFactor)
+		plan.PickBestBootstrapSource = true
+		// this is for replicating shards on a different server
+		plan.TransitionalFollowSource = []ClusterShard{{ShardId: req.ShardId, ServerId: req.ServerId}}
+		return
+	}
+
+	if !IsVirtualShardInLocal(req.ShardId, req.ServerId, req.ToClusterSize, shardCount, req.ReplicationFactor) {
+		// moving out, nothing to do
+		return
+	}
+	if IsVirtualShardInLocal(req.ShardId, req.ServerId, req.FromClusterSize, shardCount, req.ReplicationFactor) {
+		// the shard does not move
+		return
+	}
+
+	// moving in, copy from one existing copy, and follow the primary copy until the old copies are retired
+	plan.BootstrapSource = PartitionVirtualShards(req.ServerId, req.ShardId, req.FromClusterSize, shardCount, req.ReplicationFactor)
+	plan.PickBestBootstrapSource = true
+	plan.TransitionalFollowSource = []ClusterShard{{ShardId: req.ShardId, ServerId: req.ShardId % req.FromClusterSize}}
+	return
+
+}
+
 func (plan *BootstrapPlan) String() string {
 	var buf bytes.Buffer
 	if len(plan.BootstrapSource) > 0 {
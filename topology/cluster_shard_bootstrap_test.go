@@ -161,3 +161,35 @@ func TestSomeCases(t *testing.T) {
 	println(plan.String())
 
 }
+
+func TestBootstrapVirtualShardsWhenGrowing(t *testing.T) {
+
+	// shard 4 moves from server 1 to server 4 when growing 3 => 5 servers
+	plan := BootstrapPlanWithVirtualShards(&BootstrapRequest{4, 4, 3, 5, 1}, 8)
+	assert.Equal(t, []ClusterShard{{ShardId: 4, ServerId: 1}}, plan.BootstrapSource)
+	assert.Equal(t, []ClusterShard{{ShardId: 4, ServerId: 1}}, plan.TransitionalFollowSource)
+
+	// shard 0 stays on server 0
+	plan = BootstrapPlanWithVirtualShards(&BootstrapRequest{0, 0, 3, 5, 1}, 8)
+	assert.Equal(t, 0, len(plan.BootstrapSource))
+	assert.Equal(t, 0, len(plan.TransitionalFollowSource))
+
+	// shard 3 moves out of server 0
+	plan = BootstrapPlanWithVirtualShards(&BootstrapRequest{0, 3, 3, 5, 1}, 8)
+	assert.Equal(t, 0, len(plan.BootstrapSource))
+
+}
+
+func TestBootstrapVirtualShardsWhenShrinking(t *testing.T) {
+
+	// shard 7 moves from servers 2,3 to servers 1,0 when shrinking 5 => 2 servers
+	plan := BootstrapPlanWithVirtualShards(&BootstrapRequest{1, 7, 5, 2, 2}, 8)
+	assert.Equal(t, true, plan.PickBestBootstrapSource)
+	assert.Equal(t, []ClusterShard{{ShardId: 7, ServerId: 2}, {ShardId: 7, ServerId: 3}}, plan.BootstrapSource)
+	assert.Equal(t, []ClusterShard{{ShardId: 7, ServerId: 2}}, plan.TransitionalFollowSource)
+
+	// shard 4 already has a copy on server 0
+	plan = BootstrapPlanWithVirtualShards(&BootstrapRequest{0, 4, 5, 2, 2}, 8)
+	assert.Equal(t, 0, len(plan.BootstrapSource))
+
+}
@@ -12,45 +12,56 @@ func (shard ClusterShard) String() string {
 	return fmt.Sprintf("%d.%d", shard.ServerId, shard.ShardId)
 }
 
+/*
+With virtual shards, a cluster of clusterSize servers has a fixed number of shardCount logical shards.
+The keys are hashed into the shardCount shards, and the shard i is placed on the server i % clusterSize,
+and its replicas on the next servers. Resizing the cluster moves the whole shards between the servers.
+
+shardCount 0 means one shard per server, as many shards as the cluster size.
+*/
+
+// LogicalShardCount returns the number of shards the keys are hashed into.
+func LogicalShardCount(clusterSize int, shardCount int) int {
+	if shardCount > 0 {
+		return shardCount
+	}
+	return clusterSize
+}
+
 // PeerShards list peer shards that are on other cluster nodes
 func PeerShards(selfServerId int, selfShardId int, clusterSize int, replicationFactor int) (peers []ClusterShard) {
+	return PeerVirtualShards(selfServerId, selfShardId, clusterSize, 0, replicationFactor)
+}
 
-	if selfShardId >= clusterSize {
-		return
-	}
+// PeerVirtualShards list peer shards that are on other cluster nodes, with shardCount virtual shards
+func PeerVirtualShards(selfServerId int, selfShardId int, clusterSize int, shardCount int, replicationFactor int) (peers []ClusterShard) {
 
-	for i := 0; i < replicationFactor && i < clusterSize; i++ {
-		serverId := selfShardId + i
-		if serverId >= clusterSize {
-			serverId -= clusterSize
-		}
-		if serverId == selfServerId {
+	for _, shard := range PartitionVirtualShards(selfServerId, selfShardId, clusterSize, shardCount, replicationFactor) {
+		if shard.ServerId == selfServerId {
 			continue
 		}
-		peers = append(peers, ClusterShard{
-			ShardId:  selfShardId,
-			ServerId: serverId,
-		})
+		peers = append(peers, shard)
 	}
 
 	return
 }
 
-// PartitionShards list shards that belongs to the same partition
+// PartitionShards list shards that are on all cluster nodes having the shard
 func PartitionShards(selfServerId int, selfShardId int, clusterSize int, replicationFactor int) (shards []ClusterShard) {
+	return PartitionVirtualShards(selfServerId, selfShardId, clusterSize, 0, replicationFactor)
+}
+
+// PartitionVirtualShards list shards that are on all cluster nodes having the shard, with shardCount virtual shards
+func PartitionVirtualShards(selfServerId int, selfShardId int, clusterSize int, shardCount int, replicationFactor int) (shards []ClusterShard) {
 
-	if selfShardId >= clusterSize {
+	if clusterSize == 0 || selfShardId >= LogicalShardCount(clusterSize, shardCount) {
 		return
 	}
 
 	for i := 0; i < replicationFactor && i < clusterSize; i++ {
-		serverId := selfShardId + i
-		if serverId >= clusterSize {
-			serverId -= clusterSize
-		}
 		shards = append(shards, ClusterShard{
 			ShardId:  selfShardId,
-			ServerId: serverId,
+			ServerId: (selfShardId + i) % clusterSize,
 		})
 	}
 
@@ -59,27 +70,40 @@ func PartitionShards(selfServerId int, selfShardId int, clusterSize int, replica
 
 // LocalShards list shards that local node should have
 func LocalShards(selfServerId int, clusterSize int, replicationFactor int) (shards []ClusterShard) {
+	return LocalVirtualShards(selfServerId, clusterSize, 0, replicationFactor)
+}
+
+// LocalVirtualShards list shards that local node should have, with shardCount virtual shards
+func LocalVirtualShards(selfServerId int, clusterSize int, shardCount int, replicationFactor int) (shards []ClusterShard) {
 
 	if selfServerId >= clusterSize {
 		return
 	}
 
+	logicalShardCount := LogicalShardCount(clusterSize, shardCount)
 	for i := 0; i < replicationFactor && i < clusterSize; i++ {
-		shardId := selfServerId - i
-		if shardId < 0 {
-			shardId += clusterSize
+		position := selfServerId - i
+		if position < 0 {
+			position += clusterSize
+		}
+		for shardId := position; shardId < logicalShardCount; shardId += clusterSize {
+			shards = append(shards, ClusterShard{
+				ShardId:  shardId,
+				ServerId: selfServerId,
+			})
 		}
-		shards = append(shards, ClusterShard{
-			ShardId:  shardId,
-			ServerId: selfServerId,
-		})
 	}
 	return
 }
 
 // IsShardInLocal returns true if the tuple if shard should be on current server
 func IsShardInLocal(shardId int, selfServerId int, clusterSize int, replicationFactor int) bool {
-	shards := LocalShards(selfServerId, clusterSize, replicationFactor)
+	return IsVirtualShardInLocal(shardId, selfServerId, clusterSize, 0, replicationFactor)
+}
+
+// IsVirtualShardInLocal returns true if the shard should be on current server, with shardCount virtual shards
+func IsVirtualShardInLocal(shardId int, selfServerId int, clusterSize int, shardCount int, replicationFactor int) bool {
+	shards := LocalVirtualShards(selfServerId, clusterSize, shardCount, replicationFactor)
 	for _, shard := range shards {
 		if shardId == shard.ShardId {
 			return true
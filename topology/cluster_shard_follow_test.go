@@ -95,6 +95,47 @@ func TestLocalShards(t *testing.T) {
 
 }
 
+func TestLocalVirtualShards(t *testing.T) {
+
+	// 3 servers, 8 shards, 1 copy
+	shards := LocalVirtualShards(1, 3, 8, 1)
+	assert.Equal(t, 3, len(shards))
+	assert.Equal(t, 1, shards[0].ShardId)
+	assert.Equal(t, 4, shards[1].ShardId)
+	assert.Equal(t, 7, shards[2].ShardId)
+
+	// 3 servers, 8 shards, 2 copies
+	shards = LocalVirtualShards(0, 3, 8, 2)
+	assert.Equal(t, 5, len(shards))
+	assert.Equal(t, []int{0, 3, 6, 2, 5}, shardIds(shards))
+
+	// 0 shard count is the same as one shard per server
+	assert.Equal(t, LocalShards(2, 3, 2), LocalVirtualShards(2, 3, 0, 2))
+
+	assert.Equal(t, true, IsVirtualShardInLocal(5, 0, 3, 8, 2))
+	assert.Equal(t, false, IsVirtualShardInLocal(4, 0, 3, 8, 2))
+
+}
+
+func TestPeerVirtualShards(t *testing.T) {
+
+	peers := PeerVirtualShards(2, 5, 3, 8, 3)
+	assert.Equal(t, 2, len(peers))
+	assert.Equal(t, ClusterShard{ShardId: 5, ServerId: 0}, peers[0])
+	assert.Equal(t, ClusterShard{ShardId: 5, ServerId: 1}, peers[1])
+
+	// shard out of range
+	assert.Equal(t, 0, len(PartitionVirtualShards(0, 8, 3, 8, 3)))
+
+}
+
+func shardIds(shards []ClusterShard) (ids []int) {
+	for _, shard := range shards {
+		ids = append(ids, shard.ShardId)
+	}
+	return
+}
+
 func TestShardListContains(t *testing.T) {
 
 	shards := LocalShards(6, 7, 3)
@@ -28,12 +28,15 @@ func TestClusterOperations(t *testing.T) {
 func TestClusterProto(t *testing.T) {
 
 	ring3 := createRing(3)
+	ring3.SetDataCenter("dc1")
 
 	cluster := ring3.ToCluster()
 
 	assert.Equal(t, cluster.Keyspace, "ks1", "keyspace")
+	assert.Equal(t, cluster.DataCenter, "dc1", "data center")
 	assert.Equal(t, cluster.ExpectedClusterSize, uint32(3), "expected cluster size")
 	assert.Equal(t, cluster.CurrentClusterSize, uint32(3), "current cluster size")
+	assert.Equal(t, cluster.ReplicationFactor, uint32(2), "replication factor")
 
 }
 
@@ -180,3 +183,29 @@ func TestNextCluster(t *testing.T) {
 	ring.RemoveNextCluster()
 
 }
+
+func TestCurrentSizeWithShardCount(t *testing.T) {
+
+	cluster := NewCluster("ks1", 2, 1)
+	cluster.SetShardCount(4)
+
+	for shardId := 0; shardId < 4; shardId++ {
+		serverId := shardId % 2
+		cluster.SetShard(&pb.StoreResource{
+			Network: "tcp",
+			Address: fmt.Sprint("localhost:", 7000+serverId),
+		}, &pb.ShardInfo{
+			KeyspaceName:      "ks1",
+			ServerId:          uint32(serverId),
+			ShardId:           uint32(shardId),
+			ClusterSize:       2,
+			ReplicationFactor: 1,
+			ShardCount:        4,
+		})
+	}
+
+	assert.Equal(t, cluster.CurrentSize(), 2, "the number of servers")
+	assert.Equal(t, cluster.CurrentShardCount(), 4, "the number of shards")
+	assert.Equal(t, cluster.ToCluster().CurrentClusterSize, uint32(2), "current cluster size")
+
+}
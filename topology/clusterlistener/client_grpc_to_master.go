@@ -27,7 +27,7 @@ func (clusterListener *ClusterListener) registerClientAtMasterServer(master stri
 	go func() {
 		for keyspace := range clusterListener.clusters {
 			// glog.V(2).Infof("%s register cluster keyspace(%v) datacenter(%v)", clusterListener.clientName, keyspace, dataCenter)
-			if err := registerForClusterAtMaster(stream, string(keyspace), false, clusterListener.clientName); err != nil {
+			if err := clusterListener.registerForClusterAtMaster(stream, string(keyspace), false); err != nil {
 				// glog.V(2).Infof("%s register cluster keyspace(%v) datacenter(%v): %v", clusterListener.clientName, keyspace, dataCenter, err)
 				return
 			}
@@ -40,7 +40,7 @@ func (clusterListener *ClusterListener) registerClientAtMasterServer(master stri
 			} else {
 				// glog.V(2).Infof("%s register cluster new keyspace(%v) datacenter(%v)", clusterListener.clientName, msg.keyspace, dataCenter)
 			}
-			if err := registerForClusterAtMaster(stream, string(msg.keyspace), msg.isUnfollow, clusterListener.clientName); err != nil {
+			if err := clusterListener.registerForClusterAtMaster(stream, string(msg.keyspace), msg.isUnfollow); err != nil {
 				if msg.isUnfollow {
 					// glog.V(2).Infof("%s unfollow cluster keyspace(%v) datacenter(%v): %v", clusterListener.clientName, msg.keyspace, dataCenter, err)
 				} else {
@@ -71,17 +71,19 @@ func (clusterListener *ClusterListener) registerClientAtMasterServer(master stri
 
 }
 
-func registerForClusterAtMaster(stream pb.VastoMaster_RegisterClientClient, keyspace string, isUnfollow bool, clientName string) error {
+func (clusterListener *ClusterListener) registerForClusterAtMaster(stream pb.VastoMaster_RegisterClientClient, keyspace string, isUnfollow bool) error {
 	clientHeartbeat := &pb.ClientHeartbeat{
-		ClientName: clientName,
+		ClientName: clusterListener.clientName,
 		ClusterFollow: &pb.ClientHeartbeat_ClusterFollowMessage{
-			Keyspace:   keyspace,
-			IsUnfollow: isUnfollow,
+			Keyspace:                  keyspace,
+			IsUnfollow:                isUnfollow,
+			DataCenter:                clusterListener.dataCenter,
+			DisableDataCenterFallback: clusterListener.disableDataCenterFallback,
 		},
 	}
 
 	if err := stream.Send(clientHeartbeat); err != nil {
-		return fmt.Errorf("%s client send heartbeat: %v", clientName, err)
+		return fmt.Errorf("%s client send heartbeat: %v", clusterListener.clientName, err)
 	}
 	return nil
 }
@@ -29,16 +29,25 @@ type ClusterListener struct {
 	connPools                 map[string]pool.Pool
 	connPoolLock              sync.Mutex
 	disableUnixSocket         bool
+	dataCenter                string
+	disableDataCenterFallback bool
 }
 
-// NewClusterListener creates a cluster listener in a data center.
+// NewClusterListener creates a cluster listener.
 // clientName is only for display purpose.
 func NewClusterListener(clientName string) *ClusterListener {
+	return NewClusterListenerInDataCenter("", clientName)
+}
+
+// NewClusterListenerInDataCenter creates a cluster listener in a data center.
+// clientName is only for display purpose.
+func NewClusterListenerInDataCenter(dataCenter string, clientName string) *ClusterListener {
 	return &ClusterListener{
 		clusters:                  make(map[keyspaceName]*topology.Cluster),
 		keyspaceFollowMessageChan: make(chan keyspaceFollowMessage, 1),
 		clientName:                clientName,
 		connPools:                 make(map[string]pool.Pool),
+		dataCenter:                dataCenter,
 	}
 }
 
@@ -112,12 +121,24 @@ func (clusterListener *ClusterListener) StartListener(ctx context.Context, maste
 		}
 	}()
 
-	// println("client is connected to master", master, "data center", dataCenter)
+	// println("client is connected to master", master, "data center", clusterListener.dataCenter)
 
 	return
 
 }
 
+// SetDataCenterFallback whether or not follow the keyspace in other data centers,
+// if the keyspace has no cluster in the local data center. Default to true.
+// Stores should only follow the clusters in the local data center.
+func (clusterListener *ClusterListener) SetDataCenterFallback(enableFallback bool) {
+	clusterListener.disableDataCenterFallback = !enableFallback
+}
+
+// DataCenter returns the local data center of the listener
+func (clusterListener *ClusterListener) DataCenter() string {
+	return clusterListener.dataCenter
+}
+
 // SetUnixSocket whether or not use unix socket if available. Default to true.
 // When client or gateway is on the same machine as the store server, using unix socket can avoid some network cost.
 func (clusterListener *ClusterListener) SetUnixSocket(useUnixSocket bool) {
@@ -135,8 +156,8 @@ func (clusterListener *ClusterListener) HasConnectedKeyspace(keyspace string) bo
 		// println("cluster current size", cluster.CurrentSize())
 		return false
 	}
-	if cluster.CurrentSize() != cluster.ExpectedSize() {
-		// println("cluster current size", cluster.CurrentSize(), "expected", cluster.ExpectedSize())
+	if cluster.CurrentShardCount() != cluster.LogicalShardCount() {
+		// println("cluster current shard count", cluster.CurrentShardCount(), "expected", cluster.LogicalShardCount())
 		return false
 	}
 	return true
@@ -146,6 +167,7 @@ func (clusterListener *ClusterListener) processClientMessage(msg *pb.ClientMessa
 	if msg.GetCluster() != nil {
 		glog.V(4).Infof("%s listener get cluster: %v", clusterListener.clientName, msg.GetCluster())
 		cluster := clusterListener.GetOrSetCluster(msg.Cluster.Keyspace, int(msg.Cluster.ExpectedClusterSize), int(msg.Cluster.ReplicationFactor))
+		cluster.SetShardCount(int(msg.Cluster.ShardCount))
 		for _, node := range msg.Cluster.Nodes {
 			addNode(cluster, node)
 			for _, shardEventProcess := range clusterListener.shardEventProcessors {
@@ -191,6 +213,14 @@ func (clusterListener *ClusterListener) processClientMessage(msg *pb.ClientMessa
 			return
 		}
 		r.SetExpectedSize(int(msg.Resize.TargetClusterSize))
+	} else if msg.GetReplicationFactorChange() != nil {
+		glog.V(4).Infof("%s listener get replication factor change: %v", clusterListener.clientName, msg.GetReplicationFactorChange())
+		r, found := clusterListener.GetCluster(msg.ReplicationFactorChange.Keyspace)
+		if !found {
+			glog.Errorf("%s no keyspace %s found to change replication factor", clusterListener.clientName, msg.ReplicationFactorChange.Keyspace)
+			return
+		}
+		r.SetReplicationFactor(int(msg.ReplicationFactorChange.ReplicationFactor))
 	} else {
 		glog.Errorf("%s unknown message %v", clusterListener.clientName, msg)
 	}
@@ -63,6 +63,18 @@ func Uint16toBytes(v uint16) (b []byte) {
 	return b
 }
 
+// BytesToInt64 converts big endian 8 bytes into int64
+func BytesToInt64(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+// Int64ToBytes converts int64 into big endian 8 bytes
+func Int64ToBytes(v int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v))
+	return b
+}
+
 // BytesToFloat64 converts from little endian 8 bytes to a float64
 func BytesToFloat64(bytes []byte) float64 {
 	bits := binary.LittleEndian.Uint64(bytes)
@@ -40,6 +40,20 @@ func TestConversionUint16(t *testing.T) {
 
 }
 
+func TestConversionInt64(t *testing.T) {
+
+	for _, x := range []int64{0, -1, 12345, 1<<62 + 1, -1 << 63} {
+
+		b := Int64ToBytes(x)
+
+		if BytesToInt64(b) != x {
+			t.Errorf("unexpected: %d, expecting %d", BytesToInt64(b), x)
+		}
+
+	}
+
+}
+
 func TestConversionFloat64(t *testing.T) {
 
 	x := float64(12345.678)
@@ -2,12 +2,24 @@ package util
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 )
 
+var (
+	// ErrorMessageTooLarge error when the message size is over the limit
+	ErrorMessageTooLarge = errors.New("message too large")
+)
+
 // ReadMessage reads out the []byte for one message
 func ReadMessage(reader io.Reader) (m []byte, err error) {
+	return ReadMessageWithLimit(reader, 0)
+}
+
+// ReadMessageWithLimit reads out the []byte for one message, which should not be larger than maxSize.
+// If maxSize is 0, there are no limits.
+func ReadMessageWithLimit(reader io.Reader, maxSize int32) (m []byte, err error) {
 	var length int32
 	err = binary.Read(reader, binary.LittleEndian, &length)
 	if err == io.EOF {
@@ -19,6 +31,9 @@ func ReadMessage(reader io.Reader) (m []byte, err error) {
 	if length == 0 {
 		return
 	}
+	if length < 0 || (maxSize > 0 && length > maxSize) {
+		return nil, fmt.Errorf("message size %d, limit %d: %v", length, maxSize, ErrorMessageTooLarge)
+	}
 	m = make([]byte, length)
 	var n int
 	n, err = io.ReadFull(reader, m)
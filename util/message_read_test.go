@@ -40,3 +40,26 @@ func TestReadWriteMessage(t *testing.T) {
 	assert.Equal(t, err != nil, true, "wrong length message")
 
 }
+
+func TestReadMessageWithLimit(t *testing.T) {
+	data := make([]byte, 100)
+
+	rand.Read(data)
+
+	var buf bytes.Buffer
+
+	WriteMessage(&buf, data)
+	out, err := ReadMessageWithLimit(&buf, 100)
+	assert.Equal(t, err, nil, "message within limit")
+	assert.Equal(t, len(out), 100, "message within limit")
+
+	WriteMessage(&buf, data)
+	_, err = ReadMessageWithLimit(&buf, 99)
+	assert.Equal(t, err != nil, true, "message over limit")
+
+	buf.Reset()
+	binary.Write(&buf, binary.LittleEndian, int32(-1))
+	_, err = ReadMessageWithLimit(&buf, 0)
+	assert.Equal(t, err != nil, true, "negative length message")
+
+}
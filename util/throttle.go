@@ -0,0 +1,73 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttler limits how many bytes are sent per second, shared by all its users.
+// The limit can be changed while in use. 0 bytes per second means no limit.
+type Throttler struct {
+	sync.Mutex
+	bytesPerSecond int64
+	startTime      time.Time
+	sentBytes      int64
+}
+
+// NewThrottler creates a throttler with the limit of bytes per second.
+func NewThrottler(bytesPerSecond int64) *Throttler {
+	return &Throttler{
+		bytesPerSecond: bytesPerSecond,
+		startTime:      time.Now(),
+	}
+}
+
+// SetBytesPerSecond changes the limit, which starts to apply to the next Wait().
+func (t *Throttler) SetBytesPerSecond(bytesPerSecond int64) {
+	t.Lock()
+	t.bytesPerSecond = bytesPerSecond
+	t.startTime = time.Now()
+	t.sentBytes = 0
+	t.Unlock()
+}
+
+// BytesPerSecond returns the current limit.
+func (t *Throttler) BytesPerSecond() int64 {
+	if t == nil {
+		return 0
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.bytesPerSecond
+}
+
+// Wait blocks until the n bytes can be sent without going over the limit.
+// A nil throttler does not limit.
+func (t *Throttler) Wait(n int64) {
+	if t == nil {
+		return
+	}
+
+	t.Lock()
+	if t.bytesPerSecond <= 0 {
+		t.Unlock()
+		return
+	}
+	now := time.Now()
+	if now.Sub(t.startTime.Add(t.duration(t.sentBytes))) > time.Second {
+		// idle for a while, do not let the unused bandwidth accumulate
+		t.startTime = now
+		t.sentBytes = 0
+	}
+	t.sentBytes += n
+	delay := t.startTime.Add(t.duration(t.sentBytes)).Sub(now)
+	t.Unlock()
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+func (t *Throttler) duration(bytes int64) time.Duration {
+	return time.Duration(float64(bytes) / float64(t.bytesPerSecond) * float64(time.Second))
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThrottler(t *testing.T) {
+
+	throttler := NewThrottler(10000)
+
+	startTime := time.Now()
+	for i := 0; i < 3; i++ {
+		throttler.Wait(1000)
+	}
+	if elapsed := time.Since(startTime); elapsed < 250*time.Millisecond || elapsed > time.Second {
+		t.Errorf("unexpected elapsed time %v for 3000 bytes at 10000 bytes per second", elapsed)
+	}
+
+	throttler.SetBytesPerSecond(0)
+	startTime = time.Now()
+	throttler.Wait(1000000)
+	if elapsed := time.Since(startTime); elapsed > 100*time.Millisecond {
+		t.Errorf("unexpected elapsed time %v without limit", elapsed)
+	}
+
+	var nilThrottler *Throttler
+	nilThrottler.Wait(1000000)
+	if nilThrottler.BytesPerSecond() != 0 {
+		t.Errorf("unexpected limit of nil throttler")
+	}
+
+}
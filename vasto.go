@@ -39,39 +39,53 @@ var (
 	master       = app.Command("master", "Start a master process")
 	masterOption = &m.MasterOption{
 		Address: master.Flag("address", "listening address host:port").Default(":8278").String(),
+		Dir:     master.Flag("dir", "folder to keep the admin job history, not kept if empty").String(),
 	}
 
 	store       = app.Command("store", "Start a vasto store")
 	storeOption = &s.StoreOption{
-		Dir:               store.Flag("dir", "folder to store data").Default(os.TempDir()).String(),
-		Host:              store.Flag("host", "store host address").Default(util.GetLocalIP()).String(),
-		ListenHost:        store.Flag("listenHost", "store listening host address").Default("").String(),
-		TcpPort:           store.Flag("port", "store listening tcp port").Default("8279").Int32(),
-		DisableUnixSocket: store.Flag("disableUnixSocket", "store listening unix socket").Default("false").Bool(),
-		Master:            store.Flag("master", "master address").Default("localhost:8278").String(),
-		LogFileSizeMb:     store.Flag("logFileSizeMb", "log file size limit in MB").Default("128").Int(),
-		LogFileCount:      store.Flag("logFileCount", "log file count limit").Default("3").Int(),
-		DiskSizeGb:        store.Flag("diskSizeGb", "disk size in GB").Default("10").Int(),
-		Tags:              store.Flag("tags", "comma separated tags").Default("").String(),
-		DisableBinLog:     store.Flag("disableBinLog", "disable binary log").Default("false").Bool(),
+		Dir:                store.Flag("dir", "folder to store data").Default(os.TempDir()).String(),
+		Host:               store.Flag("host", "store host address").Default(util.GetLocalIP()).String(),
+		ListenHost:         store.Flag("listenHost", "store listening host address").Default("").String(),
+		TcpPort:            store.Flag("port", "store listening tcp port").Default("8279").Int32(),
+		DisableUnixSocket:  store.Flag("disableUnixSocket", "store listening unix socket").Default("false").Bool(),
+		Master:             store.Flag("master", "master address").Default("localhost:8278").String(),
+		DataCenter:         store.Flag("dc", "data center name").Default("").String(),
+		LogFileSizeMb:      store.Flag("logFileSizeMb", "log file size limit in MB").Default("128").Int(),
+		LogFileCount:       store.Flag("logFileCount", "log file count limit").Default("3").Int(),
+		DiskSizeGb:         store.Flag("diskSizeGb", "disk size in GB").Default("10").Int(),
+		Tags:               store.Flag("tags", "comma separated tags").Default("").String(),
+		Zone:               store.Flag("zone", "failure domain zone, or tag zone=<name>").Default("").String(),
+		Rack:               store.Flag("rack", "failure domain rack, or tag rack=<name>").Default("").String(),
+		DisableBinLog:      store.Flag("disableBinLog", "disable binary log").Default("false").Bool(),
+		MigrateEntryFormat: store.Flag("migrateEntryFormat", "rewrite entries in legacy format when loading existing shards").Default("false").Bool(),
+		MaxMessageSizeMb:   store.Flag("maxMessageSizeMb", "reject request messages larger than this size in MB").Default("64").Int(),
+		BootstrapThrottle:  store.Flag("bootstrapBytesPerSecond", "limit sending bootstrap copies to other stores, 0 for no limit").Default("0").Int64(),
 	}
 	storeProfile = store.Flag("cpuprofile", "cpu profile output file").Default("").String()
 
 	server             = app.Command("server", "Start a vasto master and a vasto store")
 	serverMasterOption = &m.MasterOption{
 		Address: server.Flag("master.address", "listening address host:port").Default(":8278").String(),
+		Dir:     server.Flag("master.dir", "folder to keep the admin job history, not kept if empty").String(),
 	}
 	serverStoreOption = &s.StoreOption{
-		Dir:               server.Flag("store.dir", "folder to server data").Default(os.TempDir()).String(),
-		Host:              server.Flag("store.host", "server host address").Default(util.GetLocalIP()).String(),
-		ListenHost:        server.Flag("store.listenHost", "server listening host address").Default("").String(),
-		TcpPort:           server.Flag("store.port", "server listening tcp port").Default("8279").Int32(),
-		DisableUnixSocket: server.Flag("store.disableUnixSocket", "server listening unix socket").Default("false").Bool(),
-		Master:            server.Flag("store.master", "master address").Default("localhost:8278").String(),
-		LogFileSizeMb:     server.Flag("store.logFileSizeMb", "log file size limit in MB").Default("128").Int(),
-		LogFileCount:      server.Flag("store.logFileCount", "log file count limit").Default("3").Int(),
-		DiskSizeGb:        server.Flag("store.diskSizeGb", "disk size in GB").Default("10").Int(),
-		Tags:              server.Flag("store.tags", "comma separated tags").Default("").String(),
+		Dir:                server.Flag("store.dir", "folder to server data").Default(os.TempDir()).String(),
+		Host:               server.Flag("store.host", "server host address").Default(util.GetLocalIP()).String(),
+		ListenHost:         server.Flag("store.listenHost", "server listening host address").Default("").String(),
+		TcpPort:            server.Flag("store.port", "server listening tcp port").Default("8279").Int32(),
+		DisableUnixSocket:  server.Flag("store.disableUnixSocket", "server listening unix socket").Default("false").Bool(),
+		Master:             server.Flag("store.master", "master address").Default("localhost:8278").String(),
+		DataCenter:         server.Flag("store.dc", "data center name").Default("").String(),
+		LogFileSizeMb:      server.Flag("store.logFileSizeMb", "log file size limit in MB").Default("128").Int(),
+		LogFileCount:       server.Flag("store.logFileCount", "log file count limit").Default("3").Int(),
+		DiskSizeGb:         server.Flag("store.diskSizeGb", "disk size in GB").Default("10").Int(),
+		Tags:               server.Flag("store.tags", "comma separated tags").Default("").String(),
+		Zone:               server.Flag("store.zone", "failure domain zone, or tag zone=<name>").Default("").String(),
+		Rack:               server.Flag("store.rack", "failure domain rack, or tag rack=<name>").Default("").String(),
+		MigrateEntryFormat: server.Flag("store.migrateEntryFormat", "rewrite entries in legacy format when loading existing shards").Default("false").Bool(),
+		MaxMessageSizeMb:   server.Flag("store.maxMessageSizeMb", "reject request messages larger than this size in MB").Default("64").Int(),
+		BootstrapThrottle:  server.Flag("store.bootstrapBytesPerSecond", "limit sending bootstrap copies to other stores, 0 for no limit").Default("0").Int64(),
 	}
 	serverProfile = server.Flag("cpuprofile", "cpu profile output file").Default("").String()
 
@@ -80,6 +94,7 @@ var (
 		TcpAddress: gateway.Flag("address", "gateway tcp host address").Default(":8281").String(),
 		UnixSocket: gateway.Flag("unixSocket", "gateway listening unix socket").Default("").Short('s').String(),
 		Master:     gateway.Flag("master", "master address").Default("localhost:8278").String(),
+		DataCenter: gateway.Flag("dc", "local data center name").Default("").String(),
 		Keyspace:   gateway.Flag("cluster", "cluster name").Default("").String(),
 	}
 	gatewayProfile = gateway.Flag("cpuprofile", "cpu profile output file").Default("").String()
@@ -91,6 +106,7 @@ var (
 		RequestCountStart: bench.Flag("requestNumberStart", "starting request index").Default("0").Int32(),
 		BatchSize:         bench.Flag("batchSize", "put requests in batch").Default("1").Short('b').Int32(),
 		Master:            bench.Flag("master", "master address").Default("localhost:8278").String(),
+		DataCenter:        bench.Flag("dc", "local data center name").Default("").String(),
 		Keyspace:          bench.Flag("cluster", "cluster name").Default("benchmark").String(),
 		Tests:             bench.Flag("tests", "[put|get]").Default("put,get").Short('t').String(),
 		DisableUnixSocket: bench.Flag("disableUnixSocket", "avoid unix socket and only use tcp network").Default("false").Bool(),
@@ -99,8 +115,9 @@ var (
 
 	shell       = app.Command("shell", "Start a vasto shell")
 	shellOption = &sh.ShellOption{
-		Master:   shell.Flag("master", "master address").Default("localhost:8278").String(),
-		Keyspace: shell.Flag("cluster", "cluster name").Default("").String(),
+		Master:     shell.Flag("master", "master address").Default("localhost:8278").String(),
+		DataCenter: shell.Flag("dc", "local data center name").Default("").String(),
+		Keyspace:   shell.Flag("cluster", "cluster name").Default("").String(),
 	}
 
 	admin       = app.Command("admin", "Manage FixedCluster Size")